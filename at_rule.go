@@ -0,0 +1,30 @@
+package cssgo
+
+import "io"
+
+// Media creates an `@media` at-rule wrapping the given rules.
+// Example: Media("(min-width: 600px)", Class("foo").Props(Width(PX(10)))) -> `@media (min-width: 600px){.foo{width: 10px;}}`
+//
+// Parameters:
+// - query (string): The media query list (e.g., `(prefers-color-scheme: dark)`).
+// - rules (...RuleNode): The rules that apply when the query matches.
+//
+// Returns:
+// - RuleNodeFunc: A function that renders the full at-rule.
+func Media(query string, rules ...RuleNode) RuleNodeFunc {
+	return RuleNodeFunc(func(w io.Writer) error {
+		if _, err := w.Write([]byte("@media " + query + "{")); err != nil {
+			return err
+		}
+
+		for _, rule := range rules {
+			if err := rule.RenderCSS(w); err != nil {
+				return err
+			}
+		}
+
+		_, err := w.Write([]byte("}"))
+
+		return err
+	})
+}
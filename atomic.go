@@ -0,0 +1,124 @@
+package cssgo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// AtomicPrefix is the prefix of class names generated for atoms.
+const AtomicPrefix = "a-"
+
+// Declaration is a single CSS declaration, such as `color: red;`.
+// It implements the PropertyNode interface.
+type Declaration struct {
+	Property  string
+	Value     string
+	Important bool
+}
+
+// RenderCSS writes the declaration, e.g. `color: red;` or `color: red !important;`.
+func (d Declaration) RenderCSS(w io.Writer) error {
+	css := d.Property + ": " + d.Value
+	if d.Important {
+		css += " !important"
+	}
+	_, err := w.Write([]byte(css + ";"))
+	return err
+}
+
+func (d Declaration) String() string {
+	var b strings.Builder
+	_ = d.RenderCSS(&b)
+	return b.String()
+}
+
+func (d Declaration) propertyNode() {}
+
+// Declarations splits properties into their individual declarations, so that
+// shorthands built from several properties, such as GroupProps, can be inspected one by one.
+// Example: Declarations(GroupProps(TextColor(Red), Width(PX(10)))) -> [color: red; width: 10px;]
+func Declarations(props ...PropertyNode) ([]Declaration, error) {
+	return declarations(nil, props...)
+}
+
+// declarations is Declarations for nodes that render properties on w,
+// so that the properties are rendered with the validation mode of w.
+func declarations(w io.Writer, props ...PropertyNode) ([]Declaration, error) {
+	var b strings.Builder
+	bw := bufferWriter(w, &b)
+	for _, prop := range props {
+		if err := prop.RenderCSS(bw); err != nil {
+			return nil, err
+		}
+	}
+
+	items, err := parseCSS(b.String())
+	if err != nil {
+		return nil, err
+	}
+
+	decls := make([]Declaration, 0, len(items))
+	for _, item := range items {
+		if item.kind == ruleItem {
+			return nil, fmt.Errorf("%w: %q", ErrNestedRule, item.prelude)
+		}
+		if item.kind != declItem {
+			return nil, fmt.Errorf("%w: %q is not a declaration", ErrParse, item.prelude)
+		}
+		decls = append(decls, item.decl)
+	}
+	return decls, nil
+}
+
+// Atom is a single declaration together with the context it applies in.
+// It renders as a rule for a single-purpose class, which is shared by every
+// element using the same declaration in the same context.
+// It implements the RuleNode interface.
+type Atom struct {
+	Declaration Declaration
+	// Pseudo is appended to the class selector, e.g. ":hover" or "::placeholder".
+	Pseudo string
+	// Media is the media query the atom applies in, e.g. "(min-width: 600px)".
+	Media string
+}
+
+// Atomize splits properties into atoms without a context.
+// Example: Atomize(Padding1(PX(8)), TextColor(Red)) -> two atoms, one per declaration.
+func Atomize(props ...PropertyNode) ([]Atom, error) {
+	decls, err := Declarations(props...)
+	if err != nil {
+		return nil, err
+	}
+
+	atoms := make([]Atom, len(decls))
+	for i, decl := range decls {
+		atoms[i] = Atom{Declaration: decl}
+	}
+	return atoms, nil
+}
+
+// ClassName returns the class name of the atom, derived from its declaration and context.
+// Example: Atom{Declaration: Declaration{Property: "color", Value: "red"}}.ClassName() -> "a-1a2b3c4d"
+func (a Atom) ClassName() string {
+	sum := sha256.Sum256([]byte(a.Media + "\x00" + a.Pseudo + "\x00" + a.Declaration.String()))
+	return AtomicPrefix + hex.EncodeToString(sum[:4])
+}
+
+// RenderCSS writes the rule of the atom.
+// Example: `.a-1a2b3c4d:hover{color: red;}`, wrapped in `@media` when the atom has a media query.
+func (a Atom) RenderCSS(w io.Writer) error {
+	rule := Selector(func(w io.Writer) error {
+		_, err := w.Write([]byte("." + a.ClassName() + a.Pseudo))
+		return err
+	}).Props(a.Declaration)
+
+	if a.Media != "" {
+		return Media(a.Media, rule).RenderCSS(w)
+	}
+	return rule.RenderCSS(w)
+}
+
+func (a Atom) ruleNode() {}
@@ -0,0 +1,76 @@
+package cssgo
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestDeclarations(t *testing.T) {
+	decls, err := Declarations(
+		GroupProps(TextColor(Red), Padding2(PX(8), PX(16))),
+		Content(Str("a; b{c}")),
+		Prop("background", Url("x;y.png")),
+		Property(func(w io.Writer) error {
+			_, err := w.Write([]byte("width: 100% ! IMPORTANT;"))
+			return err
+		}),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []Declaration{
+		{Property: "color", Value: "red"},
+		{Property: "padding", Value: "8px 16px"},
+		{Property: "content", Value: `"a; b{c}"`},
+		{Property: "background", Value: "url('x;y.png')"},
+		{Property: "width", Value: "100%", Important: true},
+	}
+	if !reflect.DeepEqual(decls, want) {
+		t.Fatalf("FAIL\ngot: %v != want: %v", decls, want)
+	}
+
+	RunTests(t,
+		test{"declaration", want[0], "color: red;"},
+		test{"important declaration", want[4], "width: 100% !important;"},
+	)
+
+	if _, err := Declarations(Property(Class("a").Props(TextColor(Red)))); !errors.Is(err, ErrNestedRule) {
+		t.Fatalf("expected ErrNestedRule for nested rules, got %v", err)
+	}
+}
+
+func TestAtoms(t *testing.T) {
+	atoms, err := Atomize(GroupProps(TextColor(Red), Padding1(PX(8))))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(atoms) != 2 {
+		t.Fatalf("expected 2 atoms, got %d", len(atoms))
+	}
+
+	red := atoms[0]
+	hover := Atom{Declaration: red.Declaration, Pseudo: ":hover"}
+	wide := Atom{Declaration: red.Declaration, Media: "(min-width: 600px)"}
+
+	names := map[string]bool{}
+	for _, atom := range []Atom{red, atoms[1], hover, wide} {
+		names[atom.ClassName()] = true
+	}
+	if len(names) != 4 {
+		t.Fatalf("atoms in different contexts should have different class names: %v", names)
+	}
+
+	again, _ := Atomize(TextColor(Red))
+	if again[0].ClassName() != red.ClassName() {
+		t.Fatalf("class names should be stable: %q != %q", again[0].ClassName(), red.ClassName())
+	}
+
+	RunTests(t,
+		test{"atom", red, "." + red.ClassName() + "{color: red;}"},
+		test{"pseudo atom", hover, "." + hover.ClassName() + ":hover{color: red;}"},
+		test{"media atom", wide, "@media (min-width: 600px){." + wide.ClassName() + "{color: red;}}"},
+	)
+}
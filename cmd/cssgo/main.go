@@ -0,0 +1,120 @@
+// Command cssgo extracts the stylesheets a Go package registers with cssgo.Register
+// into static .css files, so that they can be served as cacheable assets instead of
+// being inlined into every page.
+//
+// Usage:
+//
+//	cssgo [-o dir] [-hash] [-manifest file] [-only names] package
+//
+// The package is built into a temporary program together with its dependencies,
+// so its init functions run exactly as they do in the application.
+// It must be run from within the module that contains the package.
+//
+// Example:
+//
+//	cssgo -o static/css -hash -manifest manifest.json ./web/styles
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/avearmin/cssgo/extract"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "cssgo:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+var mainTemplate = template.Must(template.New("main").Parse(`// Code generated by cssgo. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/avearmin/cssgo/extract"
+
+	_ {{ printf "%q" . }}
+)
+
+func main() {
+	extract.Main()
+}
+`))
+
+// run parses the arguments, generates the extraction program for the package and runs it.
+func run(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("cssgo", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: cssgo [flags] package")
+		fs.PrintDefaults()
+	}
+	opts := extract.Flags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return flag.ErrHelp
+	}
+
+	importPath, err := resolve(fs.Arg(0), stderr)
+	if err != nil {
+		return err
+	}
+
+	// The program is generated inside the current module, so that it builds with
+	// the same dependencies as the package. Directories starting with a dot are
+	// ignored by ./... patterns.
+	dir, err := os.MkdirTemp(".", ".cssgo-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	var src bytes.Buffer
+	if err := mainTemplate.Execute(&src, importPath); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), src.Bytes(), 0o644); err != nil {
+		return err
+	}
+
+	cmd := exec.Command("go", "run", "./"+filepath.ToSlash(dir),
+		"-o="+opts.Dir,
+		"-hash="+strconv.FormatBool(opts.Hash),
+		"-manifest="+opts.Manifest,
+		"-only="+strings.Join(opts.Names, ","),
+	)
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+	return cmd.Run()
+}
+
+// resolve turns a package pattern such as ./web/styles into its import path.
+func resolve(pkg string, stderr io.Writer) (string, error) {
+	cmd := exec.Command("go", "list", "-f", "{{.ImportPath}}", pkg)
+	cmd.Stderr = stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("resolving package %q: %w", pkg, err)
+	}
+
+	paths := strings.Fields(string(out))
+	if len(paths) != 1 {
+		return "", fmt.Errorf("%q must match exactly one package", pkg)
+	}
+	return paths[0], nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"encoding/json"
+	"github.com/avearmin/cssgo/extract"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a program with the go tool")
+	}
+
+	out := t.TempDir()
+	var stdout, stderr strings.Builder
+	err := run([]string{"-o", out, "-hash", "-manifest", "manifest.json", "./testdata/styles"}, &stdout, &stderr)
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n%s", err, stderr.String())
+	}
+
+	data, err := os.ReadFile(filepath.Join(out, "manifest.json"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var manifest extract.Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{
+		"app.css":   ".button{color: blue;}",
+		"admin.css": ".panel{padding: 8px;}",
+	}
+	if len(manifest) != len(want) {
+		t.Fatalf("unexpected manifest %v", manifest)
+	}
+	for logical, css := range want {
+		file := manifest[logical]
+		if file != extract.FileName(strings.TrimSuffix(logical, ".css"), css, true) {
+			t.Fatalf("unexpected file name %q for %s", file, logical)
+		}
+		got, err := os.ReadFile(filepath.Join(out, file))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(got) != css {
+			t.Fatalf("FAIL\ngot: %s != want: %s", got, css)
+		}
+	}
+
+	if leftovers, _ := filepath.Glob(".cssgo-*"); len(leftovers) > 0 {
+		t.Fatalf("temporary program was not removed: %v", leftovers)
+	}
+}
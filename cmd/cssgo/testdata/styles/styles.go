@@ -0,0 +1,11 @@
+package styles
+
+import "github.com/avearmin/cssgo"
+
+var App = cssgo.Register("app", cssgo.Stylesheet{
+	cssgo.Class("button").Props(cssgo.TextColor(cssgo.Blue)),
+})
+
+var Admin = cssgo.Register("admin", cssgo.Stylesheet{
+	cssgo.Class("panel").Props(cssgo.Padding1(cssgo.PX(8))),
+})
@@ -0,0 +1,319 @@
+package cssgo
+
+import (
+	"fmt"
+	"io"
+	"math"
+)
+
+// RGBColor represents a color in the sRGB color space, used for color math.
+// Channels and alpha are normalised to 0.0–1.0; channels outside that range are
+// kept during calculations and clipped when the color is rendered.
+// It is a concrete type that implements the ColorValue interface.
+type RGBColor struct {
+	R, G, B, Alpha float64
+}
+
+// ToRGB resolves a ColorValue into an RGBColor.
+// Every color understood by ParseColor is supported, except for those that depend on
+// where they are used, such as `currentcolor`, light-dark(), Var or Inherit, which return ErrInvalidColor.
+func ToRGB(value ColorValue) (RGBColor, error) {
+	if c, ok := value.(RGBColor); ok {
+		return c, nil
+	}
+
+	s, err := render(value)
+	if err != nil {
+		return RGBColor{}, err
+	}
+
+	return parseColorString(s)
+}
+
+// MustRGB is like ToRGB but panics if the color cannot be resolved.
+// It simplifies the initialization of package level colors.
+func MustRGB(value ColorValue) RGBColor {
+	c, err := ToRGB(value)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Color converts the RGBColor into a Color, clipping it to the sRGB gamut.
+// Opaque colors render as hex (e.g., "#3366ff"), translucent ones as rgba().
+func (c RGBColor) Color() Color {
+	r, g, b := toByte(c.R), toByte(c.G), toByte(c.B)
+	alpha := math.Round(clamp(c.Alpha, 0, 1)*1000) / 1000
+	if alpha >= 1 {
+		return Hex(r<<16 | g<<8 | b)
+	}
+	return RGBA(r, g, b, alpha)
+}
+
+func (c RGBColor) RenderCSS(w io.Writer) error {
+	return c.Color().RenderCSS(w)
+}
+
+func (c RGBColor) String() string {
+	return string(c.Color())
+}
+
+func (c RGBColor) valueNode()  {}
+func (c RGBColor) colorValue() {}
+
+// WithAlpha returns the color with its alpha replaced.
+// Example: MustRGB(Red).WithAlpha(0.5) -> "rgba(255, 0, 0, 0.5)"
+func (c RGBColor) WithAlpha(alpha float64) RGBColor {
+	c.Alpha = alpha
+	return c
+}
+
+// Lighten increases the OKLCH lightness of the color by `amount` (0.0–1.0).
+// Example: MustRGB(Hex(0x3366ff)).Lighten(0.1)
+func (c RGBColor) Lighten(amount float64) RGBColor {
+	l, ch, h := c.OKLCH()
+	return FromOKLCH(clamp(l+amount, 0, 1), ch, h).WithAlpha(c.Alpha)
+}
+
+// Darken decreases the OKLCH lightness of the color by `amount` (0.0–1.0).
+// Example: MustRGB(Hex(0x3366ff)).Darken(0.1)
+func (c RGBColor) Darken(amount float64) RGBColor {
+	return c.Lighten(-amount)
+}
+
+// Saturate scales the OKLCH chroma of the color by `1 + amount`.
+// Example: Saturate(0.2) makes the color 20% more colorful.
+func (c RGBColor) Saturate(amount float64) RGBColor {
+	l, ch, h := c.OKLCH()
+	return FromOKLCH(l, math.Max(ch*(1+amount), 0), h).WithAlpha(c.Alpha)
+}
+
+// Desaturate scales the OKLCH chroma of the color by `1 - amount`.
+// Example: Desaturate(1) turns the color into a gray of the same lightness.
+func (c RGBColor) Desaturate(amount float64) RGBColor {
+	return c.Saturate(-amount)
+}
+
+// Mix mixes the color with `other` in the given color space, as color-mix() does.
+// `weight` is the proportion of `other` (0.0–1.0), so Mix(other, 0.5, OKLCHSpace) is an even mix.
+// Every predefined and interpolation color space is supported; other spaces return ErrInvalidColor.
+// Hues are interpolated along the shorter arc.
+// Example: MustRGB(Red).Mix(MustRGB(Blue), 0.5, SRGB) -> "#800080"
+func (c RGBColor) Mix(other RGBColor, weight float64, space ColorSpace) (RGBColor, error) {
+	weight = clamp(weight, 0, 1)
+	alpha := lerp(c.Alpha, other.Alpha, weight)
+
+	// Rectangular channels are interpolated premultiplied by alpha, as in color-mix().
+	premultiplied := func(a, b [3]float64) [3]float64 {
+		var out [3]float64
+		for i := range out {
+			out[i] = lerp(a[i]*c.Alpha, b[i]*other.Alpha, weight)
+			if alpha > 0 {
+				out[i] /= alpha
+			}
+		}
+		return out
+	}
+
+	// polar interpolates hue, chroma and lightness like channels, with the hue at index hue.
+	polar := func(a, b [3]float64, chroma float64, otherChroma float64, hue int) [3]float64 {
+		h1, h2 := powerlessHue(a[hue], chroma, b[hue], otherChroma)
+		ch := premultiplied(a, b)
+		ch[hue] = lerpHue(h1, h2, weight)
+		return ch
+	}
+
+	switch space {
+	case HSLSpace:
+		h1, s1, l1 := c.HSL()
+		h2, s2, l2 := other.HSL()
+		ch := polar([3]float64{h1, s1, l1}, [3]float64{h2, s2, l2}, s1, s2, 0)
+		return FromHSL(ch[0], ch[1], ch[2]).WithAlpha(alpha), nil
+
+	case HWBSpace:
+		h1, w1, b1 := c.HWB()
+		h2, w2, b2 := other.HWB()
+		ch := polar([3]float64{h1, w1, b1}, [3]float64{h2, w2, b2}, 100-w1-b1, 100-w2-b2, 0)
+		return fromHWB(ch[0], ch[1], ch[2]).WithAlpha(alpha), nil
+
+	case LCHSpace:
+		l1, c1, h1 := lch(toLab(c))
+		l2, c2, h2 := lch(toLab(other))
+		ch := polar([3]float64{l1, c1, h1}, [3]float64{l2, c2, h2}, c1, c2, 2)
+		rad := ch[2] * math.Pi / 180
+		return fromLab(ch[0], ch[1]*math.Cos(rad), ch[1]*math.Sin(rad)).WithAlpha(alpha), nil
+
+	case OKLCHSpace:
+		l1, c1, h1 := c.OKLCH()
+		l2, c2, h2 := other.OKLCH()
+		ch := polar([3]float64{l1, c1, h1}, [3]float64{l2, c2, h2}, c1, c2, 2)
+		return FromOKLCH(ch[0], ch[1], ch[2]).WithAlpha(alpha), nil
+	}
+
+	a, ok := toColorSpace(c, space)
+	if !ok {
+		return RGBColor{}, invalidColor(string(space), "unknown color space")
+	}
+	b, _ := toColorSpace(other, space)
+
+	mixed, err := fromColorSpace(string(space), space, premultiplied(a, b))
+	return mixed.WithAlpha(alpha), err
+}
+
+// lch converts rectangular Lab coordinates into lightness, chroma and hue (degrees).
+func lch(l, a, b float64) (float64, float64, float64) {
+	return l, math.Hypot(a, b), math.Mod(math.Atan2(b, a)*180/math.Pi+360, 360)
+}
+
+// HSL returns the hue (degrees), saturation and lightness (0–100) of the color.
+func (c RGBColor) HSL() (h, s, l float64) {
+	maxC := math.Max(c.R, math.Max(c.G, c.B))
+	minC := math.Min(c.R, math.Min(c.G, c.B))
+	l = (maxC + minC) / 2
+	d := maxC - minC
+
+	if d != 0 {
+		if l == 0 || l == 1 {
+			s = 0
+		} else {
+			s = d / (1 - math.Abs(2*l-1))
+		}
+
+		switch maxC {
+		case c.R:
+			h = math.Mod((c.G-c.B)/d+6, 6)
+		case c.G:
+			h = (c.B-c.R)/d + 2
+		default:
+			h = (c.R-c.G)/d + 4
+		}
+		h *= 60
+	}
+
+	return h, s * 100, l * 100
+}
+
+// HWB returns the hue (degrees), whiteness and blackness (0–100) of the color.
+func (c RGBColor) HWB() (h, w, b float64) {
+	h, _, _ = c.HSL()
+	return h, math.Min(c.R, math.Min(c.G, c.B)) * 100, (1 - math.Max(c.R, math.Max(c.G, c.B))) * 100
+}
+
+// FromHSL creates an opaque RGBColor from a hue (degrees), saturation and lightness (0–100).
+func FromHSL(h, s, l float64) RGBColor {
+	h = math.Mod(math.Mod(h, 360)+360, 360)
+	s, l = s/100, l/100
+
+	f := func(n float64) float64 {
+		k := math.Mod(n+h/30, 12)
+		a := s * math.Min(l, 1-l)
+		return l - a*math.Max(-1, math.Min(k-3, math.Min(9-k, 1)))
+	}
+
+	return RGBColor{R: f(0), G: f(8), B: f(4), Alpha: 1}
+}
+
+// OKLab returns the OKLab lightness (0.0–1.0) and a/b axes of the color.
+func (c RGBColor) OKLab() (l, a, b float64) {
+	r, g, bl := toLinear(c.R), toLinear(c.G), toLinear(c.B)
+
+	lms1 := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*bl)
+	lms2 := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*bl)
+	lms3 := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*bl)
+
+	l = 0.2104542553*lms1 + 0.7936177850*lms2 - 0.0040720468*lms3
+	a = 1.9779984951*lms1 - 2.4285922050*lms2 + 0.4505937099*lms3
+	b = 0.0259040371*lms1 + 0.7827717662*lms2 - 0.8086757660*lms3
+	return l, a, b
+}
+
+// FromOKLab creates an opaque RGBColor from OKLab coordinates.
+func FromOKLab(l, a, b float64) RGBColor {
+	lms1 := math.Pow(l+0.3963377774*a+0.2158037573*b, 3)
+	lms2 := math.Pow(l-0.1055613458*a-0.0638541728*b, 3)
+	lms3 := math.Pow(l-0.0894841775*a-1.2914855480*b, 3)
+
+	return RGBColor{
+		R:     fromLinear(4.0767416621*lms1 - 3.3077115913*lms2 + 0.2309699292*lms3),
+		G:     fromLinear(-1.2684380046*lms1 + 2.6097574011*lms2 - 0.3413193965*lms3),
+		B:     fromLinear(-0.0041960863*lms1 - 0.7034186147*lms2 + 1.7076147010*lms3),
+		Alpha: 1,
+	}
+}
+
+// OKLCH returns the OKLCH lightness (0.0–1.0), chroma and hue (degrees) of the color.
+func (c RGBColor) OKLCH() (l, ch, h float64) {
+	l, a, b := c.OKLab()
+	ch = math.Hypot(a, b)
+	h = math.Mod(math.Atan2(b, a)*180/math.Pi+360, 360)
+	return l, ch, h
+}
+
+// FromOKLCH creates an opaque RGBColor from OKLCH coordinates.
+func FromOKLCH(l, ch, h float64) RGBColor {
+	rad := h * math.Pi / 180
+	return FromOKLab(l, ch*math.Cos(rad), ch*math.Sin(rad))
+}
+
+// ColorMix generates a `color-mix()` ColorFunction that lets the browser mix two colors.
+// Example: ColorMix(OKLCHSpace, Blue, 40, White, 60) -> "color-mix(in oklch, blue 40%, white 60%)"
+//
+// Parameters:
+// - `space` (ColorSpace): The interpolation color space (e.g., SRGB, OKLCHSpace).
+// - `a`, `b` (ColorValue): The colors to mix.
+// - `pa`, `pb` (float64): The percentages (0–100) of each color.
+func ColorMix(space ColorSpace, a ColorValue, pa float64, b ColorValue, pb float64) ColorFunction {
+	return colorFunction("color-mix",
+		fmt.Sprintf("in %s, ", space), a, fmt.Sprintf(" %g%%, ", pa), b, fmt.Sprintf(" %g%%", pb))
+}
+
+// toLinear converts a gamma encoded sRGB channel into linear light.
+func toLinear(c float64) float64 {
+	abs := math.Abs(c)
+	if abs <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Copysign(math.Pow((abs+0.055)/1.055, 2.4), c)
+}
+
+// fromLinear converts a linear light channel into gamma encoded sRGB.
+func fromLinear(c float64) float64 {
+	abs := math.Abs(c)
+	if abs <= 0.0031308 {
+		return c * 12.92
+	}
+	return math.Copysign(1.055*math.Pow(abs, 1/2.4)-0.055, c)
+}
+
+// toByte converts a normalised channel into a 0–255 integer, clipping it to the gamut.
+func toByte(c float64) int {
+	return int(math.Round(clamp(c, 0, 1) * 255))
+}
+
+func clamp(v, lo, hi float64) float64 {
+	return math.Max(lo, math.Min(hi, v))
+}
+
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
+
+// lerpHue interpolates between two hues along the shorter arc.
+func lerpHue(h1, h2, t float64) float64 {
+	d := math.Mod(h2-h1+540, 360) - 180
+	return math.Mod(h1+d*t+360, 360)
+}
+
+// powerlessHue makes the hue of an achromatic color follow the other color,
+// so that mixing with white, black or gray does not introduce a hue shift.
+func powerlessHue(h1, c1, h2, c2 float64) (float64, float64) {
+	const epsilon = 1e-4
+	switch {
+	case c1 < epsilon && c2 >= epsilon:
+		return h2, h2
+	case c2 < epsilon && c1 >= epsilon:
+		return h1, h1
+	}
+	return h1, h2
+}
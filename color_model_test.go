@@ -0,0 +1,164 @@
+package cssgo
+
+import (
+	"errors"
+	"io"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestRGBColor(t *testing.T) {
+	brand := MustRGB(Hex(0x3366ff))
+
+	RunTests(t,
+		test{"opaque renders as hex", brand, "#3366ff"},
+		test{"with alpha renders as rgba", brand.WithAlpha(0.5), "rgba(51, 102, 255, 0.5)"},
+		test{"lighten by zero is unchanged", brand.Lighten(0), "#3366ff"},
+		test{"lighten", brand.Lighten(0.1), "#4e88ff"},
+		test{"darken", brand.Darken(0.1), "#1a43dc"},
+		test{"saturate", brand.Saturate(0.2), "#265aff"},
+		test{"desaturate fully", brand.Desaturate(1), "#787878"},
+		test{"from hsl", FromHSL(120, 100, 25), "#008000"},
+		test{"resolve hsl", MustRGB(HSL(200, 50, 50)), "#4095bf"},
+		test{"resolve modern rgb", MustRGB(Color("rgb(255 0 0 / 50%)")), "rgba(255, 0, 0, 0.5)"},
+		test{"resolve oklch", MustRGB(OKLCH(0.628, 0.2577, 29.23)), "#ff0000"},
+		test{"resolve short hex with alpha", MustRGB(Color("#f008")), "rgba(255, 0, 0, 0.533)"},
+		test{"as property value", BackgroundColor(brand.Darken(0.1)), "background-color: #1a43dc;"},
+	)
+}
+
+func TestRGBColorMix(t *testing.T) {
+	red, blue := MustRGB(Red), MustRGB(Blue)
+	mix := func(a, b RGBColor, weight float64, space ColorSpace) RGBColor {
+		mixed, err := a.Mix(b, weight, space)
+		if err != nil {
+			t.Fatalf("Mix in %s: unexpected error %v", space, err)
+		}
+		return mixed
+	}
+
+	RunTests(t,
+		test{"srgb", mix(red, blue, 0.5, SRGB), "#800080"},
+		test{"srgb-linear", mix(MustRGB(White), MustRGB(Black), 0.5, SRGBLinear), "#bcbcbc"},
+		test{"oklab", mix(MustRGB(White), MustRGB(Black), 0.5, OKLabSpace), "#636363"},
+		test{"oklch", mix(red, blue, 0.5, OKLCHSpace), "#ba00c2"},
+		test{"hsl", mix(MustRGB(HSL(120, 10, 20)), MustRGB(HSL(30, 30, 40)), 0.5, HSLSpace), "#545c3d"},
+		test{"hwb", mix(MustRGB(HWB(120, 10, 20)), MustRGB(HWB(30, 30, 40)), 0.5, HWBSpace), "#93b333"},
+		test{"hwb with gray", mix(MustRGB(HWB(0, 100, 100)), red, 0.5, HWBSpace), "#bf4040"},
+		test{"weight zero", mix(red, blue, 0, OKLCHSpace), "#ff0000"},
+		test{"premultiplied alpha", mix(red, blue.WithAlpha(0), 0.5, SRGB), "rgba(255, 0, 0, 0.5)"},
+	)
+
+	if _, err := red.Mix(blue, 0.5, ColorSpace("cmyk")); !errors.Is(err, ErrInvalidColor) {
+		t.Fatalf("Mix in cmyk: got %v, want ErrInvalidColor", err)
+	}
+}
+
+func TestRGBColorMixSpaces(t *testing.T) {
+	rectangular := []ColorSpace{
+		SRGB, SRGBLinear, DisplayP3, A98RGB, ProPhotoRGB, Rec2020, XYZ, XYZD50, XYZD65, LabSpace, OKLabSpace,
+	}
+	polar := map[ColorSpace]func(a, b, c float64) RGBColor{
+		HSLSpace: FromHSL,
+		HWBSpace: fromHWB,
+		LCHSpace: func(l, c, h float64) RGBColor {
+			return fromLab(l, c*math.Cos(h*math.Pi/180), c*math.Sin(h*math.Pi/180))
+		},
+		OKLCHSpace: FromOKLCH,
+	}
+
+	// Mixing interpolates the channels of the space, which are chosen so that
+	// the colors are inside the sRGB gamut in every space.
+	for _, space := range rectangular {
+		from := func(ch [3]float64) RGBColor {
+			if space == LabSpace {
+				ch = [3]float64{ch[0] * 100, ch[1]*20 - 5, ch[2]*20 - 5}
+			}
+			if space == OKLabSpace {
+				ch = [3]float64{ch[0], ch[1]/5 - 0.05, ch[2]/5 - 0.05}
+			}
+			c, err := fromColorSpace(string(space), space, ch)
+			if err != nil {
+				t.Fatalf("TESTCASE %s: FAIL\nunexpected error %v", space, err)
+			}
+			return c
+		}
+
+		mixed, err := from([3]float64{0.2, 0.3, 0.4}).Mix(from([3]float64{0.4, 0.5, 0.6}), 0.25, space)
+		if err != nil {
+			t.Fatalf("TESTCASE %s: FAIL\nunexpected error %v", space, err)
+		}
+		want := from([3]float64{0.25, 0.35, 0.45})
+		if !closeRGB(mixed, want) {
+			t.Fatalf("TESTCASE %s: FAIL\ngot: %v != want: %v", space, mixed, want)
+		}
+	}
+
+	for space, from := range polar {
+		a, b := [3]float64{40, 30, 40}, [3]float64{100, 20, 30}
+		if space == LCHSpace || space == OKLCHSpace {
+			a, b = [3]float64{40, 10, 40}, [3]float64{60, 20, 100}
+			if space == OKLCHSpace {
+				a, b = [3]float64{0.4, 0.05, 40}, [3]float64{0.6, 0.1, 100}
+			}
+		}
+
+		mixed, err := from(a[0], a[1], a[2]).Mix(from(b[0], b[1], b[2]), 0.5, space)
+		if err != nil {
+			t.Fatalf("TESTCASE %s: FAIL\nunexpected error %v", space, err)
+		}
+		want := from((a[0]+b[0])/2, (a[1]+b[1])/2, (a[2]+b[2])/2)
+		if !closeRGB(mixed, want) {
+			t.Fatalf("TESTCASE %s: FAIL\ngot: %v != want: %v", space, mixed, want)
+		}
+	}
+}
+
+// closeRGB reports whether two colors are equal up to rounding errors.
+func closeRGB(a, b RGBColor) bool {
+	const epsilon = 1e-6
+	return math.Abs(a.R-b.R) < epsilon && math.Abs(a.G-b.G) < epsilon &&
+		math.Abs(a.B-b.B) < epsilon && math.Abs(a.Alpha-b.Alpha) < epsilon
+}
+
+func TestRGBColorConversions(t *testing.T) {
+	l, c, h := MustRGB(Red).OKLCH()
+	if math.Abs(l-0.628) > 1e-3 || math.Abs(c-0.2577) > 1e-3 || math.Abs(h-29.23) > 1e-2 {
+		t.Fatalf("red in oklch: got (%g, %g, %g)", l, c, h)
+	}
+
+	h, s, l := MustRGB(Red).HSL()
+	if h != 0 || s != 100 || l != 50 {
+		t.Fatalf("red in hsl: got (%g, %g, %g)", h, s, l)
+	}
+}
+
+func TestToRGBErrors(t *testing.T) {
+	for _, value := range []ColorValue{Color("#12345"), Color("rgb(1 2)"), Color("blurple"), Var("brand"), Inherit} {
+		if _, err := ToRGB(value); !errors.Is(err, ErrInvalidColor) {
+			t.Fatalf("ToRGB(%v): got %v, want ErrInvalidColor", value, err)
+		}
+	}
+}
+
+func TestColorMix(t *testing.T) {
+	RunTests(t,
+		test{"oklch", ColorMix(OKLCHSpace, Blue, 40, White, 60), "color-mix(in oklch, blue 40%, white 60%)"},
+		test{"srgb", ColorMix(SRGB, Hex(0xff0000), 50, Var("brand"), 50), "color-mix(in srgb, #ff0000 50%, var(--brand) 50%)"},
+	)
+}
+
+func TestColorMixErrors(t *testing.T) {
+	errColor := errors.New("color failed")
+	failing := ColorFunction(func(w io.Writer) error { return errColor })
+
+	var b strings.Builder
+	if err := ColorMix(SRGB, failing, 50, White, 50).RenderCSS(&b); !errors.Is(err, errColor) {
+		t.Fatalf("got %v, want %v", err, errColor)
+	}
+
+	if err := Validate(TextColor(ColorMix(OKLCHSpace, White, 50, Color("blurple"), 50))); !errors.Is(err, ErrInvalidColor) {
+		t.Fatalf("got %v, want ErrInvalidColor", err)
+	}
+}
@@ -0,0 +1,566 @@
+package cssgo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidColor is returned when a color cannot be parsed or resolved.
+var ErrInvalidColor = errors.New("cssgo: invalid color")
+
+// invalidColor wraps ErrInvalidColor with the offending input and a reason.
+func invalidColor(input, reason string) error {
+	return fmt.Errorf("%w %q: %s", ErrInvalidColor, input, reason)
+}
+
+// colorFuncs lists the functional color notations that can be resolved.
+var colorFuncs = map[string]bool{
+	"rgb":   true,
+	"rgba":  true,
+	"hsl":   true,
+	"hsla":  true,
+	"hwb":   true,
+	"lab":   true,
+	"lch":   true,
+	"oklch": true,
+	"oklab": true,
+	"color": true,
+}
+
+// legacyColorFuncs lists the functions that also accept the comma separated syntax.
+var legacyColorFuncs = map[string]bool{
+	"rgb":  true,
+	"rgba": true,
+	"hsl":  true,
+	"hsla": true,
+}
+
+// colorArg is a single component of a functional color notation,
+// e.g. `50%`, `120deg`, `0.3` or `none`.
+type colorArg struct {
+	value float64
+	unit  string
+	none  bool
+}
+
+// colorFunc is a parsed functional color notation, e.g. `rgb(255 0 0 / 50%)`.
+type colorFunc struct {
+	name     string
+	args     []colorArg
+	alpha    colorArg
+	hasAlpha bool
+	legacy   bool
+	space    ColorSpace
+}
+
+// errUnresolvedColor is returned for valid colors that depend on where they are used,
+// such as `currentcolor` or `light-dark()`, and therefore cannot be converted to RGB.
+var errUnresolvedColor = fmt.Errorf("%w: the color cannot be resolved statically", ErrInvalidColor)
+
+// ParseColor parses and validates a CSS color string.
+// It understands the named colors, `transparent`, `currentcolor`, 3, 4, 6 and 8 digit hex colors,
+// and the rgb(), rgba(), hsl(), hsla(), hwb(), lab(), lch(), oklab(), oklch(), color(),
+// light-dark() and color-mix() functions in both legacy and modern syntax where applicable.
+// The returned Color is trimmed and lower-cased, ready to be used as a ColorValue.
+// Invalid colors return an error wrapping ErrInvalidColor.
+// Use ToRGB on the result to obtain its sRGB channels.
+// Example: ParseColor(" #FFF ") -> "#fff"
+func ParseColor(s string) (Color, error) {
+	if _, err := parseColorString(s); err != nil && !errors.Is(err, errUnresolvedColor) {
+		return "", err
+	}
+	return Color(strings.ToLower(strings.TrimSpace(s))), nil
+}
+
+// parseColorString resolves a CSS color string into an RGBColor.
+func parseColorString(input string) (RGBColor, error) {
+	s := strings.ToLower(strings.TrimSpace(input))
+
+	switch s {
+	case "":
+		return RGBColor{}, invalidColor(input, "empty color")
+	case "transparent":
+		return RGBColor{}, nil
+	case "currentcolor":
+		return RGBColor{}, fmt.Errorf("%w %q", errUnresolvedColor, input)
+	}
+
+	if hex, ok := namedColors[Color(s)]; ok {
+		return rgbFromUint(hex, 1), nil
+	}
+
+	if strings.HasPrefix(s, "#") {
+		return parseHexColor(input, s[1:])
+	}
+
+	if strings.HasPrefix(s, "light-dark(") && strings.HasSuffix(s, ")") {
+		return parseLightDark(input, s[len("light-dark("):len(s)-1])
+	}
+
+	if strings.HasPrefix(s, "color-mix(") && strings.HasSuffix(s, ")") {
+		return parseColorMix(input, s[len("color-mix("):len(s)-1])
+	}
+
+	fn, err := parseColorFunc(input, s)
+	if err != nil {
+		return RGBColor{}, err
+	}
+
+	return fn.rgb(input)
+}
+
+// parseLightDark validates both colors of a light-dark() function.
+// The result depends on the color scheme, so it is never resolved.
+func parseLightDark(input, body string) (RGBColor, error) {
+	args := splitTopLevel(body, ',')
+	if len(args) != 2 {
+		return RGBColor{}, invalidColor(input, "light-dark() expects 2 colors")
+	}
+
+	for _, arg := range args {
+		if _, err := parseColorString(arg); err != nil && !errors.Is(err, errUnresolvedColor) {
+			return RGBColor{}, err
+		}
+	}
+
+	return RGBColor{}, fmt.Errorf("%w %q", errUnresolvedColor, input)
+}
+
+// mixSpaces lists the interpolation spaces accepted by color-mix(), which are the spaces
+// RGBColor.Mix implements. Polar spaces map to true, as they accept a hue interpolation method.
+var mixSpaces = map[ColorSpace]bool{
+	SRGB: false, SRGBLinear: false, DisplayP3: false, A98RGB: false, ProPhotoRGB: false, Rec2020: false,
+	XYZ: false, XYZD50: false, XYZD65: false, LabSpace: false, OKLabSpace: false,
+	HSLSpace: true, HWBSpace: true, LCHSpace: true, OKLCHSpace: true,
+}
+
+// hueMethods lists the hue interpolation methods of color-mix(). Only the default,
+// shorter hue, is implemented by RGBColor.Mix, so colors using the others are not resolved.
+var hueMethods = map[string]bool{"shorter hue": true, "longer hue": false, "increasing hue": false, "decreasing hue": false}
+
+// parseColorMix resolves a color-mix() function following the percentage
+// normalisation rules of the specification.
+func parseColorMix(input, body string) (RGBColor, error) {
+	args := splitTopLevel(body, ',')
+	if len(args) != 3 {
+		return RGBColor{}, invalidColor(input, "color-mix() expects a color space and 2 colors")
+	}
+
+	method := strings.Fields(args[0])
+	if len(method) < 2 || method[0] != "in" {
+		return RGBColor{}, invalidColor(input, "color-mix() expects \"in <color-space>\"")
+	}
+	space := ColorSpace(method[1])
+	polar, ok := mixSpaces[space]
+	if !ok {
+		return RGBColor{}, invalidColor(input, fmt.Sprintf("color-mix() does not support the color space %q", space))
+	}
+
+	resolved := true
+	if len(method) > 2 {
+		implemented, ok := hueMethods[strings.Join(method[2:], " ")]
+		if !polar || !ok {
+			return RGBColor{}, invalidColor(input, "color-mix() expects a hue interpolation method after a polar color space")
+		}
+		resolved = implemented
+	}
+
+	var colors [2]RGBColor
+	var percents [2]float64
+	var given [2]bool
+	for i, arg := range args[1:] {
+		color, percent, ok, err := parseMixArg(input, arg)
+		if err != nil {
+			return RGBColor{}, err
+		}
+		colors[i], percents[i], given[i] = color, percent, ok
+	}
+
+	switch {
+	case !given[0] && !given[1]:
+		percents = [2]float64{50, 50}
+	case !given[0]:
+		percents[0] = 100 - percents[1]
+	case !given[1]:
+		percents[1] = 100 - percents[0]
+	}
+
+	sum := percents[0] + percents[1]
+	if sum <= 0 {
+		return RGBColor{}, invalidColor(input, "color-mix() percentages must not sum to zero")
+	}
+
+	if !resolved {
+		return RGBColor{}, fmt.Errorf("%w %q", errUnresolvedColor, input)
+	}
+
+	mixed, err := colors[0].Mix(colors[1], percents[1]/sum, space)
+	if err != nil {
+		return RGBColor{}, err
+	}
+	if sum < 100 {
+		mixed.Alpha *= sum / 100
+	}
+
+	return mixed, nil
+}
+
+// parseMixArg parses a color-mix() argument, which is a color with an optional percentage before or after it.
+func parseMixArg(input, arg string) (RGBColor, float64, bool, error) {
+	fields := splitTopLevel(strings.TrimSpace(arg), ' ')
+
+	var percent string
+	switch {
+	case len(fields) > 1 && strings.HasSuffix(fields[len(fields)-1], "%"):
+		percent, fields = fields[len(fields)-1], fields[:len(fields)-1]
+	case len(fields) > 1 && strings.HasSuffix(fields[0], "%"):
+		percent, fields = fields[0], fields[1:]
+	}
+
+	color, err := parseColorString(strings.Join(fields, " "))
+	if err != nil {
+		return RGBColor{}, 0, false, err
+	}
+
+	if percent == "" {
+		return color, 0, false, nil
+	}
+
+	p, err := parseColorArg(input, percent)
+	if err != nil || p.none || p.value < 0 || p.value > 100 {
+		return RGBColor{}, 0, false, invalidColor(input, fmt.Sprintf("invalid percentage %q", percent))
+	}
+
+	return color, p.value, true, nil
+}
+
+// splitTopLevel splits s on sep, ignoring separators nested in parentheses.
+// Empty fields are dropped when splitting on spaces.
+func splitTopLevel(s string, sep byte) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case sep:
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	parts = append(parts, strings.TrimSpace(s[start:]))
+
+	if sep == ' ' {
+		fields := parts[:0]
+		for _, part := range parts {
+			if part != "" {
+				fields = append(fields, part)
+			}
+		}
+		return fields
+	}
+
+	return parts
+}
+
+// rgbFromUint converts a 0xrrggbb integer into an RGBColor.
+func rgbFromUint(hex uint32, alpha float64) RGBColor {
+	return RGBColor{
+		R:     float64(hex>>16&0xff) / 255,
+		G:     float64(hex>>8&0xff) / 255,
+		B:     float64(hex&0xff) / 255,
+		Alpha: alpha,
+	}
+}
+
+// parseHexColor parses the digits of a 3, 4, 6 or 8 digit hex color.
+func parseHexColor(input, digits string) (RGBColor, error) {
+	switch len(digits) {
+	case 3, 4:
+		expanded := make([]byte, 0, len(digits)*2)
+		for i := 0; i < len(digits); i++ {
+			expanded = append(expanded, digits[i], digits[i])
+		}
+		digits = string(expanded)
+	case 6, 8:
+	default:
+		return RGBColor{}, invalidColor(input, "hex colors must have 3, 4, 6 or 8 digits")
+	}
+
+	n, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil {
+		return RGBColor{}, invalidColor(input, "invalid hex digits")
+	}
+
+	if len(digits) == 8 {
+		return rgbFromUint(uint32(n>>8), float64(n&0xff)/255), nil
+	}
+
+	return rgbFromUint(uint32(n), 1), nil
+}
+
+// parseColorFunc splits a functional color notation into its components.
+// Both the legacy comma separated syntax and the modern space separated
+// syntax with an optional `/ alpha` are accepted.
+func parseColorFunc(input, s string) (colorFunc, error) {
+	open := strings.IndexByte(s, '(')
+	if open <= 0 || !strings.HasSuffix(s, ")") {
+		return colorFunc{}, invalidColor(input, "unknown color")
+	}
+
+	fn := colorFunc{name: strings.TrimSpace(s[:open])}
+	if !colorFuncs[fn.name] {
+		return colorFunc{}, invalidColor(input, fmt.Sprintf("unsupported color function %s()", fn.name))
+	}
+	body := s[open+1 : len(s)-1]
+
+	if fn.name == "color" {
+		fields := strings.Fields(body)
+		if len(fields) == 0 {
+			return colorFunc{}, invalidColor(input, "color() expects a color space")
+		}
+		fn.space = ColorSpace(fields[0])
+		if !predefinedSpaces[fn.space] {
+			return colorFunc{}, invalidColor(input, fmt.Sprintf("%q is not a predefined color space", fn.space))
+		}
+		body = strings.TrimSpace(body)[len(fields[0]):]
+	}
+
+	var parts []string
+	if strings.Contains(body, ",") {
+		if !legacyColorFuncs[fn.name] {
+			return colorFunc{}, invalidColor(input, fmt.Sprintf("%s() does not support commas", fn.name))
+		}
+		fn.legacy = true
+		if strings.Contains(body, "/") {
+			return colorFunc{}, invalidColor(input, "cannot mix commas and slash")
+		}
+		for _, part := range strings.Split(body, ",") {
+			parts = append(parts, strings.TrimSpace(part))
+		}
+		if len(parts) == 4 {
+			fn.hasAlpha = true
+		}
+	} else {
+		channels, alpha, found := strings.Cut(body, "/")
+		parts = strings.Fields(channels)
+		if found {
+			fn.hasAlpha = true
+			alphaParts := strings.Fields(alpha)
+			if len(alphaParts) != 1 {
+				return colorFunc{}, invalidColor(input, "expected a single alpha value after '/'")
+			}
+			parts = append(parts, alphaParts[0])
+		}
+	}
+
+	for i, part := range parts {
+		arg, err := parseColorArg(input, part)
+		if err != nil {
+			return colorFunc{}, err
+		}
+		if fn.legacy && arg.none {
+			return colorFunc{}, invalidColor(input, "none is not allowed in the legacy syntax")
+		}
+		if fn.hasAlpha && i == len(parts)-1 {
+			fn.alpha = arg
+			break
+		}
+		fn.args = append(fn.args, arg)
+	}
+
+	return fn, nil
+}
+
+// parseColorArg parses a number with an optional unit, or the `none` keyword.
+func parseColorArg(input, s string) (colorArg, error) {
+	if s == "none" {
+		return colorArg{none: true}, nil
+	}
+
+	end := numberPrefix(s)
+	if end == 0 {
+		return colorArg{}, invalidColor(input, fmt.Sprintf("invalid component %q", s))
+	}
+
+	value, err := strconv.ParseFloat(s[:end], 64)
+	if err != nil {
+		return colorArg{}, invalidColor(input, fmt.Sprintf("invalid component %q", s))
+	}
+
+	unit := s[end:]
+	switch unit {
+	case "", "%", "deg", "rad", "grad", "turn":
+	default:
+		return colorArg{}, invalidColor(input, fmt.Sprintf("invalid unit in %q", s))
+	}
+
+	return colorArg{value: value, unit: unit}, nil
+}
+
+// numberPrefix returns the length of the CSS <number> at the start of s,
+// or 0 if s does not start with a number.
+func numberPrefix(s string) int {
+	i := 0
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+
+	digits := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+		digits++
+	}
+
+	if i < len(s) && s[i] == '.' {
+		i++
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+			digits++
+		}
+	}
+
+	if digits == 0 {
+		return 0
+	}
+
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+		if j < len(s) && s[j] >= '0' && s[j] <= '9' {
+			for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+				j++
+			}
+			i = j
+		}
+	}
+
+	return i
+}
+
+// number returns the value of a plain number or percentage component,
+// where 100% corresponds to `percent`. `none` resolves to zero.
+func (a colorArg) number(input string, percent float64) (float64, error) {
+	switch {
+	case a.none:
+		return 0, nil
+	case a.unit == "":
+		return a.value, nil
+	case a.unit == "%":
+		return a.value / 100 * percent, nil
+	default:
+		return 0, invalidColor(input, "unexpected unit "+a.unit)
+	}
+}
+
+// hue returns the value of a hue component in degrees.
+func (a colorArg) hue(input string) (float64, error) {
+	switch a.unit {
+	case "", "deg":
+		if a.none {
+			return 0, nil
+		}
+		return a.value, nil
+	case "rad":
+		return a.value * 180 / math.Pi, nil
+	case "grad":
+		return a.value * 0.9, nil
+	case "turn":
+		return a.value * 360, nil
+	default:
+		return 0, invalidColor(input, "hue must be a number or an angle")
+	}
+}
+
+// alphaValue returns the alpha of the color, defaulting to fully opaque.
+func (fn colorFunc) alphaValue(input string) (float64, error) {
+	if !fn.hasAlpha {
+		return 1, nil
+	}
+	return fn.alpha.number(input, 1)
+}
+
+// argReader reads color components, keeping the first error encountered.
+type argReader struct {
+	input string
+	err   error
+}
+
+func (r *argReader) number(a colorArg, percent float64) float64 {
+	v, err := a.number(r.input, percent)
+	if r.err == nil {
+		r.err = err
+	}
+	return v
+}
+
+func (r *argReader) hue(a colorArg) float64 {
+	v, err := a.hue(r.input)
+	if r.err == nil {
+		r.err = err
+	}
+	return v
+}
+
+// rgb converts the parsed function into an RGBColor.
+func (fn colorFunc) rgb(input string) (RGBColor, error) {
+	if len(fn.args) != 3 {
+		return RGBColor{}, invalidColor(input, fmt.Sprintf("%s() expects 3 components", fn.name))
+	}
+
+	alpha, err := fn.alphaValue(input)
+	if err != nil {
+		return RGBColor{}, err
+	}
+
+	r := argReader{input: input}
+	a := fn.args
+
+	var c RGBColor
+	switch fn.name {
+	case "rgb", "rgba":
+		if fn.legacy && (a[0].unit != a[1].unit || a[1].unit != a[2].unit) {
+			return RGBColor{}, invalidColor(input, "the legacy syntax cannot mix numbers and percentages")
+		}
+		c = RGBColor{R: r.number(a[0], 255) / 255, G: r.number(a[1], 255) / 255, B: r.number(a[2], 255) / 255}
+	case "hsl", "hsla":
+		if fn.legacy && (a[1].unit != "%" || a[2].unit != "%") {
+			return RGBColor{}, invalidColor(input, "the legacy syntax requires percentages for saturation and lightness")
+		}
+		c = FromHSL(r.hue(a[0]), r.number(a[1], 100), r.number(a[2], 100))
+	case "hwb":
+		c = fromHWB(r.hue(a[0]), r.number(a[1], 100), r.number(a[2], 100))
+	case "lab":
+		c = fromLab(r.number(a[0], 100), r.number(a[1], 125), r.number(a[2], 125))
+	case "lch":
+		l, ch, h := r.number(a[0], 100), r.number(a[1], 150), r.hue(a[2])
+		rad := h * math.Pi / 180
+		c = fromLab(l, ch*math.Cos(rad), ch*math.Sin(rad))
+	case "oklch":
+		c = FromOKLCH(r.number(a[0], 1), r.number(a[1], 0.4), r.hue(a[2]))
+	case "oklab":
+		c = FromOKLab(r.number(a[0], 1), r.number(a[1], 0.4), r.number(a[2], 0.4))
+	case "color":
+		c, err = fromColorSpace(input, fn.space, [3]float64{r.number(a[0], 1), r.number(a[1], 1), r.number(a[2], 1)})
+		if err != nil {
+			return RGBColor{}, err
+		}
+	default:
+		return RGBColor{}, invalidColor(input, fmt.Sprintf("unsupported color function %s()", fn.name))
+	}
+
+	if r.err != nil {
+		return RGBColor{}, r.err
+	}
+
+	return c.WithAlpha(alpha), nil
+}
@@ -0,0 +1,173 @@
+package cssgo
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseColor(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Color
+		rgb   string
+	}{
+		{"named color", "AliceBlue", AliceBlue, "#f0f8ff"},
+		{"transparent", "transparent", "transparent", "rgba(0, 0, 0, 0)"},
+		{"currentcolor", "currentColor", "currentcolor", ""},
+		{"3 digit hex", " #FFF ", "#fff", "#ffffff"},
+		{"4 digit hex", "#f008", "#f008", "rgba(255, 0, 0, 0.533)"},
+		{"6 digit hex", "#123ABC", "#123abc", "#123abc"},
+		{"8 digit hex", "#ff000080", "#ff000080", "rgba(255, 0, 0, 0.502)"},
+		{"legacy rgb", "rgb(255, 0, 0)", "rgb(255, 0, 0)", "#ff0000"},
+		{"legacy rgba", "rgba(255, 0, 0, 0.5)", "rgba(255, 0, 0, 0.5)", "rgba(255, 0, 0, 0.5)"},
+		{"modern rgb", "rgb(100% 0% 0% / 50%)", "rgb(100% 0% 0% / 50%)", "rgba(255, 0, 0, 0.5)"},
+		{"legacy hsl", "hsl(120, 100%, 25%)", "hsl(120, 100%, 25%)", "#008000"},
+		{"modern hsl with angle", "hsl(0.5turn 100% 50% / 1)", "hsl(0.5turn 100% 50% / 1)", "#00ffff"},
+		{"hwb", "hwb(194 0% 0%)", "hwb(194 0% 0%)", "#00c3ff"},
+		{"lab", "lab(54.29 80.8 69.89)", "lab(54.29 80.8 69.89)", "#ff0000"},
+		{"lch", "lch(54.29 106.84 40.85)", "lch(54.29 106.84 40.85)", "#ff0000"},
+		{"oklab", "oklab(0.628 0.2249 0.1258)", "oklab(0.628 0.2249 0.1258)", "#ff0000"},
+		{"oklch with none", "oklch(62.8% 0.2577 29.23 / none)", "oklch(62.8% 0.2577 29.23 / none)", "rgba(255, 0, 0, 0)"},
+		{"color display-p3", "color(display-p3 1 0 0)", "color(display-p3 1 0 0)", "#ff0000"},
+		{"color srgb-linear", "color(srgb-linear 0.2 0.2 0.2)", "color(srgb-linear 0.2 0.2 0.2)", "#7c7c7c"},
+		{"color rec2020", "color(rec2020 1 0 0)", "color(rec2020 1 0 0)", "#ff0000"},
+		{"light-dark", "light-dark(white, #000)", "light-dark(white, #000)", ""},
+		{"color-mix", "color-mix(in srgb, red, blue)", "color-mix(in srgb, red, blue)", "#800080"},
+		{"color-mix under 100%", "color-mix(in srgb, red 20%, blue 20%)", "color-mix(in srgb, red 20%, blue 20%)", "rgba(128, 0, 128, 0.4)"},
+	}
+
+	for _, test := range tests {
+		func(t2 *testing.T) {
+			got, err := ParseColor(test.input)
+			if err != nil {
+				t2.Fatalf("TESTCASE %s: unexpected error: %v", test.name, err)
+			}
+			if got != test.want {
+				t2.Fatalf("TESTCASE %s: FAIL\ngot: %s != want: %s", test.name, got, test.want)
+			}
+
+			rgb, err := ToRGB(got)
+			if test.rgb == "" {
+				if !errors.Is(err, ErrInvalidColor) {
+					t2.Fatalf("TESTCASE %s: expected ErrInvalidColor from ToRGB, got %v", test.name, err)
+				}
+				return
+			}
+			if err != nil {
+				t2.Fatalf("TESTCASE %s: unexpected ToRGB error: %v", test.name, err)
+			}
+			if rgb.String() != test.rgb {
+				t2.Fatalf("TESTCASE %s: FAIL\ngot: %s != want: %s", test.name, rgb, test.rgb)
+			}
+		}(t)
+	}
+}
+
+func TestParseColorErrors(t *testing.T) {
+	for _, input := range []string{
+		"",
+		"blurple",
+		"#12345",
+		"#ggg",
+		"rgb(1 2)",
+		"rgb(1 2 3 4)",
+		"rgb(1, 2, none)",
+		"rgb(1, 2, 3 / 1)",
+		"lab(1, 2, 3)",
+		"hsl(10px 50% 50%)",
+		"rgb(1e 2 3)",
+		"color(foo 1 2 3)",
+		"color(hsl 0.1 0.2 0.3)",
+		"color(oklab 0.5 0.1 0.1)",
+		"light-dark(white)",
+		"light-dark(white, bluee)",
+		"color-mix(in foo, red, blue)",
+		"color-mix(in srgb, red 0%, blue 0%)",
+		"color-mix(in srgb longer hue, red, blue)",
+		"color-mix(in oklch wider hue, red, blue)",
+		"rgb(100%, 0, 0)",
+		"hsl(120, 50, 50)",
+		"hsla(120, 50%, 50, 0.5)",
+		"rgba(255, 0%, 0, 0.5)",
+		"var(--brand)",
+		"url(javascript:alert(1))",
+	} {
+		if _, err := ParseColor(input); !errors.Is(err, ErrInvalidColor) {
+			t.Fatalf("ParseColor(%q): got %v, want ErrInvalidColor", input, err)
+		}
+	}
+}
+
+// TestColorMixSpaces checks color-mix() in every interpolation space it accepts against the
+// computed values that browsers produce, which are written in the interpolation space.
+func TestColorMixSpaces(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"color-mix(in srgb, red, blue)", "rgb(127.5 0 127.5)"},
+		{"color-mix(in srgb, red 25%, blue)", "rgb(63.75 0 191.25)"},
+		{"color-mix(in srgb, color(srgb .1 .2 .3), color(srgb .5 .6 .7))", "color(srgb .3 .4 .5)"},
+		{"color-mix(in srgb-linear, color(srgb-linear .1 .2 .3), color(srgb-linear .5 .6 .7))", "color(srgb-linear .3 .4 .5)"},
+		{"color-mix(in display-p3, color(display-p3 .1 .2 .3), color(display-p3 .5 .6 .7))", "color(display-p3 .3 .4 .5)"},
+		{"color-mix(in a98-rgb, color(a98-rgb .1 .2 .3), color(a98-rgb .5 .6 .7))", "color(a98-rgb .3 .4 .5)"},
+		{"color-mix(in prophoto-rgb, color(prophoto-rgb .1 .2 .3), color(prophoto-rgb .5 .6 .7))", "color(prophoto-rgb .3 .4 .5)"},
+		{"color-mix(in rec2020, color(rec2020 .1 .2 .3), color(rec2020 .5 .6 .7))", "color(rec2020 .3 .4 .5)"},
+		{"color-mix(in xyz, color(xyz .1 .2 .3), color(xyz .5 .6 .7))", "color(xyz .3 .4 .5)"},
+		{"color-mix(in xyz-d50, color(xyz-d50 .1 .2 .3), color(xyz-d50 .5 .6 .7))", "color(xyz-d50 .3 .4 .5)"},
+		{"color-mix(in xyz-d65, color(xyz-d65 .1 .2 .3), color(xyz-d65 .5 .6 .7))", "color(xyz-d65 .3 .4 .5)"},
+		{"color-mix(in lab, lab(10 20 30), lab(50 60 70))", "lab(30 40 50)"},
+		{"color-mix(in lab, lab(10 20 30 / .4), lab(50 60 70 / .8))", "lab(36.666666667 46.666666667 56.666666667 / .6)"},
+		{"color-mix(in oklab, oklab(.1 .2 .3), oklab(.5 .6 .7))", "oklab(.3 .4 .5)"},
+		{"color-mix(in lch, lch(10 20 30deg), lch(50 60 70deg))", "lch(30 40 50)"},
+		{"color-mix(in lch, lch(10 20 30deg), lch(50 60 350deg))", "lch(30 40 10)"},
+		{"color-mix(in lch shorter hue, lch(10 20 30deg), lch(50 60 350deg))", "lch(30 40 10)"},
+		{"color-mix(in oklch, oklch(.1 .2 30deg), oklch(.5 .6 70deg))", "oklch(.3 .4 50)"},
+		{"color-mix(in hsl, hsl(120deg 10% 20%), hsl(30deg 30% 40%))", "hsl(75deg 20% 30%)"},
+		{"color-mix(in hsl, hsl(120deg 10% 20%), hsl(30deg 30% 40%))", "rgb(84 92 61)"},
+		{"color-mix(in hwb, hwb(120deg 10% 20%), hwb(30deg 30% 40%))", "hwb(75deg 20% 30%)"},
+		{"color-mix(in hwb, hwb(120deg 10% 20%), hwb(30deg 30% 40%))", "rgb(147 179 51)"},
+	}
+
+	for _, test := range tests {
+		got, err := parseColorString(test.input)
+		if err != nil {
+			t.Fatalf("TESTCASE %s: FAIL\nunexpected error %v", test.input, err)
+		}
+		want, err := parseColorString(test.want)
+		if err != nil {
+			t.Fatalf("TESTCASE %s: FAIL\nunexpected error %v", test.want, err)
+		}
+		// Browsers round rgb() channels to bytes, so compare up to half a byte.
+		const epsilon = 0.5 / 255
+		if math.Abs(got.R-want.R) > epsilon || math.Abs(got.G-want.G) > epsilon ||
+			math.Abs(got.B-want.B) > epsilon || math.Abs(got.Alpha-want.Alpha) > 1e-6 {
+			t.Fatalf("TESTCASE %s: FAIL\ngot: %v != want: %s", test.input, got, test.want)
+		}
+	}
+
+	for _, input := range []string{
+		"color-mix(in lch longer hue, red, blue)",
+		"color-mix(in hsl increasing hue, red, blue)",
+	} {
+		if _, err := ParseColor(input); err != nil {
+			t.Fatalf("ParseColor(%q): unexpected error %v", input, err)
+		}
+		if _, err := ToRGB(Color(input)); !errors.Is(err, ErrInvalidColor) {
+			t.Fatalf("ToRGB(%q): got %v, want ErrInvalidColor", input, err)
+		}
+	}
+}
+
+func TestParseColorAsValue(t *testing.T) {
+	c, err := ParseColor("RebeccaPurple")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	RunTests(t,
+		test{"parsed color as background", BackgroundColor(c), "background-color: rebeccapurple;"},
+	)
+}
@@ -0,0 +1,30 @@
+package cssgo
+
+import "io"
+
+// ColorSchemeValue defines an interface for CSS-compatible color-scheme values.
+// This ensures that any type implementing this interface can be rendered as a valid CSS color scheme.
+type ColorSchemeValue interface {
+	ValueNode
+	colorSchemeValue()
+}
+
+// ColorSchemeType represents a CSS color scheme keyword, such as "light", "dark" or "only".
+// It is a concrete type that implements the ColorSchemeValue interface.
+type ColorSchemeType string
+
+// Predefined color-scheme values as per the CSS specification.
+const (
+	NormalScheme ColorSchemeType = "normal"
+	LightScheme  ColorSchemeType = "light"
+	DarkScheme   ColorSchemeType = "dark"
+	OnlyScheme   ColorSchemeType = "only"
+)
+
+func (c ColorSchemeType) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(c))
+	return err
+}
+
+func (c ColorSchemeType) valueNode()        {}
+func (c ColorSchemeType) colorSchemeValue() {}
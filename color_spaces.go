@@ -0,0 +1,250 @@
+package cssgo
+
+import (
+	"fmt"
+	"math"
+)
+
+// matrix3 is a 3x3 matrix used to convert between linear color spaces.
+type matrix3 [3][3]float64
+
+func (m matrix3) apply(v [3]float64) [3]float64 {
+	var out [3]float64
+	for i := range m {
+		out[i] = m[i][0]*v[0] + m[i][1]*v[1] + m[i][2]*v[2]
+	}
+	return out
+}
+
+// inverse returns the inverse of the matrix, which converts in the opposite direction.
+func (m matrix3) inverse() matrix3 {
+	det := m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+		m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+		m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+
+	var out matrix3
+	for i := range out {
+		for j := range out[i] {
+			// The cofactor of m[j][i], from the rows and columns after j and i.
+			r1, r2 := (j+1)%3, (j+2)%3
+			c1, c2 := (i+1)%3, (i+2)%3
+			out[i][j] = (m[r1][c1]*m[r2][c2] - m[r1][c2]*m[r2][c1]) / det
+		}
+	}
+	return out
+}
+
+// Conversion matrices from the CSS Color Level 4 specification.
+var (
+	xyzD65ToLinearSRGB = matrix3{
+		{3.2409699419045226, -1.537383177570094, -0.4986107602930034},
+		{-0.9692436362808796, 1.8759675015077202, 0.04155505740717559},
+		{0.05563007969699366, -0.20397695888897652, 1.0569715142428786},
+	}
+	d50ToD65 = matrix3{
+		{0.955473421488075, -0.02309845494876471, 0.06325924320057072},
+		{-0.0283697093338637, 1.0099953980813041, 0.021041441191917323},
+		{0.012314014864481998, -0.020507649298898964, 1.330365926242124},
+	}
+	linearP3ToXYZ = matrix3{
+		{0.4865709486482162, 0.26566769316909306, 0.1982172852343625},
+		{0.2289745640697488, 0.6917385218365064, 0.079286914093745},
+		{0, 0.04511338185890264, 1.043944368900976},
+	}
+	linearA98ToXYZ = matrix3{
+		{0.5766690429101305, 0.1855582379065463, 0.1882286462349947},
+		{0.29734497525053605, 0.6273635662554661, 0.07529145849399788},
+		{0.02703136138641234, 0.07068885253582723, 0.9913375368376388},
+	}
+	linearProPhotoToXYZD50 = matrix3{
+		{0.7977666449006423, 0.13518129740053308, 0.0313477341283922},
+		{0.2880748288194013, 0.711835234241873, 0.00008993693872564},
+		{0, 0, 0.8251046025104602},
+	}
+	linearRec2020ToXYZ = matrix3{
+		{0.6369580483012914, 0.14461690358620832, 0.1688809751641721},
+		{0.2627002120112671, 0.6779980715188708, 0.05930171646986196},
+		{0, 0.028072693049087428, 1.060985057710791},
+	}
+)
+
+// Inverses of the conversion matrices, to convert colors into the other color spaces.
+var (
+	linearSRGBToXYZD65     = xyzD65ToLinearSRGB.inverse()
+	d65ToD50               = d50ToD65.inverse()
+	xyzToLinearP3          = linearP3ToXYZ.inverse()
+	xyzToLinearA98         = linearA98ToXYZ.inverse()
+	xyzD50ToLinearProPhoto = linearProPhotoToXYZD50.inverse()
+	xyzToLinearRec2020     = linearRec2020ToXYZ.inverse()
+)
+
+// d50White is the D50 reference white used by Lab and LCH.
+var d50White = [3]float64{0.3457 / 0.3585, 1, (1 - 0.3457 - 0.3585) / 0.3585}
+
+// fromXYZD65 converts CIE XYZ (D65) into an opaque RGBColor.
+func fromXYZD65(xyz [3]float64) RGBColor {
+	lin := xyzD65ToLinearSRGB.apply(xyz)
+	return RGBColor{R: fromLinear(lin[0]), G: fromLinear(lin[1]), B: fromLinear(lin[2]), Alpha: 1}
+}
+
+// toXYZD65 converts an RGBColor into CIE XYZ (D65).
+func toXYZD65(c RGBColor) [3]float64 {
+	return linearSRGBToXYZD65.apply([3]float64{toLinear(c.R), toLinear(c.G), toLinear(c.B)})
+}
+
+// toLab converts an RGBColor into CIE Lab (D50).
+func toLab(c RGBColor) (l, a, b float64) {
+	const (
+		kappa   = 24389.0 / 27
+		epsilon = 216.0 / 24389
+	)
+
+	xyz := d65ToD50.apply(toXYZD65(c))
+	var f [3]float64
+	for i := range f {
+		v := xyz[i] / d50White[i]
+		if v > epsilon {
+			f[i] = math.Cbrt(v)
+		} else {
+			f[i] = (kappa*v + 16) / 116
+		}
+	}
+
+	return 116*f[1] - 16, 500 * (f[0] - f[1]), 200 * (f[1] - f[2])
+}
+
+// fromLab converts CIE Lab (D50) into an opaque RGBColor.
+func fromLab(l, a, b float64) RGBColor {
+	const (
+		kappa   = 24389.0 / 27
+		epsilon = 216.0 / 24389
+	)
+
+	f1 := (l + 16) / 116
+	f0 := a/500 + f1
+	f2 := f1 - b/200
+
+	x := math.Pow(f0, 3)
+	if x <= epsilon {
+		x = (116*f0 - 16) / kappa
+	}
+	y := math.Pow(f1, 3)
+	if l <= kappa*epsilon {
+		y = l / kappa
+	}
+	z := math.Pow(f2, 3)
+	if z <= epsilon {
+		z = (116*f2 - 16) / kappa
+	}
+
+	xyz := [3]float64{x * d50White[0], y * d50White[1], z * d50White[2]}
+	return fromXYZD65(d50ToD65.apply(xyz))
+}
+
+// fromHWB converts a hue (degrees), whiteness and blackness (0–100) into an opaque RGBColor.
+func fromHWB(h, white, black float64) RGBColor {
+	white, black = white/100, black/100
+	if white+black >= 1 {
+		gray := white / (white + black)
+		return RGBColor{gray, gray, gray, 1}
+	}
+
+	c := FromHSL(h, 100, 50)
+	scale := 1 - white - black
+	return RGBColor{c.R*scale + white, c.G*scale + white, c.B*scale + white, 1}
+}
+
+// toColorSpace converts an RGBColor into the channels of a rectangular color space:
+// the predefined spaces of the color() function, LabSpace and OKLabSpace.
+// It is the inverse of fromColorSpace, and reports false for other spaces.
+func toColorSpace(c RGBColor, space ColorSpace) ([3]float64, bool) {
+	transfer := func(ch [3]float64, fn func(float64) float64) [3]float64 {
+		return [3]float64{fn(ch[0]), fn(ch[1]), fn(ch[2])}
+	}
+
+	switch space {
+	case SRGB:
+		return [3]float64{c.R, c.G, c.B}, true
+	case SRGBLinear:
+		return transfer([3]float64{c.R, c.G, c.B}, toLinear), true
+	case DisplayP3:
+		return transfer(xyzToLinearP3.apply(toXYZD65(c)), fromLinear), true
+	case A98RGB:
+		return transfer(xyzToLinearA98.apply(toXYZD65(c)), func(c float64) float64 {
+			return math.Copysign(math.Pow(math.Abs(c), 256.0/563), c)
+		}), true
+	case ProPhotoRGB:
+		return transfer(xyzD50ToLinearProPhoto.apply(d65ToD50.apply(toXYZD65(c))), func(c float64) float64 {
+			if math.Abs(c) < 1.0/512 {
+				return c * 16
+			}
+			return math.Copysign(math.Pow(math.Abs(c), 1/1.8), c)
+		}), true
+	case Rec2020:
+		const alpha, beta = 1.09929682680944, 0.018053968510807
+		return transfer(xyzToLinearRec2020.apply(toXYZD65(c)), func(c float64) float64 {
+			if math.Abs(c) < beta {
+				return c * 4.5
+			}
+			return math.Copysign(alpha*math.Pow(math.Abs(c), 0.45)-(alpha-1), c)
+		}), true
+	case XYZ, XYZD65:
+		return toXYZD65(c), true
+	case XYZD50:
+		return d65ToD50.apply(toXYZD65(c)), true
+	case LabSpace:
+		l, a, b := toLab(c)
+		return [3]float64{l, a, b}, true
+	case OKLabSpace:
+		l, a, b := c.OKLab()
+		return [3]float64{l, a, b}, true
+	}
+
+	return [3]float64{}, false
+}
+
+// fromColorSpace converts the channels of a color() function into an opaque RGBColor.
+func fromColorSpace(input string, space ColorSpace, ch [3]float64) (RGBColor, error) {
+	linear := func(transfer func(float64) float64) [3]float64 {
+		return [3]float64{transfer(ch[0]), transfer(ch[1]), transfer(ch[2])}
+	}
+
+	switch space {
+	case SRGB:
+		return RGBColor{ch[0], ch[1], ch[2], 1}, nil
+	case SRGBLinear:
+		return RGBColor{fromLinear(ch[0]), fromLinear(ch[1]), fromLinear(ch[2]), 1}, nil
+	case DisplayP3:
+		return fromXYZD65(linearP3ToXYZ.apply(linear(toLinear))), nil
+	case A98RGB:
+		return fromXYZD65(linearA98ToXYZ.apply(linear(func(c float64) float64 {
+			return math.Copysign(math.Pow(math.Abs(c), 563.0/256), c)
+		}))), nil
+	case ProPhotoRGB:
+		xyz := linearProPhotoToXYZD50.apply(linear(func(c float64) float64 {
+			if math.Abs(c) <= 16.0/512 {
+				return c / 16
+			}
+			return math.Copysign(math.Pow(math.Abs(c), 1.8), c)
+		}))
+		return fromXYZD65(d50ToD65.apply(xyz)), nil
+	case Rec2020:
+		const alpha, beta = 1.09929682680944, 0.018053968510807
+		return fromXYZD65(linearRec2020ToXYZ.apply(linear(func(c float64) float64 {
+			if math.Abs(c) < beta*4.5 {
+				return c / 4.5
+			}
+			return math.Copysign(math.Pow((math.Abs(c)+alpha-1)/alpha, 1/0.45), c)
+		}))), nil
+	case XYZ, XYZD65:
+		return fromXYZD65(ch), nil
+	case XYZD50:
+		return fromXYZD65(d50ToD65.apply(ch)), nil
+	case LabSpace:
+		return fromLab(ch[0], ch[1], ch[2]), nil
+	case OKLabSpace:
+		return FromOKLab(ch[0], ch[1], ch[2]), nil
+	}
+
+	return RGBColor{}, invalidColor(input, fmt.Sprintf("unknown color space %q", space))
+}
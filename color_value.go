@@ -3,6 +3,9 @@ package cssgo
 import (
 	"fmt"
 	"io"
+	"math"
+	"strconv"
+	"strings"
 )
 
 // ColorValue defines an interface for types representing CSS-compatible Color values.
@@ -13,6 +16,8 @@ type ColorValue interface {
 }
 
 // color represents a CSS color (e.g., "blue", "red", "#ffffff", "rgb(0, 40, 50)".
+// Out of range constructor arguments are kept, and reported or clamped when rendering
+// depending on the ValidationMode.
 type Color string
 
 // Named colors that follow the CSS standard.
@@ -161,6 +166,13 @@ const (
 )
 
 func (c Color) RenderCSS(w io.Writer) error {
+	if validationMode(w) == ValidationLenient {
+		c = clampedColors.get(c, clampColor)
+	}
+	if err := check(w, func() error { return validatedColors.get(c, validateColor) }); err != nil {
+		return err
+	}
+
 	_, err := w.Write([]byte(c))
 	return err
 }
@@ -228,3 +240,239 @@ func HSL(hue int, saturation, lightness float64) Color {
 func HSLA(hue int, saturation, lightness, alpha float64) Color {
 	return Color(fmt.Sprintf("hsla(%d, %g%%, %g%%, %g)", hue, saturation, lightness, alpha))
 }
+
+// LightDark generates a `light-dark()` Color value that resolves to `light` or `dark`
+// depending on the used color scheme of the element.
+// Example: LightDark(White, Black) -> "light-dark(white, black)"
+//
+// Parameters:
+// - `light` (ColorValue): The color used when the color scheme is light.
+// - `dark` (ColorValue): The color used when the color scheme is dark.
+func LightDark(light, dark ColorValue) ColorFunction {
+	return colorFunction("light-dark", light, ", ", dark)
+}
+
+// ColorFunction is a color computed by a CSS function from other colors, such as light-dark() or color-mix().
+// Its color operands are rendered when it is rendered, and their errors are returned.
+type ColorFunction func(io.Writer) error
+
+func (c ColorFunction) RenderCSS(w io.Writer) error {
+	return c(w)
+}
+
+func (c ColorFunction) valueNode()  {}
+func (c ColorFunction) colorValue() {}
+
+// colorFunction returns a ColorFunction rendering `name(` followed by the parts and `)`.
+// String parts are written as is, and ValueNode parts are rendered.
+// Example: colorFunction("light-dark", White, ", ", Black) -> "light-dark(white, black)"
+func colorFunction(name string, parts ...any) ColorFunction {
+	return ColorFunction(func(w io.Writer) error {
+		if _, err := io.WriteString(w, name+"("); err != nil {
+			return err
+		}
+
+		for _, part := range parts {
+			var err error
+			switch part := part.(type) {
+			case string:
+				_, err = io.WriteString(w, part)
+			case ValueNode:
+				err = part.RenderCSS(w)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := io.WriteString(w, ")")
+		return err
+	})
+}
+
+// ColorSpace represents a predefined CSS color space, as used by the `color()` function.
+type ColorSpace string
+
+// Predefined color spaces as per the CSS Color Level 4 specification.
+const (
+	SRGB        ColorSpace = "srgb"
+	SRGBLinear  ColorSpace = "srgb-linear"
+	DisplayP3   ColorSpace = "display-p3"
+	A98RGB      ColorSpace = "a98-rgb"
+	ProPhotoRGB ColorSpace = "prophoto-rgb"
+	Rec2020     ColorSpace = "rec2020"
+	XYZ         ColorSpace = "xyz"
+	XYZD50      ColorSpace = "xyz-d50"
+	XYZD65      ColorSpace = "xyz-d65"
+)
+
+// predefinedSpaces are the color spaces accepted by the `color()` function.
+var predefinedSpaces = map[ColorSpace]bool{
+	SRGB: true, SRGBLinear: true, DisplayP3: true, A98RGB: true, ProPhotoRGB: true,
+	Rec2020: true, XYZ: true, XYZD50: true, XYZD65: true,
+}
+
+// Additional color spaces that can only be used for interpolation, e.g. with ColorMix.
+const (
+	HSLSpace   ColorSpace = "hsl"
+	HWBSpace   ColorSpace = "hwb"
+	LabSpace   ColorSpace = "lab"
+	LCHSpace   ColorSpace = "lch"
+	OKLabSpace ColorSpace = "oklab"
+	OKLCHSpace ColorSpace = "oklch"
+)
+
+// NoneChannel returns the value used to render the `none` keyword for a missing color channel.
+// Any channel or alpha passed as NaN is rendered as `none`.
+// Example: OKLCH(0.7, 0, NoneChannel()) -> "oklch(0.7 0 none)"
+func NoneChannel() float64 {
+	return math.NaN()
+}
+
+// channel formats a color channel in modern syntax, rendering NaN as `none`.
+func channel(value float64, unit string) string {
+	if math.IsNaN(value) {
+		return "none"
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64) + unit
+}
+
+// modernColor renders a color function in the space-separated syntax,
+// e.g. `oklch(0.7 0.1 250 / 0.5)`. The alpha is only rendered when given.
+func modernColor(fn string, channels []string, alpha ...float64) Color {
+	var b strings.Builder
+	b.WriteString(fn)
+	b.WriteString("(")
+	b.WriteString(strings.Join(channels, " "))
+	for _, a := range alpha {
+		b.WriteString(" / ")
+		b.WriteString(channel(a, ""))
+	}
+	b.WriteString(")")
+	return Color(b.String())
+}
+
+// OKLCH generates an OKLCH Color value in the format `oklch(l c h)`.
+// Parameters:
+// - `l` (float64): Perceived lightness (0.0–1.0).
+// - `c` (float64): Chroma, non-negative and in practice below 0.4.
+// - `h` (float64): Hue angle in degrees.
+func OKLCH(l, c, h float64) Color {
+	return modernColor("oklch", []string{channel(l, ""), channel(c, ""), channel(h, "")})
+}
+
+// OKLCHA generates an OKLCH Color value with an alpha in the format `oklch(l c h / alpha)`.
+// Parameters:
+// - `l` (float64): Perceived lightness (0.0–1.0).
+// - `c` (float64): Chroma, non-negative and in practice below 0.4.
+// - `h` (float64): Hue angle in degrees.
+// - `alpha` (float64): Opacity (0.0–1.0).
+func OKLCHA(l, c, h, alpha float64) Color {
+	return modernColor("oklch", []string{channel(l, ""), channel(c, ""), channel(h, "")}, alpha)
+}
+
+// OKLab generates an OKLab Color value in the format `oklab(l a b)`.
+// Parameters:
+// - `l` (float64): Perceived lightness (0.0–1.0).
+// - `a` (float64): Green/red axis, in practice between -0.4 and 0.4.
+// - `b` (float64): Blue/yellow axis, in practice between -0.4 and 0.4.
+func OKLab(l, a, b float64) Color {
+	return modernColor("oklab", []string{channel(l, ""), channel(a, ""), channel(b, "")})
+}
+
+// OKLabA generates an OKLab Color value with an alpha in the format `oklab(l a b / alpha)`.
+// Parameters:
+// - `l` (float64): Perceived lightness (0.0–1.0).
+// - `a` (float64): Green/red axis, in practice between -0.4 and 0.4.
+// - `b` (float64): Blue/yellow axis, in practice between -0.4 and 0.4.
+// - `alpha` (float64): Opacity (0.0–1.0).
+func OKLabA(l, a, b, alpha float64) Color {
+	return modernColor("oklab", []string{channel(l, ""), channel(a, ""), channel(b, "")}, alpha)
+}
+
+// Lab generates a CIE Lab Color value in the format `lab(l a b)`.
+// Parameters:
+// - `l` (float64): Lightness (0–100).
+// - `a` (float64): Green/red axis, in practice between -125 and 125.
+// - `b` (float64): Blue/yellow axis, in practice between -125 and 125.
+func Lab(l, a, b float64) Color {
+	return modernColor("lab", []string{channel(l, ""), channel(a, ""), channel(b, "")})
+}
+
+// LabA generates a CIE Lab Color value with an alpha in the format `lab(l a b / alpha)`.
+// Parameters:
+// - `l` (float64): Lightness (0–100).
+// - `a` (float64): Green/red axis, in practice between -125 and 125.
+// - `b` (float64): Blue/yellow axis, in practice between -125 and 125.
+// - `alpha` (float64): Opacity (0.0–1.0).
+func LabA(l, a, b, alpha float64) Color {
+	return modernColor("lab", []string{channel(l, ""), channel(a, ""), channel(b, "")}, alpha)
+}
+
+// LCH generates a CIE LCH Color value in the format `lch(l c h)`.
+// Parameters:
+// - `l` (float64): Lightness (0–100).
+// - `c` (float64): Chroma, non-negative and in practice below 150.
+// - `h` (float64): Hue angle in degrees.
+func LCH(l, c, h float64) Color {
+	return modernColor("lch", []string{channel(l, ""), channel(c, ""), channel(h, "")})
+}
+
+// LCHA generates a CIE LCH Color value with an alpha in the format `lch(l c h / alpha)`.
+// Parameters:
+// - `l` (float64): Lightness (0–100).
+// - `c` (float64): Chroma, non-negative and in practice below 150.
+// - `h` (float64): Hue angle in degrees.
+// - `alpha` (float64): Opacity (0.0–1.0).
+func LCHA(l, c, h, alpha float64) Color {
+	return modernColor("lch", []string{channel(l, ""), channel(c, ""), channel(h, "")}, alpha)
+}
+
+// HWB generates an HWB Color value in the format `hwb(hue whiteness% blackness%)`.
+// Parameters:
+// - `hue` (float64): Hue angle in degrees.
+// - `whiteness` (float64): Amount of white to mix in, as a percentage (0–100).
+// - `blackness` (float64): Amount of black to mix in, as a percentage (0–100).
+func HWB(hue, whiteness, blackness float64) Color {
+	return modernColor("hwb", []string{channel(hue, ""), channel(whiteness, "%"), channel(blackness, "%")})
+}
+
+// HWBA generates an HWB Color value with an alpha in the format `hwb(hue whiteness% blackness% / alpha)`.
+// Parameters:
+// - `hue` (float64): Hue angle in degrees.
+// - `whiteness` (float64): Amount of white to mix in, as a percentage (0–100).
+// - `blackness` (float64): Amount of black to mix in, as a percentage (0–100).
+// - `alpha` (float64): Opacity (0.0–1.0).
+func HWBA(hue, whiteness, blackness, alpha float64) Color {
+	return modernColor("hwb", []string{channel(hue, ""), channel(whiteness, "%"), channel(blackness, "%")}, alpha)
+}
+
+// ColorFn generates a color value in a predefined color space in the format `color(space c1 c2 c3)`.
+// Rendering it fails with ErrInvalidColor when the space is not a predefined one, such as HSLSpace,
+// which can only be used for interpolation.
+// Parameters:
+// - `space` (ColorSpace): The color space (e.g., DisplayP3, Rec2020).
+// - `c1`, `c2`, `c3` (float64): The channels of the color space, usually 0.0–1.0 for RGB spaces.
+func ColorFn(space ColorSpace, c1, c2, c3 float64) ColorFunction {
+	return predefinedColor(space, modernColor("color", []string{string(space), channel(c1, ""), channel(c2, ""), channel(c3, "")}))
+}
+
+// ColorFnA generates a color value in a predefined color space with an alpha
+// in the format `color(space c1 c2 c3 / alpha)`, like ColorFn.
+// Parameters:
+// - `space` (ColorSpace): The color space (e.g., DisplayP3, Rec2020).
+// - `c1`, `c2`, `c3` (float64): The channels of the color space, usually 0.0–1.0 for RGB spaces.
+// - `alpha` (float64): Opacity (0.0–1.0).
+func ColorFnA(space ColorSpace, c1, c2, c3, alpha float64) ColorFunction {
+	return predefinedColor(space, modernColor("color", []string{string(space), channel(c1, ""), channel(c2, ""), channel(c3, "")}, alpha))
+}
+
+// predefinedColor renders a color() color, failing when its space is not a predefined one.
+func predefinedColor(space ColorSpace, c Color) ColorFunction {
+	return ColorFunction(func(w io.Writer) error {
+		if !predefinedSpaces[space] {
+			return invalidColor(string(c), fmt.Sprintf("%q is not a predefined color space", space))
+		}
+		return c.RenderCSS(w)
+	})
+}
@@ -1,6 +1,11 @@
 package cssgo
 
-import "testing"
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
 
 func TestHex(t *testing.T) {
 	RunTests(t,
@@ -753,3 +758,76 @@ func TestNamedColors(t *testing.T) {
 		},
 	)
 }
+
+func TestLightDark(t *testing.T) {
+	RunTests(t,
+		test{"LightDark: named colors", LightDark(White, Black), "light-dark(white, black)"},
+		test{"LightDark: hex and var", LightDark(Hex(0xfafafa), Var("dark-bg")), "light-dark(#fafafa, var(--dark-bg))"},
+	)
+}
+
+func TestLightDarkErrors(t *testing.T) {
+	errColor := errors.New("color failed")
+	failing := ColorFunction(func(w io.Writer) error { return errColor })
+
+	var b strings.Builder
+	if err := LightDark(White, failing).RenderCSS(&b); !errors.Is(err, errColor) {
+		t.Fatalf("got %v, want %v", err, errColor)
+	}
+
+	if err := Validate(TextColor(LightDark(Color("blurple"), Black))); !errors.Is(err, ErrInvalidColor) {
+		t.Fatalf("got %v, want ErrInvalidColor", err)
+	}
+}
+
+func TestOKLCH(t *testing.T) {
+	RunTests(t,
+		test{"OKLCH: oklch(0.7 0.1 250)", OKLCH(0.7, 0.1, 250), "oklch(0.7 0.1 250)"},
+		test{"OKLCH: none hue", OKLCH(0.5, 0, NoneChannel()), "oklch(0.5 0 none)"},
+		test{"OKLCHA: oklch(0.628 0.2577 29.23 / 0.5)", OKLCHA(0.628, 0.2577, 29.23, 0.5), "oklch(0.628 0.2577 29.23 / 0.5)"},
+		test{"OKLCHA: none alpha", OKLCHA(0.628, 0.2577, 29.23, NoneChannel()), "oklch(0.628 0.2577 29.23 / none)"},
+	)
+}
+
+func TestOKLab(t *testing.T) {
+	RunTests(t,
+		test{"OKLab: oklab(0.5 -0.1 0.1)", OKLab(0.5, -0.1, 0.1), "oklab(0.5 -0.1 0.1)"},
+		test{"OKLabA: oklab(0.5 -0.1 0.1 / 0.25)", OKLabA(0.5, -0.1, 0.1, 0.25), "oklab(0.5 -0.1 0.1 / 0.25)"},
+	)
+}
+
+func TestLab(t *testing.T) {
+	RunTests(t,
+		test{"Lab: lab(50 40 -20)", Lab(50, 40, -20), "lab(50 40 -20)"},
+		test{"LabA: lab(50 40 -20 / 1)", LabA(50, 40, -20, 1), "lab(50 40 -20 / 1)"},
+	)
+}
+
+func TestLCH(t *testing.T) {
+	RunTests(t,
+		test{"LCH: lch(52.2 72.2 50)", LCH(52.2, 72.2, 50), "lch(52.2 72.2 50)"},
+		test{"LCHA: lch(52.2 72.2 50 / 0.8)", LCHA(52.2, 72.2, 50, 0.8), "lch(52.2 72.2 50 / 0.8)"},
+	)
+}
+
+func TestHWB(t *testing.T) {
+	RunTests(t,
+		test{"HWB: hwb(194 0% 0%)", HWB(194, 0, 0), "hwb(194 0% 0%)"},
+		test{"HWB: none whiteness", HWB(194, NoneChannel(), 10.5), "hwb(194 none 10.5%)"},
+		test{"HWBA: hwb(194 0% 0% / 0.5)", HWBA(194, 0, 0, 0.5), "hwb(194 0% 0% / 0.5)"},
+	)
+}
+
+func TestColorFn(t *testing.T) {
+	RunTests(t,
+		test{"ColorFn: display-p3", ColorFn(DisplayP3, 1, 0.5, 0), "color(display-p3 1 0.5 0)"},
+		test{"ColorFn: srgb-linear", ColorFn(SRGBLinear, 0.2, 0.3, 0.4), "color(srgb-linear 0.2 0.3 0.4)"},
+		test{"ColorFnA: rec2020", ColorFnA(Rec2020, 0.1, 0.2, 0.3, 0.75), "color(rec2020 0.1 0.2 0.3 / 0.75)"},
+	)
+
+	for _, space := range []ColorSpace{HSLSpace, HWBSpace, LabSpace, LCHSpace, OKLabSpace, OKLCHSpace, "foo"} {
+		if err := ColorFnA(space, 0.1, 0.2, 0.3, 1).RenderCSS(io.Discard); !errors.Is(err, ErrInvalidColor) {
+			t.Fatalf("TESTCASE %s: FAIL\ngot error: %v, want ErrInvalidColor", space, err)
+		}
+	}
+}
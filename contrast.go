@@ -0,0 +1,147 @@
+package cssgo
+
+import (
+	"errors"
+	"math"
+)
+
+// Minimum WCAG 2.x contrast ratios for text.
+const (
+	ContrastAA      = 4.5 // AA for normal text.
+	ContrastAALarge = 3.0 // AA for large text (18pt, or 14pt bold).
+	ContrastAAA     = 7.0 // AAA for normal text.
+)
+
+// ContrastRatio computes the WCAG 2.x contrast ratio between a text color and a background color.
+// The result ranges from 1 (no contrast) to 21 (black on white).
+// A translucent text color is composited over the background, and a translucent
+// background is composited over white.
+// Example: ContrastRatio(Black, White) -> 21
+func ContrastRatio(text, background ColorValue) (float64, error) {
+	fg, bg, err := resolvePair(text, background)
+	if err != nil {
+		return 0, err
+	}
+
+	l1, l2 := fg.luminance(), bg.luminance()
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+
+	return (l1 + 0.05) / (l2 + 0.05), nil
+}
+
+// APCAContrast computes the APCA lightness contrast (Lc) of a text color on a background color,
+// following APCA-W3 0.0.98G. The result is roughly between -108 and 106; it is positive for
+// dark text on a light background and negative for light text on a dark background.
+// Example: APCAContrast(Black, White) -> ~106.04
+func APCAContrast(text, background ColorValue) (float64, error) {
+	fg, bg, err := resolvePair(text, background)
+	if err != nil {
+		return 0, err
+	}
+
+	yText, yBg := fg.apcaLuminance(), bg.apcaLuminance()
+	if math.Abs(yBg-yText) < 0.0005 {
+		return 0, nil
+	}
+
+	const (
+		scale    = 1.14
+		offset   = 0.027
+		clipping = 0.1
+	)
+
+	var lc float64
+	if yBg > yText {
+		sapc := (math.Pow(yBg, 0.56) - math.Pow(yText, 0.57)) * scale
+		if sapc >= clipping {
+			lc = sapc - offset
+		}
+	} else {
+		sapc := (math.Pow(yBg, 0.65) - math.Pow(yText, 0.62)) * scale
+		if sapc <= -clipping {
+			lc = sapc + offset
+		}
+	}
+
+	return lc * 100, nil
+}
+
+// MostReadable returns the candidate with the highest WCAG contrast ratio against the background.
+// Candidates that cannot be resolved are skipped; ErrInvalidColor is returned if none can be.
+// Example: MostReadable(Navy, White, Black) -> White
+func MostReadable(background ColorValue, candidates ...ColorValue) (ColorValue, error) {
+	var (
+		best      ColorValue
+		bestRatio float64
+		errs      []error
+	)
+
+	for _, candidate := range candidates {
+		ratio, err := ContrastRatio(candidate, background)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if best == nil || ratio > bestRatio {
+			best, bestRatio = candidate, ratio
+		}
+	}
+
+	if best == nil {
+		if len(errs) == 0 {
+			return nil, invalidColor("", "no candidate colors")
+		}
+		return nil, errors.Join(errs...)
+	}
+
+	return best, nil
+}
+
+// resolvePair resolves a text and background color, flattening their alpha
+// so that the contrast is computed on the colors as they are displayed.
+func resolvePair(text, background ColorValue) (RGBColor, RGBColor, error) {
+	fg, err := ToRGB(text)
+	if err != nil {
+		return RGBColor{}, RGBColor{}, err
+	}
+
+	bg, err := ToRGB(background)
+	if err != nil {
+		return RGBColor{}, RGBColor{}, err
+	}
+
+	bg = bg.over(RGBColor{1, 1, 1, 1})
+	fg = fg.over(bg)
+
+	return fg, bg, nil
+}
+
+// over composites the color over an opaque backdrop.
+func (c RGBColor) over(backdrop RGBColor) RGBColor {
+	a := clamp(c.Alpha, 0, 1)
+	return RGBColor{
+		R:     lerp(backdrop.R, clamp(c.R, 0, 1), a),
+		G:     lerp(backdrop.G, clamp(c.G, 0, 1), a),
+		B:     lerp(backdrop.B, clamp(c.B, 0, 1), a),
+		Alpha: 1,
+	}
+}
+
+// luminance returns the WCAG relative luminance of the color.
+func (c RGBColor) luminance() float64 {
+	return 0.2126*toLinear(c.R) + 0.7152*toLinear(c.G) + 0.0722*toLinear(c.B)
+}
+
+// apcaLuminance returns the APCA screen luminance of the color, including the soft clamp for near blacks.
+func (c RGBColor) apcaLuminance() float64 {
+	y := 0.2126729*math.Pow(c.R, 2.4) + 0.7151522*math.Pow(c.G, 2.4) + 0.0721750*math.Pow(c.B, 2.4)
+
+	const threshold = 0.022
+	if y < threshold {
+		y += math.Pow(threshold-y, 1.414)
+	}
+
+	return y
+}
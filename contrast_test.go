@@ -0,0 +1,86 @@
+package cssgo
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestContrastRatio(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       ColorValue
+		background ColorValue
+		want       float64
+	}{
+		{"black on white", Black, White, 21},
+		{"white on black", White, Black, 21},
+		{"same color", Red, Red, 1},
+		{"gray just below AA", Hex(0x777777), White, 4.478},
+		{"blue on white", Blue, White, 8.592},
+		{"hsl gray on white", HSL(0, 0, 50), Hex(0xffffff), 3.977},
+		{"translucent text", RGBA(0, 0, 0, 0.5), White, 3.977},
+	}
+
+	for _, test := range tests {
+		func(t2 *testing.T) {
+			got, err := ContrastRatio(test.text, test.background)
+			if err != nil {
+				t2.Fatalf("TESTCASE %s: unexpected error: %v", test.name, err)
+			}
+			if math.Abs(got-test.want) > 0.005 {
+				t2.Fatalf("TESTCASE %s: FAIL\ngot: %g != want: %g", test.name, got, test.want)
+			}
+		}(t)
+	}
+}
+
+func TestAPCAContrast(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       ColorValue
+		background ColorValue
+		want       float64
+	}{
+		{"black on white", Black, White, 106.04},
+		{"white on black", White, Black, -107.88},
+		{"gray on white", Hex(0x888888), White, 63.06},
+		{"same color", Blue, Blue, 0},
+	}
+
+	for _, test := range tests {
+		func(t2 *testing.T) {
+			got, err := APCAContrast(test.text, test.background)
+			if err != nil {
+				t2.Fatalf("TESTCASE %s: unexpected error: %v", test.name, err)
+			}
+			if math.Abs(got-test.want) > 0.01 {
+				t2.Fatalf("TESTCASE %s: FAIL\ngot: %g != want: %g", test.name, got, test.want)
+			}
+		}(t)
+	}
+}
+
+func TestMostReadable(t *testing.T) {
+	got, err := MostReadable(Navy, Black, Var("unknown"), White, Gray)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != White {
+		t.Fatalf("got: %v != want: %v", got, White)
+	}
+
+	if _, err := MostReadable(White, Var("unknown")); !errors.Is(err, ErrInvalidColor) {
+		t.Fatalf("got: %v, want ErrInvalidColor", err)
+	}
+}
+
+func TestContrastAA(t *testing.T) {
+	ratio, err := ContrastRatio(Hex(0x767676), White)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ratio < ContrastAA {
+		t.Fatalf("#767676 on white should meet AA, got %g", ratio)
+	}
+}
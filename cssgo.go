@@ -27,3 +27,11 @@ func (r RuleNodeFunc) String() string {
 }
 
 func (r RuleNodeFunc) ruleNode() {}
+
+// render renders a node into a string.
+// It is used by values that embed other values, such as LightDark.
+func render(n Node) (string, error) {
+	var b strings.Builder
+	err := n.RenderCSS(&b)
+	return b.String(), err
+}
@@ -0,0 +1,50 @@
+package cssgo
+
+import (
+	"io"
+	"strings"
+)
+
+// customPropName normalises a custom property name so that it always starts with "--".
+// Example: customPropName("brand") -> "--brand"
+func customPropName(name string) string {
+	if strings.HasPrefix(name, "--") {
+		return name
+	}
+	return "--" + name
+}
+
+// CustomProp creates a custom property (CSS variable) declaration.
+// The leading "--" is added if it is missing.
+// Example: CustomProp("brand", Blue) -> "--brand: blue;"
+func CustomProp(name string, values ...ValueNode) Property {
+	return Prop(customPropName(name), values...)
+}
+
+// VarType represents a reference to a custom property (e.g., "var(--brand)").
+// It can be used wherever a typed value is expected.
+type VarType string
+
+func (v VarType) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(v))
+	return err
+}
+
+func (v VarType) valueNode()          {}
+func (v VarType) colorValue()         {}
+func (v VarType) sizeValue()          {}
+func (v VarType) displayValue()       {}
+func (v VarType) borderStyleValue()   {}
+func (v VarType) borderWidthValue()   {}
+func (v VarType) flexDirectionValue() {}
+func (v VarType) urlValue()           {}
+func (v VarType) colorSchemeValue()   {}
+func (v VarType) fontFamilyValue()    {}
+func (v VarType) contentValue()       {}
+
+// Var creates a reference to a custom property.
+// The leading "--" is added if it is missing.
+// Example: Var("brand") -> "var(--brand)"
+func Var(name string) VarType {
+	return VarType("var(" + customPropName(name) + ")")
+}
@@ -0,0 +1,419 @@
+package cssgo
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Direction is the inline direction of the document, used to map logical properties to physical ones.
+type Direction string
+
+const (
+	LTR Direction = "ltr"
+	RTL Direction = "rtl"
+)
+
+// DownlevelOptions configures Downlevel.
+type DownlevelOptions struct {
+	// Targets are the browsers the output must support, usually created with ParseTargets.
+	Targets Targets
+	// Direction is used to map logical properties such as `margin-inline-start`
+	// to physical ones. It defaults to LTR; a horizontal writing mode is assumed.
+	Direction Direction
+}
+
+// Downlevel lowers modern CSS to equivalents that the targets support, according to the
+// vendored compatibility table, so that styles can be authored once with modern syntax:
+//   - OKLCH, OKLab, Lab, LCH, color() and color-mix() colors get an sRGB fallback declaration before them;
+//   - light-dark() takes the light color, and the dark one moves into a
+//     `@media (prefers-color-scheme: dark)` rule directly after the declarations,
+//     nested in their block or, when nested rules are flattened, following their rule;
+//   - logical properties become physical ones for opts.Direction;
+//   - nested rules are flattened, as with Flatten;
+//   - range media queries become min/max form: `(width >= 600px)` -> `(min-width: 600px)`.
+//
+// Features that every target supports are left untouched.
+// Example:
+//
+//	targets, _ := ParseTargets("safari >= 12")
+//	Downlevel(DownlevelOptions{Targets: targets}, Class("a").Props(TextColor(OKLCH(0.6, 0.2, 30))))
+//	-> `.a{color: #de3e2d;color: oklch(0.6 0.2 30);}`
+func Downlevel(opts DownlevelOptions, nodes ...Node) (Stylesheet, error) {
+	var b strings.Builder
+	for _, node := range nodes {
+		if err := node.RenderCSS(&b); err != nil {
+			return nil, err
+		}
+	}
+
+	items, err := parseCSS(b.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Direction == "" {
+		opts.Direction = LTR
+	}
+	if opts.Targets.needs(compat.Features["nesting"]) {
+		items = flattenItems(items, "")
+	}
+
+	d := downleveler{opts: opts}
+	return itemsStylesheet(d.block(items)), nil
+}
+
+type downleveler struct {
+	opts DownlevelOptions
+}
+
+func (d downleveler) needs(feature string) bool {
+	return d.opts.Targets.needs(compat.Features[feature])
+}
+
+// prefersDark is the at-rule holding the dark variants of light-dark() declarations.
+const prefersDark = "@media (prefers-color-scheme: dark)"
+
+// block lowers the items of a block. The dark variants of declarations using light-dark()
+// follow their run of declarations in a `@media (prefers-color-scheme: dark)` rule,
+// nested in the same block, or directly after the rule when nested rules are flattened.
+func (d downleveler) block(items []cssItem) []cssItem {
+	var out, dark []cssItem
+	flush := func() {
+		if len(dark) > 0 {
+			out = append(out, cssItem{kind: ruleItem, prelude: prefersDark, children: dark})
+			dark = nil
+		}
+	}
+
+	for _, item := range items {
+		switch {
+		case item.kind == declItem:
+			lowered, darkDecls := d.declarations(item.decl)
+			out = append(out, lowered...)
+			dark = append(dark, darkDecls...)
+
+		case item.kind == ruleItem && strings.HasPrefix(item.prelude, "@"):
+			flush()
+			name, _, _ := strings.Cut(item.prelude, " ")
+			if name == "@media" && d.needs("media-range") {
+				item.prelude = lowerMediaRanges(item.prelude)
+			}
+			item.children = d.block(item.children)
+			out = append(out, item)
+
+		case item.kind == ruleItem && d.needs("nesting"):
+			// Flattened rules only hold declarations, so their dark variants
+			// need a copy of the rule inside the media rule.
+			flush()
+			var children, darkDecls []cssItem
+			for _, child := range item.children {
+				if child.kind != declItem {
+					children = append(children, d.block([]cssItem{child})...)
+					continue
+				}
+				lowered, darkDecl := d.declarations(child.decl)
+				children = append(children, lowered...)
+				darkDecls = append(darkDecls, darkDecl...)
+			}
+			item.children = children
+			out = append(out, item)
+			if len(darkDecls) > 0 {
+				out = append(out, cssItem{kind: ruleItem, prelude: prefersDark, children: []cssItem{
+					{kind: ruleItem, prelude: item.prelude, children: darkDecls},
+				}})
+			}
+
+		case item.kind == ruleItem:
+			flush()
+			item.children = d.block(item.children)
+			out = append(out, item)
+
+		default:
+			flush()
+			out = append(out, item)
+		}
+	}
+	flush()
+	return out
+}
+
+// declarations lowers a declaration, returning the declarations replacing it
+// and the ones that only apply in dark mode.
+func (d downleveler) declarations(decl Declaration) (lowered, dark []cssItem) {
+	for _, decl := range d.declaration(decl) {
+		if d.needs("light-dark") {
+			light, lightOK := replaceFunctions(decl.Value, "light-dark", func(args []string) string { return args[0] })
+			darkValue, _ := replaceFunctions(decl.Value, "light-dark", func(args []string) string { return args[1] })
+			if lightOK {
+				decl.Value = light
+				dark = append(dark, d.colorFallbacks(Declaration{Property: decl.Property, Value: darkValue, Important: decl.Important})...)
+			}
+		}
+		lowered = append(lowered, d.colorFallbacks(decl)...)
+	}
+	return lowered, dark
+}
+
+// declaration maps logical properties to physical ones when the targets need it.
+func (d downleveler) declaration(decl Declaration) []Declaration {
+	if !d.needs("logical-properties") {
+		return []Declaration{decl}
+	}
+
+	properties, values := physicalProperties(decl.Property, decl.Value, d.opts.Direction)
+	decls := make([]Declaration, len(properties))
+	for i := range properties {
+		decls[i] = Declaration{Property: properties[i], Value: values[i], Important: decl.Important}
+	}
+	return decls
+}
+
+// colorFeatures lists the color functions that may need an sRGB fallback with their feature.
+// color-mix() comes first, so that mixes are resolved from their original colors.
+var colorFeatures = [][2]string{
+	{"color-mix", "color-mix"}, {"color", "color-function"},
+	{"oklch", "lab-colors"}, {"oklab", "lab-colors"}, {"lab", "lab-colors"}, {"lch", "lab-colors"},
+}
+
+// colorFallbacks returns the declaration preceded by an sRGB fallback when it uses
+// colors that the targets do not support. Colors that cannot be resolved, such as
+// those using var(), get no fallback.
+func (d downleveler) colorFallbacks(decl Declaration) []cssItem {
+	item := cssItem{kind: declItem, decl: decl}
+
+	fallback := decl
+	changed, resolved := false, true
+	for _, fn := range colorFeatures {
+		name, feature := fn[0], fn[1]
+		if !d.needs(feature) {
+			continue
+		}
+		value, ok := replaceFunctionCalls(fallback.Value, name, func(call string) string {
+			c, err := parseColorString(call)
+			if err != nil {
+				resolved = false
+				return call
+			}
+			return c.String()
+		})
+		fallback.Value = value
+		changed = changed || ok
+	}
+
+	if !changed || !resolved {
+		return []cssItem{item}
+	}
+	return []cssItem{{kind: declItem, decl: fallback}, item}
+}
+
+// replaceFunctions replaces every call of the two-argument function name in a value with
+// the result of fn, which receives the trimmed arguments.
+// Example: replaceFunctions("1px solid light-dark(red, blue)", "light-dark", first) -> "1px solid red"
+func replaceFunctions(value, name string, fn func(args []string) string) (string, bool) {
+	return replaceFunctionCalls(value, name, func(call string) string {
+		args := splitTopLevel(call[len(name)+1:len(call)-1], ',')
+		if len(args) != 2 {
+			return call
+		}
+		return fn(args)
+	})
+}
+
+// replaceFunctionCalls replaces every call of the function name in a value, outside of
+// strings, with the result of fn, which receives the whole call including its arguments.
+// It reports whether any call was found.
+func replaceFunctionCalls(value, name string, fn func(call string) string) (string, bool) {
+	var b strings.Builder
+	found := false
+	for i := 0; i < len(value); {
+		c := value[i]
+		if c == '"' || c == '\'' {
+			j := i + 1
+			for ; j < len(value) && value[j] != c; j++ {
+				if value[j] == '\\' {
+					j++
+				}
+			}
+			j = min(j+1, len(value))
+			b.WriteString(value[i:j])
+			i = j
+			continue
+		}
+
+		start := i > 0 && isIdentByte(value[i-1])
+		if start || !strings.HasPrefix(strings.ToLower(value[i:]), name+"(") {
+			b.WriteByte(c)
+			i++
+			continue
+		}
+
+		end, depth := i+len(name), 0
+		for ; end < len(value); end++ {
+			if value[end] == '(' {
+				depth++
+			} else if value[end] == ')' {
+				if depth--; depth == 0 {
+					break
+				}
+			}
+		}
+		if end == len(value) {
+			b.WriteString(value[i:])
+			break
+		}
+
+		b.WriteString(fn(value[i : end+1]))
+		found = true
+		i = end + 1
+	}
+	return b.String(), found
+}
+
+func isIdentByte(c byte) bool {
+	return c == '-' || c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// logicalSides maps the logical sides to physical ones for each direction, in a horizontal writing mode.
+var logicalSides = map[Direction]map[string]string{
+	LTR: {"inline-start": "left", "inline-end": "right", "block-start": "top", "block-end": "bottom"},
+	RTL: {"inline-start": "right", "inline-end": "left", "block-start": "top", "block-end": "bottom"},
+}
+
+// logicalSizes maps logical sizing properties to physical ones, in a horizontal writing mode.
+var logicalSizes = map[string]string{
+	"inline-size": "width", "min-inline-size": "min-width", "max-inline-size": "max-width",
+	"block-size": "height", "min-block-size": "min-height", "max-block-size": "max-height",
+}
+
+// physicalProperties maps a logical property to physical properties and their values.
+// Properties that are not logical are returned unchanged.
+// Example: physicalProperties("margin-inline", "4px 8px", LTR) -> [margin-left margin-right], [4px 8px]
+func physicalProperties(property, value string, dir Direction) ([]string, []string) {
+	sides := logicalSides[dir]
+	if sides == nil {
+		sides = logicalSides[LTR]
+	}
+
+	if size, ok := logicalSizes[property]; ok {
+		return []string{size}, []string{value}
+	}
+
+	// Longhands such as margin-inline-start, border-block-end-width or inset-inline-end.
+	for logical, physical := range sides {
+		if prefix, suffix, ok := strings.Cut(property, logical); ok {
+			if prefix == "inset-" {
+				return []string{physical + suffix}, []string{value}
+			}
+			return []string{prefix + physical + suffix}, []string{value}
+		}
+	}
+
+	if property == "inset" {
+		parts := splitTopLevel(value, ' ')
+		values, ok := [4]string{}, true
+		switch len(parts) {
+		case 1:
+			values = [4]string{parts[0], parts[0], parts[0], parts[0]}
+		case 2:
+			values = [4]string{parts[0], parts[1], parts[0], parts[1]}
+		case 3:
+			values = [4]string{parts[0], parts[1], parts[2], parts[1]}
+		case 4:
+			values = [4]string{parts[0], parts[1], parts[2], parts[3]}
+		default:
+			ok = false
+		}
+		if ok {
+			return []string{"top", "right", "bottom", "left"}, values[:]
+		}
+		return []string{property}, []string{value}
+	}
+
+	// Two-value shorthands such as margin-inline, padding-block or border-inline-width,
+	// and border-inline and border-block, whose single value applies to both sides.
+	for _, axis := range []string{"inline", "block"} {
+		prefix, suffix, ok := strings.Cut(property, axis)
+		if !ok || prefix != "" && !strings.HasSuffix(prefix, "-") || suffix != "" && !strings.HasPrefix(suffix, "-") {
+			continue
+		}
+		switch strings.TrimSuffix(prefix, "-") {
+		case "margin", "padding", "inset", "border", "scroll-margin", "scroll-padding":
+		default:
+			continue
+		}
+
+		start, end := value, value
+		if prefix != "border-" || suffix != "" {
+			parts := splitTopLevel(value, ' ')
+			switch len(parts) {
+			case 1:
+			case 2:
+				start, end = parts[0], parts[1]
+			default:
+				return []string{property}, []string{value}
+			}
+		}
+
+		first, second := sides[axis+"-start"], sides[axis+"-end"]
+		if prefix == "inset-" {
+			return []string{first, second}, []string{start, end}
+		}
+		return []string{prefix + first + suffix, prefix + second + suffix}, []string{start, end}
+	}
+
+	return []string{property}, []string{value}
+}
+
+var (
+	// mediaRange matches `(width >= 600px)`, `(600px < width)` and `(400px <= width < 700px)`.
+	mediaRange = regexp.MustCompile(`\(\s*(?:([^()<>=\s]+)\s*(<=|<|>=|>|=)\s*)?(width|height)\s*(?:(<=|<|>=|>|=)\s*([^()<>=\s]+)\s*)?\)`)
+	dimension  = regexp.MustCompile(`^([+-]?(?:\d+\.?\d*|\.\d+))([a-zA-Z%]*)$`)
+	flipped    = map[string]string{"<": ">", "<=": ">=", ">": "<", ">=": "<=", "=": "="}
+)
+
+// lowerMediaRanges rewrites the range syntax of a media query into min/max features.
+// Strict comparisons move the value by 0.001 of its unit, as `(width > 600px)` excludes 600px.
+// Example: lowerMediaRanges("@media (400px <= width < 700px)") -> "@media (min-width: 400px) and (max-width: 699.999px)"
+func lowerMediaRanges(prelude string) string {
+	return mediaRange.ReplaceAllStringFunc(prelude, func(match string) string {
+		m := mediaRange.FindStringSubmatch(match)
+		before, op1, feature, op2, after := m[1], m[2], m[3], m[4], m[5]
+		if op1 == "" && op2 == "" {
+			return match
+		}
+
+		var conditions []string
+		add := func(op, value string) bool {
+			switch op {
+			case "=":
+				conditions = append(conditions, "("+feature+": "+value+")")
+				return true
+			case ">=", "<=":
+			default:
+				n := dimension.FindStringSubmatch(value)
+				if n == nil {
+					return false
+				}
+				v, _ := strconv.ParseFloat(n[1], 64)
+				if op == ">" {
+					v += 0.001
+				} else {
+					v -= 0.001
+				}
+				value = strconv.FormatFloat(v, 'f', -1, 64) + n[2]
+			}
+			bound := "min-"
+			if strings.HasPrefix(op, "<") {
+				bound = "max-"
+			}
+			conditions = append(conditions, "("+bound+feature+": "+value+")")
+			return true
+		}
+
+		if op1 != "" && !add(flipped[op1], before) || op2 != "" && !add(op2, after) {
+			return match
+		}
+		return strings.Join(conditions, " and ")
+	})
+}
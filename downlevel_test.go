@@ -0,0 +1,145 @@
+package cssgo
+
+import "testing"
+
+func TestDownlevel(t *testing.T) {
+	oldSafari, _ := ParseTargets("safari >= 12")
+	modern, _ := ParseTargets("last 1 chrome versions, last 1 firefox versions")
+	nesting, _ := ParseTargets("chrome >= 120")
+
+	tests := []struct {
+		name    string
+		targets Targets
+		dir     Direction
+		input   []Node
+		want    string
+	}{
+		{
+			name:    "oklch fallback",
+			targets: oldSafari,
+			input:   []Node{Class("a").Props(TextColor(OKLCH(0.6, 0.2, 30)), BackgroundColor(Red))},
+			want:    ".a{color: #de3e2d;color: oklch(0.6 0.2 30);background-color: red;}",
+		},
+		{
+			name:    "color-mix fallback",
+			targets: oldSafari,
+			input:   []Node{Class("a").Props(TextColor(ColorMix(SRGB, Red, 50, Blue, 50)))},
+			want:    ".a{color: #800080;color: color-mix(in srgb, red 50%, blue 50%);}",
+		},
+		{
+			name:    "color-mix fallback in other spaces",
+			targets: oldSafari,
+			input: []Node{
+				TextColor(ColorMix(HSLSpace, Red, 50, Blue, 50)), TextColor(ColorMix(LabSpace, Red, 50, Blue, 50)),
+				TextColor(ColorMix(SRGBLinear, Red, 50, Blue, 50)), TextColor(ColorMix(OKLCHSpace, Red, 50, Blue, 50)),
+			},
+			want: "color: #ff00ff;color: color-mix(in hsl, red 50%, blue 50%);" +
+				"color: #c10088;color: color-mix(in lab, red 50%, blue 50%);" +
+				"color: #bc00bc;color: color-mix(in srgb-linear, red 50%, blue 50%);" +
+				"color: #ba00c2;color: color-mix(in oklch, red 50%, blue 50%);",
+		},
+		{
+			name:    "light-dark",
+			targets: oldSafari,
+			input:   []Node{Class("a").Props(TextColor(LightDark(Black, White)), Prop("border", Str("x"))), Class("b").Props(Width(PX(1)))},
+			want:    `.a{color: black;border: "x";}@media (prefers-color-scheme: dark){.a{color: white;}}.b{width: 1px;}`,
+		},
+		{
+			name:    "light-dark in top-level declarations",
+			targets: oldSafari,
+			input: []Node{
+				TextColor(LightDark(Black, White)), Width(PX(1)),
+				Media("print", Class("b").Props(TextColor(Red))), BackgroundColor(LightDark(Red, Blue)),
+			},
+			want: "color: black;width: 1px;@media (prefers-color-scheme: dark){color: white;}" +
+				"@media print{.b{color: red;}}background-color: red;@media (prefers-color-scheme: dark){background-color: blue;}",
+		},
+		{
+			name:    "light-dark in flattened rules",
+			targets: oldSafari,
+			input: []Node{Class("a").Props(
+				TextColor(LightDark(Black, White)), Nest(Hover(), TextColor(LightDark(Red, Blue))), BackgroundColor(LightDark(Red, Blue)),
+			)},
+			want: ".a{color: black;}@media (prefers-color-scheme: dark){.a{color: white;}}" +
+				".a:hover{color: red;}@media (prefers-color-scheme: dark){.a:hover{color: blue;}}" +
+				".a{background-color: red;}@media (prefers-color-scheme: dark){.a{background-color: blue;}}",
+		},
+		{
+			name:    "light-dark in nested rules",
+			targets: nesting,
+			input: []Node{Class("a").Props(
+				TextColor(LightDark(Black, White)), Nest(Hover(), TextColor(LightDark(Red, Blue))),
+				NestMedia("print", BackgroundColor(LightDark(Red, Blue))), Width(PX(1)),
+			)},
+			want: ".a{color: black;@media (prefers-color-scheme: dark){color: white;}" +
+				"&:hover{color: red;@media (prefers-color-scheme: dark){color: blue;}}" +
+				"@media print{background-color: red;@media (prefers-color-scheme: dark){background-color: blue;}}width: 1px;}",
+		},
+		{
+			name:    "logical properties",
+			targets: oldSafari,
+			input: []Node{Class("a").Props(
+				Prop("margin-inline", goType("4px 8px")), Prop("padding-block-start", goType("2px")),
+				Prop("inset-inline-end", goType("0")), Prop("border-inline-start-width", goType("1px")),
+				Prop("border-block", goType("1px solid red")), Prop("max-inline-size", goType("10px")),
+				Prop("inset", goType("1px 2px")),
+			)},
+			want: ".a{margin-left: 4px;margin-right: 8px;padding-top: 2px;right: 0;border-left-width: 1px;" +
+				"border-top: 1px solid red;border-bottom: 1px solid red;max-width: 10px;" +
+				"top: 1px;right: 2px;bottom: 1px;left: 2px;}",
+		},
+		{
+			name:    "logical properties rtl",
+			targets: oldSafari,
+			dir:     RTL,
+			input:   []Node{Class("a").Props(Prop("margin-inline-start", goType("4px")))},
+			want:    ".a{margin-right: 4px;}",
+		},
+		{
+			name:    "nesting and media ranges",
+			targets: oldSafari,
+			input: []Node{
+				Class("a").Props(TextColor(Red), Nest(Hover(), TextColor(Blue))),
+				Media("(width >= 600px) and (400px < height <= 700px)", Class("a").Props(TextColor(Red))),
+			},
+			want: ".a{color: red;}.a:hover{color: blue;}" +
+				"@media (min-width: 600px) and (min-height: 400.001px) and (max-height: 700px){.a{color: red;}}",
+		},
+		{
+			name:    "modern targets keep everything",
+			targets: modern,
+			input: []Node{
+				Class("a").Props(TextColor(OKLCH(0.6, 0.2, 30)), Prop("margin-inline", goType("4px")), Nest(Hover(), TextColor(Blue))),
+				Media("(width >= 600px)", Class("a").Props(TextColor(Red))),
+			},
+			want: ".a{color: oklch(0.6 0.2 30);margin-inline: 4px;&:hover{color: blue;}}" +
+				"@media (width >= 600px){.a{color: red;}}",
+		},
+	}
+
+	for _, test := range tests {
+		sheet, err := Downlevel(DownlevelOptions{Targets: test.targets, Direction: test.dir}, test.input...)
+		if err != nil {
+			t.Fatalf("TESTCASE %s: unexpected error: %v", test.name, err)
+		}
+		if got := sheet.String(); got != test.want {
+			t.Errorf("TESTCASE %s: FAIL\ngot: %s != want: %s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestLowerMediaRanges(t *testing.T) {
+	tests := []struct{ input, want string }{
+		{"@media (width < 40em)", "@media (max-width: 39.999em)"},
+		{"@media (600px <= width)", "@media (min-width: 600px)"},
+		{"@media (width = 600px)", "@media (width: 600px)"},
+		{"@media screen and (min-width: 600px)", "@media screen and (min-width: 600px)"},
+		{"@media (width < calc(1px + 1em))", "@media (width < calc(1px + 1em))"},
+	}
+
+	for _, test := range tests {
+		if got := lowerMediaRanges(test.input); got != test.want {
+			t.Fatalf("TESTCASE %s: FAIL\ngot: %s != want: %s", test.input, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Package extract renders stylesheets registered with cssgo.Register into static .css files.
+// It is used by the program that cmd/cssgo generates for a package, and can be
+// called directly from a custom build step.
+package extract
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/avearmin/cssgo"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Options controls which stylesheets are written and how their files are named.
+type Options struct {
+	// Dir is the directory the files are written to. It is created if needed.
+	Dir string
+	// Hash adds a content hash to the file names, e.g. "app.1a2b3c4d.css",
+	// so that the files can be served with long-lived cache headers.
+	Hash bool
+	// Manifest is the name of a JSON file, written to Dir, that maps logical
+	// file names to the written ones. No manifest is written when it is empty.
+	Manifest string
+	// Names restricts the output to the named stylesheets. All registered stylesheets are written when it is empty.
+	Names []string
+}
+
+// Manifest maps logical file names to the names of the written files.
+// Example: {"app.css": "app.1a2b3c4d.css"}
+type Manifest map[string]string
+
+// Write renders the registered stylesheets and writes them to opts.Dir.
+// It returns the manifest of the written files, which is also written to disk when opts.Manifest is set.
+func Write(opts Options) (Manifest, error) {
+	names := opts.Names
+	if len(names) == 0 {
+		names = cssgo.RegisteredStylesheets()
+	}
+
+	if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	manifest := Manifest{}
+	for _, name := range names {
+		sheet, ok := cssgo.LookupStylesheet(name)
+		if !ok {
+			return nil, fmt.Errorf("extract: no stylesheet registered as %q", name)
+		}
+
+		var b strings.Builder
+		if err := sheet.RenderCSS(&b); err != nil {
+			return nil, fmt.Errorf("extract: rendering stylesheet %q: %w", name, err)
+		}
+		css := b.String()
+
+		file := FileName(name, css, opts.Hash)
+		if err := os.WriteFile(filepath.Join(opts.Dir, file), []byte(css), 0o644); err != nil {
+			return nil, err
+		}
+		manifest[name+".css"] = file
+	}
+
+	if opts.Manifest != "" {
+		data, err := json.MarshalIndent(manifest, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(filepath.Join(opts.Dir, opts.Manifest), append(data, '\n'), 0o644); err != nil {
+			return nil, err
+		}
+	}
+
+	return manifest, nil
+}
+
+// FileName returns the file name of a stylesheet with the given content.
+// Example: FileName("app", css, true) -> "app.1a2b3c4d.css"
+func FileName(name, css string, hash bool) string {
+	if !hash {
+		return name + ".css"
+	}
+	sum := sha256.Sum256([]byte(css))
+	return name + "." + hex.EncodeToString(sum[:4]) + ".css"
+}
+
+// Flags defines the command line flags of cmd/cssgo on fs and returns the options they populate.
+func Flags(fs *flag.FlagSet) *Options {
+	opts := &Options{}
+	fs.StringVar(&opts.Dir, "o", ".", "output `directory`")
+	fs.BoolVar(&opts.Hash, "hash", false, "add a content hash to file names")
+	fs.StringVar(&opts.Manifest, "manifest", "", "write a JSON manifest `file` to the output directory")
+	fs.Func("only", "comma-separated `names` of the stylesheets to write", func(s string) error {
+		for _, name := range strings.Split(s, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				opts.Names = append(opts.Names, name)
+			}
+		}
+		return nil
+	})
+	return opts
+}
+
+// Main parses the command line flags, writes the registered stylesheets and
+// prints the written files. It is the entry point of the program generated by cmd/cssgo.
+func Main() {
+	fs := flag.NewFlagSet("cssgo", flag.ExitOnError)
+	opts := Flags(fs)
+	_ = fs.Parse(os.Args[1:])
+
+	manifest, err := Write(*opts)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "cssgo:", err)
+		os.Exit(1)
+	}
+
+	logical := make([]string, 0, len(manifest))
+	for name := range manifest {
+		logical = append(logical, name)
+	}
+	sort.Strings(logical)
+	for _, name := range logical {
+		fmt.Println(filepath.Join(opts.Dir, manifest[name]))
+	}
+}
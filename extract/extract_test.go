@@ -0,0 +1,56 @@
+package extract
+
+import (
+	"github.com/avearmin/cssgo"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func init() {
+	cssgo.Register("extract-test", cssgo.Stylesheet{
+		cssgo.Class("foo").Props(cssgo.TextColor(cssgo.Red)),
+		cssgo.Media("(min-width: 600px)", cssgo.Class("foo").Props(cssgo.Width(cssgo.PX(10)))),
+	})
+}
+
+func TestWrite(t *testing.T) {
+	const css = ".foo{color: red;}@media (min-width: 600px){.foo{width: 10px;}}"
+
+	tests := []struct {
+		name string
+		opts Options
+		file string
+	}{
+		{"plain file name", Options{Names: []string{"extract-test"}}, "extract-test.css"},
+		{"hashed file name", Options{Names: []string{"extract-test"}, Hash: true, Manifest: "manifest.json"}, FileName("extract-test", css, true)},
+	}
+
+	for _, test := range tests {
+		test.opts.Dir = t.TempDir()
+		manifest, err := Write(test.opts)
+		if err != nil {
+			t.Fatalf("TESTCASE %s: unexpected error: %v", test.name, err)
+		}
+		if manifest["extract-test.css"] != test.file {
+			t.Fatalf("TESTCASE %s: FAIL\ngot: %s != want: %s", test.name, manifest["extract-test.css"], test.file)
+		}
+
+		got, err := os.ReadFile(filepath.Join(test.opts.Dir, test.file))
+		if err != nil {
+			t.Fatalf("TESTCASE %s: unexpected error: %v", test.name, err)
+		}
+		if string(got) != css {
+			t.Fatalf("TESTCASE %s: FAIL\ngot: %s != want: %s", test.name, got, css)
+		}
+
+		_, err = os.Stat(filepath.Join(test.opts.Dir, "manifest.json"))
+		if (test.opts.Manifest != "") != (err == nil) {
+			t.Fatalf("TESTCASE %s: manifest should only be written when requested: %v", test.name, err)
+		}
+	}
+
+	if _, err := Write(Options{Dir: t.TempDir(), Names: []string{"missing"}}); err == nil {
+		t.Fatalf("expected an error for an unregistered stylesheet")
+	}
+}
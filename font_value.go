@@ -0,0 +1,47 @@
+package cssgo
+
+import "io"
+
+// FontFamilyValue defines an interface for CSS-compatible font family values.
+// This ensures that any type implementing this interface can be rendered as a valid CSS font family.
+type FontFamilyValue interface {
+	ValueNode
+	fontFamilyValue()
+}
+
+// GenericFamily represents a CSS generic font family, such as "serif" or "monospace".
+// It is a concrete type that implements the FontFamilyValue interface.
+type GenericFamily string
+
+// Predefined generic font families as per the CSS specification.
+const (
+	Serif     GenericFamily = "serif"
+	SansSerif GenericFamily = "sans-serif"
+	Monospace GenericFamily = "monospace"
+	Cursive   GenericFamily = "cursive"
+	Fantasy   GenericFamily = "fantasy"
+	SystemUI  GenericFamily = "system-ui"
+	UISerif   GenericFamily = "ui-serif"
+	UISans    GenericFamily = "ui-sans-serif"
+	UIMono    GenericFamily = "ui-monospace"
+)
+
+func (g GenericFamily) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(g))
+	return err
+}
+
+func (g GenericFamily) valueNode()       {}
+func (g GenericFamily) fontFamilyValue() {}
+
+// FontName represents a specific font family name, such as "Helvetica Neue".
+// It is rendered as a quoted and escaped CSS string.
+type FontName string
+
+func (f FontName) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(quote(string(f), '"')))
+	return err
+}
+
+func (f FontName) valueNode()       {}
+func (f FontName) fontFamilyValue() {}
@@ -0,0 +1,266 @@
+package cssgo
+
+import (
+	"io"
+	"strings"
+)
+
+// RenderOptions controls the whitespace of rendered CSS.
+// The zero value renders exactly like RenderCSS: `.foo{color: red;}`.
+type RenderOptions struct {
+	// Indent is written once per nesting level before every declaration and rule
+	// when Newlines is set, e.g. "  " or "\t".
+	Indent string
+	// Newlines puts every declaration and rule on its own line, for debugging and snapshots.
+	Newlines bool
+	// Minify strips every optional whitespace character and the final semicolon of each block.
+	// It takes precedence over Indent and Newlines.
+	Minify bool
+	// Flatten expands nested rules into plain rules, like Flatten, for browsers without CSS Nesting support.
+	Flatten bool
+	// Validation is the validation mode of the values being rendered. It does not affect whitespace.
+	Validation ValidationMode
+}
+
+// Pretty renders one declaration or rule per line, indented with two spaces.
+var Pretty = RenderOptions{Indent: "  ", Newlines: true}
+
+// Minified renders CSS without optional whitespace.
+var Minified = RenderOptions{Minify: true}
+
+// RenderWith writes the CSS of the nodes using the given options.
+// Rules and properties are formatted as a whole; values and selectors are written as
+// they render, with their optional whitespace removed when minifying.
+// Example: RenderWith(w, Pretty, Class("foo").Props(TextColor(Red))) -> ".foo {\n  color: red;\n}\n"
+// Example: RenderWith(w, Minified, Class("foo").Or(Class("bar")).Props(TextColor(Red))) -> ".foo,.bar{color:red}"
+// Example: RenderWith(w, RenderOptions{Validation: ValidationLenient}, TextColor(RGB(300, 0, 0))) -> "color: rgb(255, 0, 0);"
+func RenderWith(w io.Writer, opts RenderOptions, nodes ...Node) error {
+	var out, b strings.Builder
+	flush := func() error {
+		if b.Len() == 0 {
+			return nil
+		}
+		css, err := formatCSS(b.String(), opts)
+		if err != nil {
+			return err
+		}
+		out.WriteString(css)
+		b.Reset()
+		return nil
+	}
+
+	for _, node := range nodes {
+		switch node.(type) {
+		case ValueNode, SelectorNode:
+			if err := flush(); err != nil {
+				return err
+			}
+			var v strings.Builder
+			if err := node.RenderCSS(&renderWriter{Writer: &v, mode: opts.Validation}); err != nil {
+				return err
+			}
+			out.WriteString(formatFragment(v.String(), node, opts))
+		default:
+			if err := node.RenderCSS(&renderWriter{Writer: &b, mode: opts.Validation}); err != nil {
+				return err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	_, err := w.Write([]byte(out.String()))
+	return err
+}
+
+// formatCSS formats rules and declarations using the options.
+func formatCSS(css string, opts RenderOptions) (string, error) {
+	if opts.Validation = ValidationOff; opts == (RenderOptions{}) {
+		return css, nil
+	}
+
+	items, err := parseCSS(css)
+	if err != nil {
+		return "", err
+	}
+	if opts.Flatten {
+		items = flattenItems(items, "")
+	}
+	return printCSS(items, opts), nil
+}
+
+// formatFragment formats the CSS of a value or selector node, which cannot be parsed on its own.
+func formatFragment(css string, node Node, opts RenderOptions) string {
+	if !opts.Minify {
+		return css
+	}
+	if _, ok := node.(SelectorNode); ok {
+		return minifyWhitespace(css, ",>+~")
+	}
+	return minifyWhitespace(css, ",")
+}
+
+// printCSS prints parsed CSS using the given options.
+func printCSS(items []cssItem, opts RenderOptions) string {
+	p := printer{opts: opts}
+	p.items(items, 0)
+	return p.b.String()
+}
+
+// printer writes parsed CSS according to RenderOptions.
+type printer struct {
+	opts RenderOptions
+	b    strings.Builder
+}
+
+func (p *printer) items(items []cssItem, depth int) {
+	for i, item := range items {
+		last := i == len(items)-1
+
+		if p.opts.Newlines && !p.opts.Minify {
+			p.b.WriteString(strings.Repeat(p.opts.Indent, depth))
+		}
+
+		switch item.kind {
+		case declItem:
+			p.declaration(item.decl, last)
+		case statementItem:
+			p.b.WriteString(p.prelude(item.prelude, false))
+			if !p.opts.Minify || !last {
+				p.b.WriteString(";")
+			}
+		case ruleItem:
+			p.b.WriteString(p.prelude(item.prelude, !strings.HasPrefix(item.prelude, "@")))
+			if p.opts.Newlines && !p.opts.Minify {
+				p.b.WriteString(" {\n")
+				p.items(item.children, depth+1)
+				p.b.WriteString(strings.Repeat(p.opts.Indent, depth))
+			} else {
+				p.b.WriteString("{")
+				p.items(item.children, depth+1)
+			}
+			p.b.WriteString("}")
+		}
+
+		if p.opts.Newlines && !p.opts.Minify {
+			p.b.WriteString("\n")
+		}
+	}
+}
+
+func (p *printer) declaration(d Declaration, last bool) {
+	if !p.opts.Minify {
+		p.b.WriteString(d.String())
+		return
+	}
+
+	p.b.WriteString(d.Property + ":" + minifyWhitespace(d.Value, ","))
+	if d.Important {
+		p.b.WriteString("!important")
+	}
+	if !last {
+		p.b.WriteString(";")
+	}
+}
+
+// prelude returns the selector list or at-rule prelude of a rule.
+// Combinators only need no surrounding whitespace in selectors.
+func (p *printer) prelude(prelude string, selector bool) string {
+	if !p.opts.Minify {
+		return prelude
+	}
+	if selector {
+		return minifyWhitespace(prelude, ",>+~")
+	}
+	return minifyPrelude(prelude)
+}
+
+// minifyPrelude removes the optional whitespace of an at-rule prelude: around commas, between the
+// at-rule name and a condition, and inside parentheses after `(`, before `)` and around `:`.
+// Other whitespace before `(` is kept, since `and(` would be read as a function.
+// Example: minifyPrelude("@media ( min-width : 600px ) and (hover)") -> "@media(min-width:600px) and (hover)"
+func minifyPrelude(prelude string) string {
+	s := minifyWhitespace(prelude, ",")
+	if name, rest, ok := strings.Cut(s, " "); ok && strings.HasPrefix(rest, "(") {
+		s = name + rest
+	}
+
+	var b strings.Builder
+	var quote byte
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && i+1 < len(s) {
+				b.WriteByte(c)
+				i++
+				c = s[i]
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == ' ' && depth > 0:
+			if prev := s[i-1]; prev == '(' || prev == ':' {
+				continue
+			}
+			if i+1 < len(s) && (s[i+1] == ')' || s[i+1] == ':') {
+				continue
+			}
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// minifyWhitespace collapses runs of whitespace into a single space and removes the
+// whitespace around the given delimiters, leaving strings and escapes untouched.
+func minifyWhitespace(s, delimiters string) string {
+	var b strings.Builder
+	space := false
+	var quote, last byte
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			b.WriteByte(c)
+			if c == '\\' && i+1 < len(s) {
+				i++
+				b.WriteByte(s[i])
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f':
+			space = true
+			continue
+		case strings.IndexByte(delimiters, c) >= 0:
+			space = false
+		case space:
+			if b.Len() > 0 && strings.IndexByte(delimiters, last) < 0 {
+				b.WriteByte(' ')
+			}
+			space = false
+		}
+
+		if c == '"' || c == '\'' {
+			quote = c
+		}
+		b.WriteByte(c)
+		last = c
+		if c == '\\' && i+1 < len(s) {
+			i++
+			b.WriteByte(s[i])
+			last = s[i]
+		}
+	}
+
+	return b.String()
+}
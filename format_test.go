@@ -0,0 +1,114 @@
+package cssgo
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestRenderWith(t *testing.T) {
+	nodes := []Node{
+		Class("foo").Or(Class("bar")).Props(TextColor(RGBA(255, 0, 0, 0.5)), Padding2(PX(8), PX(16))),
+		Media("(min-width: 600px)", El("nav").Props(Content(Str("a ,  b")), FontFamily(FontName("Open Sans"), SansSerif))),
+	}
+
+	tests := []struct {
+		name string
+		opts RenderOptions
+		want string
+	}{
+		{
+			name: "default",
+			opts: RenderOptions{},
+			want: `.foo, .bar{color: rgba(255, 0, 0, 0.5);padding: 8px 16px;}` +
+				`@media (min-width: 600px){nav{content: "a ,  b";font-family: "Open Sans", sans-serif;}}`,
+		},
+		{
+			name: "pretty",
+			opts: Pretty,
+			want: ".foo, .bar {\n" +
+				"  color: rgba(255, 0, 0, 0.5);\n" +
+				"  padding: 8px 16px;\n" +
+				"}\n" +
+				"@media (min-width: 600px) {\n" +
+				"  nav {\n" +
+				"    content: \"a ,  b\";\n" +
+				"    font-family: \"Open Sans\", sans-serif;\n" +
+				"  }\n" +
+				"}\n",
+		},
+		{
+			name: "newlines without indent",
+			opts: RenderOptions{Newlines: true},
+			want: ".foo, .bar {\ncolor: rgba(255, 0, 0, 0.5);\npadding: 8px 16px;\n}\n" +
+				"@media (min-width: 600px) {\nnav {\ncontent: \"a ,  b\";\nfont-family: \"Open Sans\", sans-serif;\n}\n}\n",
+		},
+		{
+			name: "minify",
+			opts: Minified,
+			want: `.foo,.bar{color:rgba(255,0,0,0.5);padding:8px 16px}` +
+				`@media(min-width:600px){nav{content:"a ,  b";font-family:"Open Sans",sans-serif}}`,
+		},
+	}
+
+	for _, test := range tests {
+		var b strings.Builder
+		if err := RenderWith(&b, test.opts, nodes...); err != nil {
+			t.Fatalf("TESTCASE %s: unexpected error: %v", test.name, err)
+		}
+		if b.String() != test.want {
+			t.Fatalf("TESTCASE %s: FAIL\ngot: %s != want: %s", test.name, b.String(), test.want)
+		}
+	}
+}
+
+func TestMinifySelectors(t *testing.T) {
+	var b strings.Builder
+	rule := Selector(func(w io.Writer) error {
+		_, err := w.Write([]byte(`nav  >  a ~ p + [title="a > b"]`))
+		return err
+	}).Props(Declaration{Property: "color", Value: "red", Important: true})
+
+	if err := RenderWith(&b, Minified, rule); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := `nav>a~p+[title="a > b"]{color:red!important}`; b.String() != want {
+		t.Fatalf("FAIL\ngot: %s != want: %s", b.String(), want)
+	}
+}
+
+func TestRenderWithFragments(t *testing.T) {
+	tests := []struct {
+		name string
+		opts RenderOptions
+		node Node
+		want string
+	}{
+		{name: "pretty value", opts: Pretty, node: Red, want: "red"},
+		{name: "minified value", opts: Minified, node: RGBA(255, 0, 0, 0.5), want: "rgba(255,0,0,0.5)"},
+		{name: "minified selector", opts: Minified, node: Class("a"), want: ".a"},
+		{name: "minified selector list", opts: Minified, node: Class("a").Or(El("nav")), want: ".a,nav"},
+	}
+
+	for _, test := range tests {
+		var b strings.Builder
+		if err := RenderWith(&b, test.opts, test.node); err != nil {
+			t.Fatalf("TESTCASE %s: unexpected error: %v", test.name, err)
+		}
+		if b.String() != test.want {
+			t.Fatalf("TESTCASE %s: FAIL\ngot: %s != want: %s", test.name, b.String(), test.want)
+		}
+	}
+}
+
+func TestMinifyPrelude(t *testing.T) {
+	var b strings.Builder
+	rule := Media("screen and ( min-width : 600px ) and (max-width: 900px)", El("nav").Props(TextColor(Red)))
+
+	if err := RenderWith(&b, Minified, rule); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := `@media screen and (min-width:600px) and (max-width:900px){nav{color:red}}`; b.String() != want {
+		t.Fatalf("FAIL\ngot: %s != want: %s", b.String(), want)
+	}
+}
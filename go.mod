@@ -2,4 +2,4 @@ module github.com/avearmin/cssgo
 
 go 1.22.0
 
-require maragu.dev/gomponents v1.0.0 // indirect
+require maragu.dev/gomponents v1.0.0
@@ -0,0 +1,329 @@
+package cssgo
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// grammarKind is the kind of a component of a value definition syntax.
+type grammarKind int
+
+const (
+	keywordGrammar  grammarKind = iota // auto
+	typeGrammar                        // <length>, <line-style> or <'width'>
+	functionGrammar                    // fit-content( <length-percentage> )
+	literalGrammar                     // , / or '['
+	sequenceGrammar                    // a b: all, in order
+	allGrammar                         // a && b: all, in any order
+	anyGrammar                         // a || b: one or more, in any order
+	oneGrammar                         // a | b: exactly one
+)
+
+// grammar is a parsed value definition syntax, as used by the CSS specifications.
+// Example: parseGrammar("<length [0,∞]>{1,2} | auto")
+type grammar struct {
+	kind     grammarKind
+	name     string // the keyword, literal, function or data type
+	prop     bool   // a reference to the syntax of a property, as in <'width'>
+	children []*grammar
+
+	// lo and hi are the range of numeric data types, as in <number [0,∞]>.
+	lo, hi float64
+
+	// minRep and maxRep are the repetitions allowed by the multiplier, 1 and 1 without one.
+	minRep, maxRep int
+	comma          bool // # multiplier: repetitions are separated by commas
+	required       bool // ! multiplier: a group must match at least one value
+}
+
+// parseGrammar parses a value definition syntax.
+func parseGrammar(syntax string) (*grammar, error) {
+	p := &grammarParser{syntax: syntax}
+	g, err := p.alternatives()
+	if err != nil {
+		return nil, err
+	}
+	if p.skipSpaces(); p.pos < len(syntax) {
+		return nil, p.errorf("unexpected %q", syntax[p.pos:])
+	}
+	return g, nil
+}
+
+type grammarParser struct {
+	syntax string
+	pos    int
+}
+
+func (p *grammarParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("cssgo: invalid syntax %q: %s", p.syntax, fmt.Sprintf(format, args...))
+}
+
+func (p *grammarParser) skipSpaces() {
+	for p.pos < len(p.syntax) && p.syntax[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// peek reports whether the syntax continues with s, after spaces.
+func (p *grammarParser) peek(s string) bool {
+	p.skipSpaces()
+	return strings.HasPrefix(p.syntax[p.pos:], s)
+}
+
+// alternatives parses `a | b`, which binds the loosest.
+func (p *grammarParser) alternatives() (*grammar, error) {
+	return p.combination(oneGrammar, "|", p.anyOrder)
+}
+
+// anyOrder parses `a || b`.
+func (p *grammarParser) anyOrder() (*grammar, error) {
+	return p.combination(anyGrammar, "||", p.allOrder)
+}
+
+// allOrder parses `a && b`.
+func (p *grammarParser) allOrder() (*grammar, error) {
+	return p.combination(allGrammar, "&&", p.sequence)
+}
+
+// combination parses operands separated by a combinator, which must not be followed by
+// another combinator character, so that `|` is not confused with `||`.
+func (p *grammarParser) combination(kind grammarKind, combinator string, operand func() (*grammar, error)) (*grammar, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	g := &grammar{kind: kind, children: []*grammar{first}, minRep: 1, maxRep: 1}
+	for p.peek(combinator) && !p.peek(combinator+combinator[:1]) {
+		p.pos += len(combinator)
+		next, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		g.children = append(g.children, next)
+	}
+
+	if len(g.children) == 1 {
+		return first, nil
+	}
+	return g, nil
+}
+
+// sequence parses components separated by spaces.
+func (p *grammarParser) sequence() (*grammar, error) {
+	g := &grammar{kind: sequenceGrammar, minRep: 1, maxRep: 1}
+	for {
+		p.skipSpaces()
+		if p.pos == len(p.syntax) || strings.ContainsRune("|&])", rune(p.syntax[p.pos])) {
+			break
+		}
+
+		c, err := p.component()
+		if err != nil {
+			return nil, err
+		}
+		g.children = append(g.children, c)
+	}
+
+	switch len(g.children) {
+	case 0:
+		return nil, p.errorf("expected a component at %d", p.pos)
+	case 1:
+		return g.children[0], nil
+	}
+	return g, nil
+}
+
+// component parses a single component and its multiplier.
+func (p *grammarParser) component() (*grammar, error) {
+	s := p.syntax
+	g := &grammar{minRep: 1, maxRep: 1}
+
+	switch c := s[p.pos]; {
+	case c == '[':
+		p.pos++
+		inner, err := p.alternatives()
+		if err != nil {
+			return nil, err
+		}
+		if !p.peek("]") {
+			return nil, p.errorf("unclosed [")
+		}
+		p.pos++
+		// Wrap the group, so that its multiplier does not replace one of the inner group.
+		g.kind, g.children = sequenceGrammar, []*grammar{inner}
+
+	case c == '<':
+		end := strings.IndexByte(s[p.pos:], '>')
+		if end < 0 {
+			return nil, p.errorf("unclosed <")
+		}
+		if err := g.parseType(s[p.pos+1 : p.pos+end]); err != nil {
+			return nil, p.errorf("%v", err)
+		}
+		p.pos += end + 1
+
+	case c == '\'':
+		end := strings.IndexByte(s[p.pos+1:], '\'')
+		if end < 0 {
+			return nil, p.errorf("unclosed quote")
+		}
+		g.kind, g.name = literalGrammar, s[p.pos+1:p.pos+1+end]
+		p.pos += end + 2
+
+	case c == ',' || c == '/':
+		g.kind, g.name = literalGrammar, string(c)
+		p.pos++
+
+	case isIdentByte(c):
+		start := p.pos
+		for p.pos < len(s) && isIdentByte(s[p.pos]) {
+			p.pos++
+		}
+		g.kind, g.name = keywordGrammar, s[start:p.pos]
+
+		if p.pos < len(s) && s[p.pos] == '(' {
+			p.pos++
+			g.kind = functionGrammar
+			if !p.peek(")") {
+				inner, err := p.alternatives()
+				if err != nil {
+					return nil, err
+				}
+				g.children = []*grammar{inner}
+			}
+			if !p.peek(")") {
+				return nil, p.errorf("unclosed function %s(", g.name)
+			}
+			p.pos++
+		}
+
+	default:
+		return nil, p.errorf("unexpected %q", c)
+	}
+
+	return g, p.multiplier(g)
+}
+
+// parseType parses the inside of a data type, as in `length [0,∞]` or `'width'`.
+func (g *grammar) parseType(s string) error {
+	g.kind, g.lo, g.hi = typeGrammar, math.Inf(-1), math.Inf(1)
+
+	name, rng, hasRange := strings.Cut(s, "[")
+	g.name = strings.TrimSpace(name)
+	if strings.HasPrefix(g.name, "'") {
+		g.name, g.prop = strings.Trim(g.name, "'"), true
+	}
+	if !hasRange {
+		return nil
+	}
+
+	lo, hi, ok := strings.Cut(strings.TrimSuffix(strings.TrimSpace(rng), "]"), ",")
+	if !ok {
+		return fmt.Errorf("invalid range in <%s>", s)
+	}
+	var err error
+	if g.lo, err = parseBound(lo); err != nil {
+		return err
+	}
+	g.hi, err = parseBound(hi)
+	return err
+}
+
+// parseBound parses a range bound such as 0, -90deg or ∞, ignoring its unit.
+func parseBound(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	switch s {
+	case "∞", "+∞":
+		return math.Inf(1), nil
+	case "-∞":
+		return math.Inf(-1), nil
+	}
+	return strconv.ParseFloat(strings.TrimRight(s, "abcdefghijklmnopqrstuvwxyz%"), 64)
+}
+
+// multiplier parses the multipliers following a component.
+func (p *grammarParser) multiplier(g *grammar) error {
+	for p.pos < len(p.syntax) {
+		switch p.syntax[p.pos] {
+		case '?':
+			g.minRep, g.maxRep = 0, 1
+		case '*':
+			g.minRep, g.maxRep = 0, math.MaxInt
+		case '+':
+			g.minRep, g.maxRep = 1, math.MaxInt
+		case '#':
+			g.minRep, g.maxRep, g.comma = 1, math.MaxInt, true
+		case '!':
+			g.required = true
+		case '{':
+			end := strings.IndexByte(p.syntax[p.pos:], '}')
+			if end < 0 {
+				return p.errorf("unclosed {")
+			}
+			lo, hi, isRange := strings.Cut(p.syntax[p.pos+1:p.pos+end], ",")
+			var err error
+			if g.minRep, err = strconv.Atoi(lo); err != nil {
+				return p.errorf("invalid multiplier {%s}", p.syntax[p.pos+1:p.pos+end])
+			}
+			switch {
+			case !isRange:
+				g.maxRep = g.minRep
+			case hi == "":
+				g.maxRep = math.MaxInt
+			default:
+				if g.maxRep, err = strconv.Atoi(hi); err != nil {
+					return p.errorf("invalid multiplier {%s}", p.syntax[p.pos+1:p.pos+end])
+				}
+			}
+			p.pos += end
+		default:
+			return nil
+		}
+		p.pos++
+	}
+	return nil
+}
+
+var (
+	grammarMu    sync.Mutex
+	grammarCache = map[string]*grammar{}
+)
+
+// cachedGrammar returns the parsed syntax of a property (with prop set) or a named data type,
+// or nil if it is unknown.
+func cachedGrammar(name string, prop bool) (*grammar, error) {
+	key := "<" + name + ">"
+	if prop {
+		key = "<'" + name + "'>"
+	}
+
+	grammarMu.Lock()
+	defer grammarMu.Unlock()
+	if g, ok := grammarCache[key]; ok {
+		return g, nil
+	}
+
+	var syntax string
+	var ok bool
+	if prop {
+		var info PropertyInfo
+		info, ok = properties[name]
+		syntax = info.Syntax
+	} else {
+		syntax, ok = syntaxes[name]
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	g, err := parseGrammar(syntax)
+	if err != nil {
+		return nil, err
+	}
+	grammarCache[key] = g
+	return g, nil
+}
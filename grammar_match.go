@@ -0,0 +1,817 @@
+package cssgo
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// valueTokenKind is the kind of a component value of a property value.
+type valueTokenKind int
+
+const (
+	identToken valueTokenKind = iota
+	numberToken
+	percentageToken
+	dimensionToken
+	stringToken
+	hashToken
+	functionToken
+	commaToken
+	delimToken // /, [, ] and other single characters
+)
+
+// valueToken is a component value of a property value.
+type valueToken struct {
+	kind  valueTokenKind
+	text  string  // the source of the token, or the lowercased name of an ident or function
+	value float64 // the value of numeric tokens
+	unit  string  // the lowercased unit of dimensions
+	// integer reports whether a number was written without a fraction or exponent.
+	integer bool
+	args    []valueToken // the arguments of a function
+	source  string       // the source of a function, including its arguments
+}
+
+// tokenizeValue splits a property value into component values. Function arguments are
+// tokenized recursively. It reports false for values it cannot tokenize, such as unclosed strings.
+// Example: tokenizeValue("1px solid rgb(0 0 0)") -> [1px solid rgb(...)]
+func tokenizeValue(s string) ([]valueToken, bool) {
+	tokens, rest, ok := tokenizeUntil(s, false)
+	return tokens, ok && rest == ""
+}
+
+// tokenizeUntil tokenizes s until its end, or until the `)` closing a function when inFunction is set,
+// returning the source following it.
+func tokenizeUntil(s string, inFunction bool) ([]valueToken, string, bool) {
+	var tokens []valueToken
+	for {
+		s = strings.TrimLeft(s, " \t\n\r\f")
+		if s == "" {
+			return tokens, "", !inFunction
+		}
+
+		c := s[0]
+		switch {
+		case c == ')':
+			return tokens, s[1:], inFunction
+
+		case c == '"' || c == '\'':
+			i := 1
+			for ; i < len(s) && s[i] != c; i++ {
+				if s[i] == '\\' {
+					i++
+				}
+			}
+			if i >= len(s) {
+				return nil, "", false
+			}
+			tokens = append(tokens, valueToken{kind: stringToken, text: s[:i+1]})
+			s = s[i+1:]
+
+		case c == '#':
+			n := identLength(s[1:], true)
+			tokens = append(tokens, valueToken{kind: hashToken, text: s[:1+n]})
+			s = s[1+n:]
+
+		case c == ',':
+			tokens = append(tokens, valueToken{kind: commaToken, text: ","})
+			s = s[1:]
+
+		case c == '(':
+			// A parenthesized block, as in calc((1px + 2px) * 3), is a function without a name.
+			args, rest, ok := tokenizeUntil(s[1:], true)
+			if !ok {
+				return nil, "", false
+			}
+			tokens = append(tokens, valueToken{kind: functionToken, args: args, source: s[:len(s)-len(rest)]})
+			s = rest
+
+		case startsNumber(s):
+			n := numberLength(s)
+			v, err := strconv.ParseFloat(s[:n], 64)
+			if err != nil {
+				return nil, "", false
+			}
+			t := valueToken{kind: numberToken, text: s[:n], value: v, integer: !strings.ContainsAny(s[:n], ".eE")}
+			switch unit := identLength(s[n:], false); {
+			case strings.HasPrefix(s[n:], "%"):
+				t.kind, n = percentageToken, n+1
+			case unit > 0:
+				t.kind, t.unit, n = dimensionToken, strings.ToLower(s[n:n+unit]), n+unit
+			}
+			t.text = s[:n]
+			tokens = append(tokens, t)
+			s = s[n:]
+
+		case identLength(s, false) > 0:
+			n := identLength(s, false)
+			name := strings.ToLower(s[:n])
+			if n == len(s) || s[n] != '(' {
+				tokens = append(tokens, valueToken{kind: identToken, text: name})
+				s = s[n:]
+				continue
+			}
+
+			t := valueToken{kind: functionToken, text: name}
+			rest := s[n+1:]
+			if trimmed := strings.TrimLeft(rest, " "); name == "url" && trimmed != "" && trimmed[0] != '"' && trimmed[0] != '\'' {
+				// An unquoted url() is a single token, whose content is not tokenized.
+				end := strings.IndexByte(rest, ')')
+				if end < 0 {
+					return nil, "", false
+				}
+				t.args = []valueToken{{kind: stringToken, text: strings.TrimSpace(rest[:end])}}
+				rest = rest[end+1:]
+			} else {
+				var ok bool
+				if t.args, rest, ok = tokenizeUntil(rest, true); !ok {
+					return nil, "", false
+				}
+			}
+			t.source = s[:len(s)-len(rest)]
+			tokens = append(tokens, t)
+			s = rest
+
+		default:
+			tokens = append(tokens, valueToken{kind: delimToken, text: s[:1]})
+			s = s[1:]
+		}
+	}
+}
+
+// identLength returns the length of the identifier at the start of s, or 0.
+// With name set, it returns the length of a name, which may start with a digit, as in hex colors.
+func identLength(s string, name bool) int {
+	i := 0
+	if !name {
+		if strings.HasPrefix(s, "--") {
+			i = 2
+		} else if strings.HasPrefix(s, "-") {
+			i = 1
+		}
+		if i < len(s) && i < 2 && !(s[i] == '_' || s[i] >= 0x80 || s[i] == '\\' || s[i] >= 'a' && s[i] <= 'z' || s[i] >= 'A' && s[i] <= 'Z') {
+			return 0
+		}
+	}
+	for i < len(s) {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s):
+			i += 2
+		case c == '-' || c == '_' || c >= 0x80 || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9':
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// startsNumber reports whether s starts with a number, such as 1, -.5 or +2e3.
+func startsNumber(s string) bool {
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+	if s != "" && s[0] == '.' {
+		s = s[1:]
+	}
+	return s != "" && s[0] >= '0' && s[0] <= '9'
+}
+
+// numberLength returns the length of the number at the start of s.
+func numberLength(s string) int {
+	i := 0
+	if s[i] == '+' || s[i] == '-' {
+		i++
+	}
+	digits := func() {
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+	}
+	digits()
+	if i+1 < len(s) && s[i] == '.' && s[i+1] >= '0' && s[i+1] <= '9' {
+		i++
+		digits()
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+		if j < len(s) && s[j] >= '0' && s[j] <= '9' {
+			i = j
+			digits()
+		}
+	}
+	return i
+}
+
+// maxMatchSteps bounds the work spent matching a value, so that pathological values
+// cannot make validation slow. Values that exhaust it are accepted.
+const maxMatchSteps = 200000
+
+type matchKey struct {
+	g    *grammar
+	pos  int
+	used uint64 // the children already matched by `a && b` and `a || b`
+}
+
+// matcher matches a list of tokens against grammars, by backtracking over every way a
+// grammar can match, and memoizing the end positions of each grammar at each position.
+type matcher struct {
+	tokens    []valueToken
+	memo      map[matchKey][]int
+	steps     int
+	exhausted bool
+	err       error
+}
+
+// matches reports whether the grammar matches the whole list of tokens.
+func matches(g *grammar, tokens []valueToken) (bool, error) {
+	m := &matcher{tokens: tokens, memo: map[matchKey][]int{}}
+	ends := m.match(g, 0)
+	if m.err != nil {
+		return false, m.err
+	}
+	return m.exhausted || slices.Contains(ends, len(tokens)), nil
+}
+
+// match returns the positions at which a match of g starting at pos can end, without duplicates.
+func (m *matcher) match(g *grammar, pos int) []int {
+	key := matchKey{g: g, pos: pos}
+	if ends, ok := m.memo[key]; ok {
+		return ends
+	}
+	if m.steps++; m.steps > maxMatchSteps {
+		m.exhausted = true
+		return nil
+	}
+
+	ends := m.repeat(g, pos)
+	if g.required {
+		ends = remove(ends, pos)
+	}
+	m.memo[key] = ends
+	return ends
+}
+
+// repeat matches the repetitions of g allowed by its multiplier.
+func (m *matcher) repeat(g *grammar, pos int) []int {
+	if g.minRep == 1 && g.maxRep == 1 {
+		return m.once(g, pos)
+	}
+
+	var ends []int
+	if g.minRep == 0 {
+		ends = append(ends, pos)
+	}
+
+	current := []int{pos}
+	for rep := 1; rep <= g.maxRep && len(current) > 0; rep++ {
+		var next []int
+		for _, p := range current {
+			start := p
+			if rep > 1 && g.comma {
+				if p >= len(m.tokens) || m.tokens[p].kind != commaToken {
+					continue
+				}
+				start++
+			}
+			for _, e := range m.once(g, start) {
+				// Stop repeating empty matches, which would never end.
+				if e > p || rep == 1 {
+					next = appendUnique(next, e)
+				}
+			}
+		}
+		if rep >= g.minRep {
+			for _, e := range next {
+				ends = appendUnique(ends, e)
+			}
+		}
+		current = remove(next, pos)
+	}
+	return ends
+}
+
+// once matches a single occurrence of g.
+func (m *matcher) once(g *grammar, pos int) []int {
+	switch g.kind {
+	case keywordGrammar:
+		if pos < len(m.tokens) && m.tokens[pos].kind == identToken && m.tokens[pos].text == strings.ToLower(g.name) {
+			return []int{pos + 1}
+		}
+
+	case literalGrammar:
+		if g.name == "," {
+			return m.comma(pos)
+		}
+		if pos >= len(m.tokens) {
+			return nil
+		}
+		t := m.tokens[pos]
+		if (t.kind == commaToken || t.kind == delimToken) && t.text == g.name {
+			return []int{pos + 1}
+		}
+
+	case functionGrammar:
+		if pos >= len(m.tokens) || m.tokens[pos].kind != functionToken || m.tokens[pos].text != strings.ToLower(g.name) {
+			return nil
+		}
+		if len(g.children) == 0 {
+			return []int{pos + 1}
+		}
+		ok, err := matches(g.children[0], m.tokens[pos].args)
+		if err != nil {
+			m.err = err
+		}
+		if ok {
+			return []int{pos + 1}
+		}
+
+	case typeGrammar:
+		return m.dataType(g, pos)
+
+	case sequenceGrammar:
+		current := []int{pos}
+		for _, child := range g.children {
+			var next []int
+			for _, p := range current {
+				for _, e := range m.match(child, p) {
+					next = appendUnique(next, e)
+				}
+			}
+			if current = next; len(current) == 0 {
+				return nil
+			}
+		}
+		return current
+
+	case oneGrammar:
+		var ends []int
+		for _, child := range g.children {
+			for _, e := range m.match(child, pos) {
+				ends = appendUnique(ends, e)
+			}
+		}
+		return ends
+
+	case allGrammar, anyGrammar:
+		return m.unordered(g, pos, 0)
+	}
+	return nil
+}
+
+// comma matches a comma of the grammar, which is omitted next to omitted optional terms, as in
+// counter(item) for `counter( <counter-name>, <counter-style>? )` (CSS Values 4 §2.6): it is
+// omitted at the start or the end of the list, and next to another comma, and must then be absent.
+func (m *matcher) comma(pos int) []int {
+	start := pos == 0 || m.tokens[pos-1].kind == commaToken
+	if pos < len(m.tokens) && m.tokens[pos].kind == commaToken {
+		if start || pos+1 == len(m.tokens) {
+			return nil
+		}
+		return []int{pos + 1}
+	}
+	if start || pos == len(m.tokens) {
+		return []int{pos}
+	}
+	return nil
+}
+
+// unordered matches the children of `a && b` or `a || b` in any order, where used
+// marks the children that were already matched.
+func (m *matcher) unordered(g *grammar, pos int, used uint64) []int {
+	key := matchKey{g: g, pos: pos, used: used | 1<<63}
+	if ends, ok := m.memo[key]; ok {
+		return ends
+	}
+
+	var ends []int
+	if used == 1<<len(g.children)-1 || g.kind == anyGrammar && used != 0 {
+		ends = append(ends, pos)
+	}
+
+	for i, child := range g.children {
+		if used&(1<<i) != 0 {
+			continue
+		}
+		for _, e := range m.match(child, pos) {
+			if e == pos && g.kind == anyGrammar {
+				continue
+			}
+			for _, end := range m.unordered(g, e, used|1<<i) {
+				ends = appendUnique(ends, end)
+			}
+		}
+		if m.steps++; m.steps > maxMatchSteps {
+			m.exhausted = true
+			return ends
+		}
+	}
+	m.memo[key] = ends
+	return ends
+}
+
+// dataType matches a data type: a basic type such as <length>, the syntax of a property
+// such as <'width'>, or a named syntax such as <line-style>.
+func (m *matcher) dataType(g *grammar, pos int) []int {
+	if g.prop || g.name != "ratio" && basicTypes[g.name] == nil {
+		ref, err := cachedGrammar(g.name, g.prop)
+		if err != nil || ref == nil {
+			// The dataset is checked by the tests, so an unknown reference is a bug in it.
+			m.exhausted = true
+			return nil
+		}
+		return m.match(ref, pos)
+	}
+
+	if g.name == "ratio" {
+		// <ratio> = <number [0,∞]> [ / <number [0,∞]> ]?
+		number := &grammar{kind: typeGrammar, name: "number", lo: 0, hi: math.Inf(1)}
+		ends := m.dataType(number, pos)
+		if len(ends) == 1 && ends[0] < len(m.tokens) && m.tokens[ends[0]].kind == delimToken && m.tokens[ends[0]].text == "/" {
+			ends = append(ends, m.dataType(number, ends[0]+1)...)
+		}
+		return ends
+	}
+
+	if pos >= len(m.tokens) {
+		return nil
+	}
+	t := m.tokens[pos]
+	if t.kind == functionToken && mathFunctions[t.text] && numericTypes[g.name] {
+		if typ, ok := mathType(t); !ok || !mathTypeMatches(typ, g.name) {
+			return nil
+		}
+		return []int{pos + 1}
+	}
+	if !basicTypes[g.name](t) {
+		return nil
+	}
+	if (t.kind == numberToken || t.kind == dimensionToken || t.kind == percentageToken) && (t.value < g.lo || t.value > g.hi) {
+		return nil
+	}
+	return []int{pos + 1}
+}
+
+// mathFunctions are the functions that compute numeric values, whose arguments are checked by mathType.
+var mathFunctions = map[string]bool{
+	"calc": true, "min": true, "max": true, "clamp": true, "round": true, "mod": true, "rem": true,
+	"abs": true, "sign": true, "sin": true, "cos": true, "tan": true, "asin": true, "acos": true,
+	"atan": true, "atan2": true, "pow": true, "sqrt": true, "hypot": true, "log": true, "exp": true,
+}
+
+// mathType returns the type a math function computes, such as "length" for calc(100px - 1em),
+// or "length-percentage" for calc(100% - 1em). It reports false when the arguments are not well
+// formed expressions, or combine incompatible types, as in calc(100% - red) or calc(1px + 1s).
+// The type is empty when it cannot be known, as with var() or the product of two lengths.
+func mathType(t valueToken) (string, bool) {
+	var args [][]valueToken
+	start := 0
+	for i := 0; i <= len(t.args); i++ {
+		if i == len(t.args) || t.args[i].kind == commaToken {
+			args = append(args, t.args[start:i])
+			start = i + 1
+		}
+	}
+
+	types := make([]string, len(args))
+	for i, arg := range args {
+		if len(arg) == 1 && arg[0].kind == identToken &&
+			(t.text == "round" && i == 0 && roundingStrategies[arg[0].text] || t.text == "clamp" && i != 1 && arg[0].text == "none") {
+			// The rounding strategy of round(), and `none` bounds of clamp(), which have no type.
+			types[i] = "none"
+			continue
+		}
+		typ, ok := mathExpression(arg)
+		if !ok {
+			return "", false
+		}
+		types[i] = typ
+	}
+	if t.text == "round" && types[0] == "none" {
+		types = types[1:]
+	}
+
+	// sum combines the types of arguments that must have the same type, such as those of min().
+	sum := func(lo, hi int) (string, bool) {
+		if len(types) < lo || len(types) > hi {
+			return "", false
+		}
+		typ, first := "", true
+		for _, arg := range types {
+			if arg == "none" {
+				continue
+			}
+			if first {
+				typ, first = arg, false
+				continue
+			}
+			var ok bool
+			if typ, ok = sumType(typ, arg); !ok {
+				return "", false
+			}
+		}
+		return typ, true
+	}
+	// numbers checks the count of arguments that must all be numbers, as those of pow().
+	numbers := func(lo, hi int, result string) (string, bool) {
+		typ, ok := sum(lo, hi)
+		return result, ok && (typ == "" || typ == "number")
+	}
+
+	switch t.text {
+	case "", "calc", "abs":
+		return sum(1, 1)
+	case "min", "max", "hypot":
+		return sum(1, math.MaxInt)
+	case "clamp":
+		return sum(3, 3)
+	case "round":
+		return sum(1, 2)
+	case "mod", "rem":
+		return sum(2, 2)
+	case "sign":
+		_, ok := sum(1, 1)
+		return "number", ok
+	case "sin", "cos", "tan":
+		typ, ok := sum(1, 1)
+		return "number", ok && (typ == "" || typ == "number" || typ == "angle")
+	case "asin", "acos", "atan":
+		return numbers(1, 1, "angle")
+	case "atan2":
+		_, ok := sum(2, 2)
+		return "angle", ok
+	case "pow":
+		return numbers(2, 2, "number")
+	case "sqrt", "exp":
+		return numbers(1, 1, "number")
+	case "log":
+		return numbers(1, 2, "number")
+	}
+	return "", false
+}
+
+// mathExpression returns the type of an expression of a math function, in which operands
+// are separated by the operators +, -, * and /. Products are computed before sums.
+func mathExpression(tokens []valueToken) (string, bool) {
+	if len(tokens)%2 == 0 {
+		return "", false
+	}
+
+	term, ok := mathOperand(tokens[0])
+	if !ok {
+		return "", false
+	}
+	sum, summed := "", false
+	for i := 1; i < len(tokens); i += 2 {
+		operand, ok := mathOperand(tokens[i+1])
+		if !isMathOperator(tokens[i]) || !ok {
+			return "", false
+		}
+
+		switch tokens[i].text {
+		case "*":
+			term = productType(term, operand)
+		case "/":
+			term = quotientType(term, operand)
+		default:
+			if !summed {
+				sum, summed = term, true
+			} else if sum, ok = sumType(sum, term); !ok {
+				return "", false
+			}
+			term = operand
+		}
+	}
+	if summed {
+		return sumType(sum, term)
+	}
+	return term, true
+}
+
+// mathOperand returns the type of an operand of a math expression.
+func mathOperand(t valueToken) (string, bool) {
+	switch t.kind {
+	case numberToken:
+		return "number", true
+	case percentageToken:
+		return "percentage", true
+	case dimensionToken:
+		typ, ok := unitTypes[t.unit]
+		if !ok && lengthUnits[t.unit] {
+			typ, ok = "length", true
+		}
+		return typ, ok
+	case identToken:
+		return "number", mathConstants[t.text]
+	case functionToken:
+		if t.text == "var" || t.text == "env" || t.text == "attr" {
+			return "", true
+		}
+		if t.text == "" || mathFunctions[t.text] {
+			return mathType(t)
+		}
+	}
+	return "", false
+}
+
+// sumType returns the type of the sum of two types, which must be the same,
+// except that percentages combine with other types, as in calc(100% - 1em).
+func sumType(a, b string) (string, bool) {
+	if a == "" || b == "" {
+		return "", true
+	}
+	if a == b {
+		return a, true
+	}
+
+	baseA, percentA := strings.CutSuffix(a, "-percentage")
+	baseB, percentB := strings.CutSuffix(b, "-percentage")
+	if a == "percentage" {
+		baseA, percentA = "", true
+	}
+	if b == "percentage" {
+		baseB, percentB = "", true
+	}
+	if baseA == "number" || baseB == "number" || baseA != "" && baseB != "" && baseA != baseB {
+		return "", false
+	}
+	if !percentA && !percentB {
+		return "", false
+	}
+	if baseA == "" {
+		baseA = baseB
+	}
+	return baseA + "-percentage", true
+}
+
+// productType returns the type of the product of two types, one of which is usually a number.
+// Products of dimensions are valid intermediate results, but their type is not tracked.
+func productType(a, b string) string {
+	switch {
+	case a == "number":
+		return b
+	case b == "number":
+		return a
+	}
+	return ""
+}
+
+// quotientType returns the type of the quotient of two types.
+func quotientType(a, b string) string {
+	switch {
+	case b == "number":
+		return a
+	case a == b && a != "":
+		return "number"
+	}
+	return ""
+}
+
+// mathTypeMatches reports whether a math function of the given type is a valid value of a basic type.
+func mathTypeMatches(typ, basic string) bool {
+	switch basic {
+	case "length-percentage":
+		return typ == "" || typ == "length" || typ == "percentage" || typ == "length-percentage"
+	case "integer":
+		return typ == "" || typ == "number"
+	}
+	return typ == "" || typ == basic
+}
+
+var (
+	// unitTypes are the types of the dimensions that are not lengths.
+	unitTypes = map[string]string{
+		"s": "time", "ms": "time", "deg": "angle", "grad": "angle", "rad": "angle", "turn": "angle",
+		"fr": "flex", "hz": "frequency", "khz": "frequency",
+		"dpi": "resolution", "dpcm": "resolution", "dppx": "resolution", "x": "resolution",
+	}
+	mathConstants      = map[string]bool{"e": true, "pi": true, "infinity": true, "-infinity": true, "nan": true}
+	roundingStrategies = map[string]bool{"nearest": true, "up": true, "down": true, "to-zero": true}
+)
+
+// isMathOperator reports whether t is one of the operators of math functions.
+func isMathOperator(t valueToken) bool {
+	return t.kind == delimToken && len(t.text) == 1 && strings.ContainsAny(t.text, "+-*/")
+}
+
+// numericTypes are the basic types that math functions can produce.
+var numericTypes = map[string]bool{
+	"length": true, "percentage": true, "length-percentage": true, "number": true, "integer": true,
+	"time": true, "angle": true, "flex": true,
+}
+
+var (
+	angleUnits = map[string]bool{"deg": true, "grad": true, "rad": true, "turn": true}
+	imageFuncs = map[string]bool{
+		"url": true, "src": true, "linear-gradient": true, "radial-gradient": true, "conic-gradient": true,
+		"repeating-linear-gradient": true, "repeating-radial-gradient": true, "repeating-conic-gradient": true,
+		"image": true, "image-set": true, "cross-fade": true, "element": true, "paint": true,
+	}
+	transformFuncs = map[string]bool{
+		"matrix": true, "matrix3d": true, "translate": true, "translatex": true, "translatey": true,
+		"translatez": true, "translate3d": true, "scale": true, "scalex": true, "scaley": true, "scalez": true,
+		"scale3d": true, "rotate": true, "rotatex": true, "rotatey": true, "rotatez": true, "rotate3d": true,
+		"skew": true, "skewx": true, "skewy": true, "perspective": true,
+	}
+	filterFuncs = map[string]bool{
+		"blur": true, "brightness": true, "contrast": true, "drop-shadow": true, "grayscale": true,
+		"hue-rotate": true, "invert": true, "opacity": true, "saturate": true, "sepia": true,
+	}
+	colorFunctions = map[string]bool{
+		"rgb": true, "rgba": true, "hsl": true, "hsla": true, "hwb": true, "lab": true, "lch": true,
+		"oklab": true, "oklch": true, "color": true, "color-mix": true, "light-dark": true,
+	}
+	// systemColors are the color keywords of the user agent, which ParseColor cannot resolve.
+	systemColors = map[string]bool{
+		"canvas": true, "canvastext": true, "linktext": true, "visitedtext": true, "activetext": true,
+		"buttonface": true, "buttontext": true, "buttonborder": true, "field": true, "fieldtext": true,
+		"highlight": true, "highlighttext": true, "selecteditem": true, "selecteditemtext": true,
+		"mark": true, "marktext": true, "graytext": true, "accentcolor": true, "accentcolortext": true,
+	}
+)
+
+// basicTypes match a token against the basic data types of the value definition syntax.
+var basicTypes = map[string]func(t valueToken) bool{
+	"length": func(t valueToken) bool {
+		return t.kind == dimensionToken && lengthUnits[t.unit] || t.kind == numberToken && t.value == 0
+	},
+	"percentage": func(t valueToken) bool { return t.kind == percentageToken },
+	"length-percentage": func(t valueToken) bool {
+		return t.kind == percentageToken || t.kind == dimensionToken && lengthUnits[t.unit] || t.kind == numberToken && t.value == 0
+	},
+	"number":  func(t valueToken) bool { return t.kind == numberToken },
+	"integer": func(t valueToken) bool { return t.kind == numberToken && t.integer },
+	"time":    func(t valueToken) bool { return t.kind == dimensionToken && (t.unit == "s" || t.unit == "ms") },
+	"angle": func(t valueToken) bool {
+		return t.kind == dimensionToken && angleUnits[t.unit] || t.kind == numberToken && t.value == 0
+	},
+	"flex":   func(t valueToken) bool { return t.kind == dimensionToken && t.unit == "fr" },
+	"string": func(t valueToken) bool { return t.kind == stringToken },
+	"url":    func(t valueToken) bool { return t.kind == functionToken && (t.text == "url" || t.text == "src") },
+	"image":  func(t valueToken) bool { return t.kind == functionToken && imageFuncs[t.text] },
+	"custom-ident": func(t valueToken) bool {
+		return t.kind == identToken && !isGlobalKeyword(t.text) && t.text != "default"
+	},
+	"dashed-ident": func(t valueToken) bool { return t.kind == identToken && strings.HasPrefix(t.text, "--") },
+	"color": func(t valueToken) bool {
+		switch t.kind {
+		case identToken:
+			_, err := ParseColor(t.text)
+			return err == nil || systemColors[t.text]
+		case hashToken:
+			_, err := ParseColor(t.text)
+			return err == nil
+		case functionToken:
+			return colorFunctions[t.text] && validColorFunction(t)
+		}
+		return false
+	},
+	"transform-function": func(t valueToken) bool { return t.kind == functionToken && transformFuncs[t.text] },
+	"filter-function":    func(t valueToken) bool { return t.kind == functionToken && filterFuncs[t.text] },
+}
+
+// validColorFunction reports whether a color function is valid, by parsing it with ParseColor.
+// Colors that cannot be resolved statically, such as those using currentcolor, are accepted,
+// and so are colors with other functions in their arguments, such as calc() or var().
+func validColorFunction(t valueToken) bool {
+	var nested func(args []valueToken) bool
+	nested = func(args []valueToken) bool {
+		for _, arg := range args {
+			if arg.kind == functionToken && (!colorFunctions[arg.text] || nested(arg.args)) {
+				return true
+			}
+		}
+		return false
+	}
+	if nested(t.args) {
+		return true
+	}
+
+	_, err := ParseColor(t.source)
+	return err == nil || errors.Is(err, errUnresolvedColor)
+}
+
+func appendUnique(s []int, v int) []int {
+	for _, e := range s {
+		if e == v {
+			return s
+		}
+	}
+	return append(s, v)
+}
+
+func remove(s []int, v int) []int {
+	out := s[:0:0]
+	for _, e := range s {
+		if e != v {
+			out = append(out, e)
+		}
+	}
+	return out
+}
@@ -0,0 +1,186 @@
+package cssgo
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidatePropGrammar(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   Node
+		wantErr error
+		hint    string
+	}{
+		{"known property", Prop("margin", PX(1), Auto), nil, ""},
+		{"unknown property", Prop("colro", Red), ErrUnknownProperty, `did you mean "color"?`},
+		{"property missing from the dataset", Prop("zzzzzz", Red), nil, ""},
+		{"svg property", Prop("fill", Red), nil, ""},
+		{"view transition property", Prop("view-transition-name", Ident("card")), nil, ""},
+		{"misspelled keyword", Prop("position", Ident("absolut")), ErrInvalidValue, `did you mean "absolute"?`},
+		{"wrong type", Prop("opacity", PX(1)), ErrInvalidValue, "expected <alpha-value>"},
+		{"too many values", Prop("margin", PX(1), PX(2), PX(3), PX(4), PX(5)), ErrInvalidValue, ""},
+		{"math operand", Prop("width", goType("calc(100% - red)")), ErrInvalidValue, "expected"},
+		{"color arguments", Prop("color", goType("rgb(1 2)")), ErrInvalidValue, "expected"},
+		{"comma separated", TransitionProperty(Ident("opacity"), Ident("transform")), nil, ""},
+		{"layers", TransitionLayers([]TransitionValue{Ident("opacity"), MS(200)}, []TransitionValue{Ident("transform"), S(1)}), nil, ""},
+		{"custom property", Prop("--brand", Ident("anything goes")), nil, ""},
+		{"vendor prefixed property", Prop("-webkit-text-stroke", PX(1), Red), nil, ""},
+		{"vendor prefixed value", Prop("position", Ident("-webkit-sticky")), nil, ""},
+		{"var", Prop("margin", Var("gap"), PX(1), PX(2), PX(3), PX(4)), nil, ""},
+		{"global keyword", Prop("grid-template-columns", Inherit), nil, ""},
+		{"upper case", Prop("Z-Index", Int(2)), nil, ""},
+	}
+
+	for _, test := range tests {
+		err := Validate(test.input)
+		if !errors.Is(err, test.wantErr) {
+			t.Fatalf("TESTCASE %s: FAIL\ngot error: %v != want: %v", test.name, err, test.wantErr)
+		}
+		if err != nil && !strings.Contains(err.Error(), test.hint) {
+			t.Fatalf("TESTCASE %s: FAIL\ngot error: %v, want it to contain: %s", test.name, err, test.hint)
+		}
+	}
+}
+
+func TestGeneratedPropertiesValidate(t *testing.T) {
+	for _, test := range generatedPropertyTests {
+		if err := Validate(test.input); err != nil {
+			t.Fatalf("TESTCASE %s: FAIL\nvalidation error: %v", test.name, err)
+		}
+	}
+}
+
+func TestValidatePropertyValues(t *testing.T) {
+	valid := [][2]string{
+		{"width", "calc(100% - 2 * 1rem)"},
+		{"width", "min(50vw, 30rem)"},
+		{"width", "fit-content(20em)"},
+		{"margin", "0 auto"},
+		{"margin-inline", "1dvw 1cqi"},
+		{"grid-template-columns", "repeat(auto-fill, minmax(10rem, 1fr))"},
+		{"grid-template-columns", "[full-start] 1fr [content-start] minmax(0, 60rem) [content-end] 1fr [full-end]"},
+		{"grid-area", "1 / 2 / span 3 / auto"},
+		{"grid-template-areas", `"a b" "c d"`},
+		{"transition", "opacity 200ms ease-in-out, transform 0.3s cubic-bezier(0.2, 0, 0, 1) 50ms"},
+		{"font", "italic bold 16px/1.5 Georgia, serif"},
+		{"font-family", `"Helvetica Neue", Arial, sans-serif`},
+		{"background", "url(a.png) no-repeat center / cover, linear-gradient(to right, red 10%, blue)"},
+		{"box-shadow", "inset 0 1px 2px rgb(0 0 0 / 0.5), 0 0 0 3px #0af"},
+		{"transform", "translate(-50%, 10px) rotate(45deg) scale(1.5)"},
+		{"color", "color-mix(in oklch, red 40%, white)"},
+		{"aspect-ratio", "16 / 9"},
+		{"border", "1px solid currentcolor"},
+		{"z-index", "-1"},
+		{"content", `"→ " attr(title)`},
+		{"width", "calc((100% - 2rem) / 3)"},
+		{"width", "clamp(10rem, 50% + 2vw, none)"},
+		{"width", "calc(1px * 2px / 1px)"},
+		{"width", "calc(var(--gap) * 2)"},
+		{"margin", "round(up, 10.5px, 1px) calc(pi * 1em)"},
+		{"opacity", "calc(1 / 2)"},
+		{"transform", "rotate(calc(atan2(1px, 2px) + 45deg))"},
+		{"color", "rgb(300 0 0 / 50%)"},
+		{"color", "rgb(calc(255 / 2) 0 0)"},
+		{"color", "hsl(120deg 50% 50%)"},
+		{"color", "color-mix(in srgb, currentcolor, rgb(0 0 0))"},
+		{"content", "counter(item)"},
+		{"content", "counter(item, upper-roman) counters(item, \".\")"},
+		{"clip-path", "polygon(0 0, 1px 1px, 2px 0)"},
+		{"clip-path", "polygon(evenodd, 0 0, 1px 1px, 2px 0)"},
+		{"view-transition-name", "card"},
+		{"contain-intrinsic-size", "auto 300px"},
+		{"fill", "url(#gradient) none"},
+		{"stroke", "context-stroke"},
+		{"zoom", "150%"},
+		{"hyphenate-character", `"-"`},
+		{"font-palette", "--brand"},
+		{"scroll-timeline", "--gallery x, --page"},
+		{"animation-timeline", "scroll(root block), view(inline 10% auto), --gallery"},
+		{"anchor-name", "--tooltip, --menu"},
+		{"position-area", "top span-left"},
+		{"field-sizing", "content"},
+	}
+
+	for _, v := range valid {
+		if err := validateProperty(v[0], v[1]); err != nil {
+			t.Fatalf("TESTCASE %s: %s: FAIL\nunexpected error: %v", v[0], v[1], err)
+		}
+	}
+
+	invalid := [][2]string{
+		{"width", "10"},
+		{"width", "calc(10px +)"},
+		{"z-index", "1.5"},
+		{"display", "flex grid block"},
+		{"color", "#12345"},
+		{"margin", "1px,2px"},
+		{"grid-template-columns", "repeat(0px, 1fr)"},
+		{"transition-duration", "200px"},
+		{"aspect-ratio", "16 /"},
+		{"opacity", ""},
+		{"width", "calc(100% - red)"},
+		{"width", "calc(1px + 1s)"},
+		{"width", "calc(1px 2px)"},
+		{"width", "calc(2)"},
+		{"width", "calc()"},
+		{"transition-duration", "calc(100% - 1s)"},
+		{"opacity", "calc(1px)"},
+		{"margin", "round(sideways, 1px, 2px)"},
+		{"color", "rgb(1 2)"},
+		{"color", "rgb(1, 2 3)"},
+		{"color", "hsl(1 2)"},
+		{"color", "color-mix(in nowhere, red, blue)"},
+		{"content", "counter(item,)"},
+		{"content", "counter(, upper-roman)"},
+		{"clip-path", "polygon(, 0 0, 1px 1px)"},
+		{"clip-path", "polygon(evenodd 0 0, 1px 1px)"},
+		{"anchor-name", "tooltip"},
+		{"animation-timeline", "scroll(root root)"},
+		{"field-sizing", "auto"},
+	}
+
+	for _, v := range invalid {
+		if err := validateProperty(v[0], v[1]); !errors.Is(err, ErrInvalidValue) {
+			t.Fatalf("TESTCASE %s: %s: FAIL\ngot error: %v != want: %v", v[0], v[1], err, ErrInvalidValue)
+		}
+	}
+}
+
+func TestValidationStrictProp(t *testing.T) {
+	strict := RenderOptions{Validation: ValidationStrict}
+
+	var b strings.Builder
+	if err := RenderWith(&b, strict, Prop("dispaly", Ident("flex"))); !errors.Is(err, ErrUnknownProperty) {
+		t.Fatalf("got %v, want ErrUnknownProperty", err)
+	}
+
+	b.Reset()
+	if err := RenderWith(&b, strict, Prop("display", Ident("flex"))); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestParseGrammar(t *testing.T) {
+	tests := []struct {
+		syntax string
+		want   bool
+	}{
+		{"<length> | auto", true},
+		{"[ <length> | <percentage> ]{1,4}", true},
+		{"<color>#", true},
+		{"a && b? || c", true},
+		{"<integer [1,∞]>", true},
+		{"[ a | b", false},
+		{"a{2", false},
+		{"", false},
+	}
+
+	for _, test := range tests {
+		_, err := parseGrammar(test.syntax)
+		if (err == nil) != test.want {
+			t.Fatalf("TESTCASE %s: FAIL\ngot error: %v", test.syntax, err)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package html
+
+import (
+	"github.com/avearmin/cssgo"
+	"io"
+	"maragu.dev/gomponents"
+	ghtml "maragu.dev/gomponents/html"
+	"strings"
+)
+
+// Atoms is the class attribute of an element styled in atomic mode.
+// Every declaration, together with its pseudo-class or media query, becomes a
+// single-purpose class that is shared by all elements using it, so the stylesheet
+// grows with the number of distinct declarations rather than with the number of components.
+// The properties of each call are merged with MergeProps first, so when two of them set the
+// same property the last one wins. Atoms are emitted after the other rules of the collector,
+// with min-width breakpoints from the narrowest, pseudo-classes in LVHFA order and longhands
+// after their shorthands, so that the cascade does not depend on which component rendered first.
+// It implements gomponents.Node and renders as a class attribute.
+type Atoms struct {
+	collector *Collector
+	classes   []string
+}
+
+// Atomic splits the properties into atoms, registers their rules with the collector
+// and returns the class attribute listing them.
+// Example:
+//
+//	ghtml.Button(styles.Atomic(cssgo.Padding1(cssgo.PX(8))).Hover(cssgo.TextColor(cssgo.Red)))
+//	-> <button class="a-1a2b3c4d a-5e6f7a8b"></button>
+func (c *Collector) Atomic(props ...cssgo.PropertyNode) Atoms {
+	return Atoms{collector: c}.add("", "", props)
+}
+
+// Hover adds atoms that apply while the element is hovered.
+func (a Atoms) Hover(props ...cssgo.PropertyNode) Atoms {
+	return a.Pseudo(":hover", props...)
+}
+
+// Focus adds atoms that apply while the element has focus.
+func (a Atoms) Focus(props ...cssgo.PropertyNode) Atoms {
+	return a.Pseudo(":focus", props...)
+}
+
+// Pseudo adds atoms that apply in a pseudo-class or pseudo-element, e.g. ":active" or "::placeholder".
+func (a Atoms) Pseudo(pseudo string, props ...cssgo.PropertyNode) Atoms {
+	return a.add(pseudo, "", props)
+}
+
+// Media adds atoms that apply when the media query matches, e.g. "(min-width: 600px)".
+func (a Atoms) Media(query string, props ...cssgo.PropertyNode) Atoms {
+	return a.add("", query, props)
+}
+
+// Classes returns the class names of the atoms, in the order they were added.
+func (a Atoms) Classes() []string {
+	return append([]string(nil), a.classes...)
+}
+
+func (a Atoms) Render(w io.Writer) error {
+	if a.collector == nil {
+		return ErrNoCollector
+	}
+	return ghtml.Class(strings.Join(a.classes, " ")).Render(w)
+}
+
+func (a Atoms) Type() gomponents.NodeType {
+	return gomponents.AttributeType
+}
+
+// add registers the atoms of the properties in the given context.
+// Each call copies the class list, so Atoms values can be extended independently.
+func (a Atoms) add(pseudo, media string, props []cssgo.PropertyNode) Atoms {
+	if a.collector == nil {
+		return a
+	}
+	atoms, err := cssgo.Atomize(cssgo.MergeProps(props...))
+	if err != nil {
+		a.collector.addErrors(err)
+		return a
+	}
+
+	classes := append([]string(nil), a.classes...)
+	for i := range atoms {
+		atoms[i].Pseudo, atoms[i].Media = pseudo, media
+
+		name := atoms[i].ClassName()
+		duplicate := false
+		for _, class := range classes {
+			duplicate = duplicate || class == name
+		}
+		if !duplicate {
+			classes = append(classes, name)
+		}
+	}
+
+	a.collector.addAtoms(atoms)
+	a.classes = classes
+	return a
+}
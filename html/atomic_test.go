@@ -0,0 +1,140 @@
+package html
+
+import (
+	"github.com/avearmin/cssgo"
+	"maragu.dev/gomponents"
+	ghtml "maragu.dev/gomponents/html"
+	"strings"
+	"testing"
+)
+
+func TestAtomic(t *testing.T) {
+	c := NewCollector()
+
+	button := func(label string) gomponents.Node {
+		return ghtml.Button(
+			c.Atomic(cssgo.Padding1(cssgo.PX(8)), cssgo.TextColor(cssgo.Blue)).
+				Hover(cssgo.TextColor(cssgo.Red)).
+				Media("(min-width: 600px)", cssgo.Padding1(cssgo.PX(16))),
+			gomponents.Text(label),
+		)
+	}
+	link := ghtml.A(c.Atomic(cssgo.TextColor(cssgo.Blue), cssgo.TextColor(cssgo.Blue)))
+
+	atom := func(pseudo, media string, prop cssgo.PropertyNode) cssgo.Atom {
+		atoms, _ := cssgo.Atomize(prop)
+		atoms[0].Pseudo, atoms[0].Media = pseudo, media
+		return atoms[0]
+	}
+	padding := atom("", "", cssgo.Padding1(cssgo.PX(8)))
+	blue := atom("", "", cssgo.TextColor(cssgo.Blue))
+	red := atom(":hover", "", cssgo.TextColor(cssgo.Red))
+	wide := atom("", "(min-width: 600px)", cssgo.Padding1(cssgo.PX(16)))
+
+	classes := padding.ClassName() + " " + blue.ClassName() + " " + red.ClassName() + " " + wide.ClassName()
+
+	var b strings.Builder
+	if err := ghtml.Div(button("a"), button("b"), link).Render(&b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `<div><button class="` + classes + `">a</button><button class="` + classes + `">b</button>` +
+		`<a class="` + blue.ClassName() + `"></a></div>`
+	if b.String() != want {
+		t.Fatalf("FAIL\ngot: %s != want: %s", b.String(), want)
+	}
+
+	rules := c.Rules()
+	if len(rules) != 4 {
+		t.Fatalf("atoms should be shared between elements, got %d rules", len(rules))
+	}
+
+	var css strings.Builder
+	for _, rule := range rules {
+		_ = rule.RenderCSS(&css)
+	}
+	var media strings.Builder
+	_ = wide.RenderCSS(&media)
+	if !strings.HasSuffix(css.String(), media.String()) {
+		t.Fatalf("media atoms should come last: %s", css.String())
+	}
+}
+
+func TestAtomicConflicts(t *testing.T) {
+	c := NewCollector()
+	red, _ := cssgo.Atomize(cssgo.TextColor(cssgo.Red))
+
+	tests := []struct {
+		name  string
+		atoms Atoms
+		want  string
+	}{
+		{"last color wins", c.Atomic(cssgo.TextColor(cssgo.Blue), cssgo.TextColor(cssgo.Red)), red[0].ClassName()},
+		{"longhand folded into shorthand", c.Atomic(cssgo.Padding1(cssgo.PX(8)), cssgo.PaddingLeft(cssgo.PX(4))), ""},
+		{"shorthand overrides longhand", c.Atomic(cssgo.PaddingLeft(cssgo.PX(4)), cssgo.Padding1(cssgo.PX(8))), ""},
+	}
+	folded, _ := cssgo.Atomize(cssgo.Padding4(cssgo.PX(8), cssgo.PX(8), cssgo.PX(8), cssgo.PX(4)))
+	padding, _ := cssgo.Atomize(cssgo.Padding1(cssgo.PX(8)))
+	tests[1].want = folded[0].ClassName()
+	tests[2].want = padding[0].ClassName()
+
+	for _, tc := range tests {
+		if got := strings.Join(tc.atoms.Classes(), " "); got != tc.want {
+			t.Fatalf("TESTCASE %s: FAIL\ngot: %s != want: %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestAtomicOrder(t *testing.T) {
+	c := NewCollector()
+	c.Atomic(cssgo.BorderTopColor(cssgo.Red)).Media("(min-width: 600px)", cssgo.TextColor(cssgo.Blue))
+	c.Atomic(cssgo.TextColor(cssgo.Green)).Hover(cssgo.TextColor(cssgo.Red))
+	c.Atomic(cssgo.Border3(cssgo.PX(1), cssgo.Solid, cssgo.Blue))
+	c.Add(cssgo.Class("card").Props(cssgo.Width(cssgo.PX(10))))
+
+	var props []string
+	for _, rule := range c.Rules() {
+		var b strings.Builder
+		_ = rule.RenderCSS(&b)
+		props = append(props, b.String()[strings.LastIndex(b.String(), "{"):])
+	}
+
+	want := []string{
+		"{width: 10px;}",
+		"{color: green;}",
+		"{border: 1px solid blue;}",
+		"{border-top-color: red;}",
+		"{color: red;}",
+		"{color: blue;}}",
+	}
+	if strings.Join(props, " ") != strings.Join(want, " ") {
+		t.Fatalf("FAIL\ngot: %s != want: %s", props, want)
+	}
+}
+
+func TestAtomicContextOrder(t *testing.T) {
+	c := NewCollector()
+	c.Atomic().Media("(min-width: 900px)", cssgo.Padding1(cssgo.PX(16)))
+	c.Atomic().Media("(max-width: 300px)", cssgo.Padding1(cssgo.PX(2)))
+	c.Atomic().Media("(width >= 600px)", cssgo.Padding1(cssgo.PX(8)))
+	c.Atomic().Media("print", cssgo.Padding1(cssgo.PX(0)))
+	c.Atomic().Media("(max-width: 40em)", cssgo.Padding1(cssgo.PX(4)))
+	c.Atomic().Pseudo(":active", cssgo.TextColor(cssgo.Red))
+	c.Atomic().Focus(cssgo.TextColor(cssgo.Green))
+	c.Atomic().Hover(cssgo.TextColor(cssgo.Blue))
+	c.Atomic().Pseudo(":visited", cssgo.TextColor(cssgo.Purple))
+	c.Atomic().Pseudo(":link", cssgo.TextColor(cssgo.Black))
+
+	var got []string
+	for _, rule := range c.Rules() {
+		atom := rule.(cssgo.Atom)
+		got = append(got, atom.Pseudo+atom.Media)
+	}
+
+	want := []string{
+		":link", ":visited", ":hover", ":focus", ":active",
+		"print", "(max-width: 40em)", "(max-width: 300px)", "(width >= 600px)", "(min-width: 900px)",
+	}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Fatalf("FAIL\ngot: %q != want: %q", got, want)
+	}
+}
@@ -0,0 +1,246 @@
+package html
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"github.com/avearmin/cssgo"
+	"io"
+	"maragu.dev/gomponents"
+	ghtml "maragu.dev/gomponents/html"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Collector gathers the rules used while building a page, so that they can be
+// flushed into a single <style> element in the <head>.
+// Rules are deduplicated by their rendered CSS and emitted in the order they were
+// first registered, so later rules override earlier ones as they would in a stylesheet.
+// A Collector is safe for concurrent use; use one per request.
+// The methods of a nil Collector do not panic: nodes created from it fail to render
+// with ErrNoCollector, so a missing WithCollector is reported rather than silently ignored.
+type Collector struct {
+	mu sync.Mutex
+	// rules holds the first rule registered for each CSS text, in registration order.
+	// They are rendered again by StyleElWith so that they use the validation mode of the element.
+	rules []cssgo.RuleNode
+	seen  map[string]bool
+	// atoms holds the atoms registered by Atomic, in registration order.
+	atoms []cssgo.Atom
+	errs  []error
+}
+
+// ErrNoCollector is returned when rendering nodes created from a nil Collector,
+// usually because the context passed to CollectorFrom carries none.
+var ErrNoCollector = errors.New("html: no collector, use WithCollector to install one")
+
+// NewCollector creates an empty Collector.
+func NewCollector() *Collector {
+	return &Collector{seen: map[string]bool{}}
+}
+
+// Add registers rules with the collector. Rules that fail to render are
+// skipped and their errors are returned when the collector is flushed.
+// Adding to a nil Collector does nothing.
+func (c *Collector) Add(rules ...cssgo.RuleNode) {
+	if c == nil {
+		return
+	}
+
+	rendered := make([]cssgo.RuleNode, 0, len(rules))
+	css := make([]string, 0, len(rules))
+	var errs []error
+	for _, rule := range rules {
+		var b strings.Builder
+		if err := rule.RenderCSS(&b); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		rendered = append(rendered, rule)
+		css = append(css, b.String())
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, rule := range rendered {
+		if !c.seen[css[i]] {
+			c.seen[css[i]] = true
+			c.rules = append(c.rules, rule)
+		}
+	}
+	c.errs = append(c.errs, errs...)
+}
+
+// addAtoms registers atoms with the collector, skipping the ones it already has.
+func (c *Collector) addAtoms(atoms []cssgo.Atom) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, atom := range atoms {
+		if name := atom.ClassName(); !c.seen[name] {
+			c.seen[name] = true
+			c.atoms = append(c.atoms, atom)
+		}
+	}
+}
+
+// addErrors records errors, which are returned when the collector is flushed.
+func (c *Collector) addErrors(errs ...error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.errs = append(c.errs, errs...)
+}
+
+// Styles scopes the properties to a generated class name, like Styles,
+// registers the rule with the collector and returns the class attribute.
+// Example: ghtml.Div(collector.Styles(cssgo.TextColor(cssgo.Red)))
+func (c *Collector) Styles(props ...cssgo.PropertyNode) gomponents.Node {
+	if c == nil {
+		return AttrNodeFunc(func(io.Writer) error { return ErrNoCollector })
+	}
+	name, rule := cssgo.ScopedClass(props...)
+	c.Add(rule)
+	return ghtml.Class(name)
+}
+
+// Rules returns the registered rules in the order they were first registered,
+// followed by the atoms registered by Atomic, in the order described by atomKey.
+func (c *Collector) Rules() []cssgo.RuleNode {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	rules := append([]cssgo.RuleNode(nil), c.rules...)
+	atoms := append([]cssgo.Atom(nil), c.atoms...)
+	c.mu.Unlock()
+
+	keys := make(map[cssgo.Atom]atomKey, len(atoms))
+	for _, atom := range atoms {
+		keys[atom] = newAtomKey(atom)
+	}
+	slices.SortStableFunc(atoms, func(a, b cssgo.Atom) int {
+		return keys[a].compare(keys[b])
+	})
+	for _, atom := range atoms {
+		rules = append(rules, atom)
+	}
+	return rules
+}
+
+// atomKey is the position of an atom in the stylesheet. Atoms are ordered so that they
+// override each other the way hand-written CSS would, whatever order they were registered in:
+//   - atoms without a context, then pseudo-classes, then media queries;
+//   - media queries without a width, then max-width queries from the widest,
+//     then min-width queries from the narrowest, so that mobile-first breakpoints cascade;
+//   - pseudo-classes in LVHFA order: :link, :visited, :hover, :focus, :active;
+//   - longhands after the shorthands that set them. The number of shorthands setting a property
+//     is its nesting depth, e.g. 0 for `border`, 1 for `border-top` and 3 for `border-top-width`.
+type atomKey struct {
+	layer  int
+	query  int // 0 without a width, 1 for max-width and 2 for min-width queries
+	width  float64
+	pseudo int
+	depth  int
+}
+
+func newAtomKey(atom cssgo.Atom) atomKey {
+	var k atomKey
+	switch {
+	case atom.Media != "":
+		k.layer = 2
+		k.query, k.width = breakpoint(atom.Media)
+	case atom.Pseudo != "":
+		k.layer = 1
+	}
+	k.pseudo = pseudoOrder[strings.ToLower(atom.Pseudo)]
+	if info, ok := cssgo.LookupProperty(atom.Declaration.Property); ok {
+		k.depth = len(info.Shorthands)
+	}
+	return k
+}
+
+func (k atomKey) compare(o atomKey) int {
+	return cmp.Or(
+		cmp.Compare(k.layer, o.layer),
+		cmp.Compare(k.query, o.query),
+		cmp.Compare(k.width, o.width),
+		cmp.Compare(k.pseudo, o.pseudo),
+		cmp.Compare(k.depth, o.depth),
+	)
+}
+
+// pseudoOrder is the LVHFA order of the user action pseudo-classes.
+// Other pseudo-classes and pseudo-elements come first.
+var pseudoOrder = map[string]int{
+	":link": 1, ":visited": 2, ":hover": 3, ":focus": 4, ":focus-within": 4, ":focus-visible": 4, ":active": 5,
+}
+
+// widthQuery matches the width condition of a media query, in either the
+// `(min-width: 600px)` or the range `(width >= 600px)` form.
+var widthQuery = regexp.MustCompile(`\(\s*(min-|max-)?width\s*(:|>=|>|<=|<)\s*([0-9.]+)(px|em|rem)\s*\)`)
+
+// breakpoint returns the kind of width condition of a media query and its width in pixels,
+// negated for max-width queries so that the widest comes first.
+func breakpoint(query string) (int, float64) {
+	m := widthQuery.FindStringSubmatch(strings.ToLower(query))
+	if m == nil {
+		return 0, 0
+	}
+	width, err := strconv.ParseFloat(m[3], 64)
+	if err != nil {
+		return 0, 0
+	}
+	if m[4] != "px" {
+		width *= 16
+	}
+
+	if m[1] == "max-" || m[1] == "" && strings.HasPrefix(m[2], "<") {
+		return 1, -width
+	}
+	if m[1] == "" && m[2] == ":" {
+		return 0, 0
+	}
+	return 2, width
+}
+
+// StyleEl creates a <style> element containing every registered rule.
+// The rules are read when the element is rendered rather than when it is created,
+// so it can be placed in the <head> of a page whose body registers rules while it is built.
+// Errors of rules that failed to render are joined and returned from Render,
+// and are cleared once reported so that a later render only returns new errors.
+func (c *Collector) StyleEl() ElNodeFunc {
+	return c.StyleElWith(StyleElOptions{})
+}
+
+// StyleElWith creates a <style> element like StyleEl, using the given options.
+func (c *Collector) StyleElWith(opts StyleElOptions) ElNodeFunc {
+	return ElNodeFunc(func(w io.Writer) error {
+		if c == nil {
+			return ErrNoCollector
+		}
+		err := StyleElWith(opts, c.Rules()...).Render(w)
+
+		c.mu.Lock()
+		errs := append([]error{err}, c.errs...)
+		c.errs = nil
+		c.mu.Unlock()
+
+		return errors.Join(errs...)
+	})
+}
+
+type collectorKey struct{}
+
+// WithCollector returns a copy of ctx that carries the collector.
+func WithCollector(ctx context.Context, c *Collector) context.Context {
+	return context.WithValue(ctx, collectorKey{}, c)
+}
+
+// CollectorFrom returns the collector carried by ctx, or nil if there is none.
+// Nodes created from a nil collector fail to render with ErrNoCollector.
+func CollectorFrom(ctx context.Context) *Collector {
+	c, _ := ctx.Value(collectorKey{}).(*Collector)
+	return c
+}
@@ -0,0 +1,130 @@
+package html
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/avearmin/cssgo"
+	"io"
+	"maragu.dev/gomponents"
+	ghtml "maragu.dev/gomponents/html"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestCollector(t *testing.T) {
+	c := NewCollector()
+	ctx := WithCollector(context.Background(), c)
+
+	card := func(ctx context.Context, title string) gomponents.Node {
+		styles := CollectorFrom(ctx)
+		return ghtml.Div(
+			styles.Styles(cssgo.Padding1(cssgo.PX(8))),
+			ghtml.H2(styles.Styles(cssgo.TextColor(cssgo.Blue)), gomponents.Text(title)),
+		)
+	}
+
+	page := ghtml.HTML(
+		ghtml.Head(c.StyleEl()),
+		ghtml.Body(card(ctx, "a"), card(ctx, "b")),
+	)
+	c.Add(cssgo.Media("(min-width: 600px)", cssgo.El("body").Props(cssgo.Margin1(cssgo.PX(0)))))
+
+	padding, _ := cssgo.ScopedClass(cssgo.Padding1(cssgo.PX(8)))
+	blue, _ := cssgo.ScopedClass(cssgo.TextColor(cssgo.Blue))
+
+	rules := []string{"." + padding + "{padding: 8px;}", "." + blue + "{color: blue;}"}
+
+	var b strings.Builder
+	if err := page.Render(&b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "<html><head><style>" + rules[0] + rules[1] +
+		"@media (min-width: 600px){body{margin: 0px;}}</style></head><body>" +
+		`<div class="` + padding + `"><h2 class="` + blue + `">a</h2></div>` +
+		`<div class="` + padding + `"><h2 class="` + blue + `">b</h2></div>` +
+		"</body></html>"
+	if b.String() != want {
+		t.Fatalf("FAIL\ngot: %s != want: %s", b.String(), want)
+	}
+}
+
+func TestCollectorOrder(t *testing.T) {
+	c := NewCollector()
+	c.Add(
+		cssgo.Class("button").Props(cssgo.TextColor(cssgo.Blue)),
+		cssgo.Class("button").Props(cssgo.TextColor(cssgo.Red)),
+	)
+	c.Add(cssgo.Class("button").Props(cssgo.TextColor(cssgo.Blue)))
+	c.Add(cssgo.Class("a").Props(cssgo.TextColor(cssgo.Green)))
+
+	want := "<style>.button{color: blue;}.button{color: red;}.a{color: green;}</style>"
+	if got := c.StyleEl().String(); got != want {
+		t.Fatalf("rules should keep the order they were first added in\ngot: %s\nwant: %s", got, want)
+	}
+}
+
+func TestCollectorConcurrent(t *testing.T) {
+	c := NewCollector()
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c.Styles(cssgo.Width(cssgo.PX(float64(i % 10))))
+			c.Add(cssgo.Class(fmt.Sprintf("item-%d", i%5)).Props(cssgo.Height(cssgo.PX(1))))
+		}(i)
+	}
+	wg.Wait()
+
+	if got := len(c.Rules()); got != 15 {
+		t.Fatalf("expected 15 deduplicated rules, got %d", got)
+	}
+}
+
+func TestCollectorErrors(t *testing.T) {
+	errRule := errors.New("rule failed")
+
+	c := NewCollector()
+	c.Add(
+		cssgo.RuleNodeFunc(func(w io.Writer) error { return errRule }),
+		cssgo.Class("ok").Props(cssgo.TextColor(cssgo.Red)),
+	)
+
+	var b strings.Builder
+	if err := c.StyleEl().Render(&b); !errors.Is(err, errRule) {
+		t.Fatalf("got error: %v, want: %v", err, errRule)
+	}
+	if want := "<style>.ok{color: red;}</style>"; b.String() != want {
+		t.Fatalf("FAIL\ngot: %s != want: %s", b.String(), want)
+	}
+
+	if err := c.StyleEl().Render(io.Discard); err != nil {
+		t.Fatalf("reported errors should be cleared, got: %v", err)
+	}
+}
+
+func TestCollectorMissing(t *testing.T) {
+	c := CollectorFrom(context.Background())
+	if c != nil {
+		t.Fatal("expected no collector in an empty context")
+	}
+
+	c.Add(cssgo.Class("ok").Props(cssgo.TextColor(cssgo.Red)))
+	if rules := c.Rules(); rules != nil {
+		t.Fatalf("expected no rules, got %d", len(rules))
+	}
+
+	nodes := []gomponents.Node{
+		c.Styles(cssgo.TextColor(cssgo.Red)),
+		c.Atomic(cssgo.TextColor(cssgo.Red)).Hover(cssgo.TextColor(cssgo.Blue)),
+		c.StyleEl(),
+	}
+	for _, node := range nodes {
+		if err := node.Render(io.Discard); !errors.Is(err, ErrNoCollector) {
+			t.Fatalf("got error: %v, want: %v", err, ErrNoCollector)
+		}
+	}
+}
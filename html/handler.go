@@ -0,0 +1,123 @@
+package html
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/avearmin/cssgo"
+	stdhtml "html"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StylesheetHandler is an http.Handler that serves a rendered Stylesheet as text/css.
+// The stylesheet is rendered and compressed once, when the handler is created.
+// Responses carry a strong ETag and honour If-None-Match. Clients accepting gzip get
+// the precompressed variant. When the request path contains the content hash,
+// e.g. "/css/app.1a2b3c4d.css", the response is marked as immutable; otherwise
+// clients are told to revalidate.
+type StylesheetHandler struct {
+	css, gzipped []byte
+	hash, etag   string
+}
+
+// NewStylesheetHandler renders the stylesheet and returns a handler serving it.
+// Example:
+//
+//	h, err := NewStylesheetHandler(sheet)
+//	path := "/css/" + h.FileName("app")
+//	mux.Handle(path, h)
+//	ghtml.Head(StylesheetLink(path))
+func NewStylesheetHandler(sheet cssgo.Stylesheet) (*StylesheetHandler, error) {
+	var css bytes.Buffer
+	if err := sheet.RenderCSS(&css); err != nil {
+		return nil, err
+	}
+
+	var gzipped bytes.Buffer
+	zw, _ := gzip.NewWriterLevel(&gzipped, gzip.BestCompression)
+	if _, err := zw.Write(css.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(css.Bytes())
+	return &StylesheetHandler{
+		css:     css.Bytes(),
+		gzipped: gzipped.Bytes(),
+		hash:    hex.EncodeToString(sum[:4]),
+		etag:    hex.EncodeToString(sum[:16]),
+	}, nil
+}
+
+// Hash returns the content hash of the stylesheet, as used in hashed file names.
+func (h *StylesheetHandler) Hash() string {
+	return h.hash
+}
+
+// FileName returns the content-hashed file name of the stylesheet, matching the
+// names written by cmd/cssgo with -hash.
+// Example: FileName("app") -> "app.1a2b3c4d.css"
+func (h *StylesheetHandler) FileName(name string) string {
+	return name + "." + h.hash + ".css"
+}
+
+func (h *StylesheetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	header := w.Header()
+	header.Set("Content-Type", "text/css; charset=utf-8")
+	header.Add("Vary", "Accept-Encoding")
+	if strings.Contains(r.URL.Path, h.hash) {
+		header.Set("Cache-Control", "public, max-age=31536000, immutable")
+	} else {
+		header.Set("Cache-Control", "no-cache")
+	}
+
+	body, etag := h.css, `"`+h.etag+`"`
+	if acceptsGzip(r.Header.Get("Accept-Encoding")) {
+		// Each encoding is a different representation and needs its own strong ETag.
+		body, etag = h.gzipped, `"`+h.etag+`-gzip"`
+		header.Set("Content-Encoding", "gzip")
+	}
+	header.Set("ETag", etag)
+
+	// ServeContent answers If-None-Match using the ETag header, and handles HEAD and ranges.
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(body))
+}
+
+// acceptsGzip reports whether an Accept-Encoding header allows gzip.
+func acceptsGzip(header string) bool {
+	for _, part := range strings.Split(header, ",") {
+		coding, params, _ := strings.Cut(part, ";")
+		if !strings.EqualFold(strings.TrimSpace(coding), "gzip") {
+			continue
+		}
+		name, value, found := strings.Cut(params, "=")
+		if !found || !strings.EqualFold(strings.TrimSpace(name), "q") {
+			return true
+		}
+		q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		return err != nil || q > 0
+	}
+	return false
+}
+
+// StylesheetLink creates a <link rel="stylesheet"> element for the given URL.
+// Example: StylesheetLink("/css/app.1a2b3c4d.css") -> `<link rel="stylesheet" href="/css/app.1a2b3c4d.css">`
+func StylesheetLink(href string) ElNodeFunc {
+	return ElNodeFunc(func(w io.Writer) error {
+		_, err := w.Write([]byte(`<link rel="stylesheet" href="` + stdhtml.EscapeString(href) + `">`))
+		return err
+	})
+}
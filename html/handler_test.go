@@ -0,0 +1,83 @@
+package html
+
+import (
+	"bytes"
+	"compress/gzip"
+	"github.com/avearmin/cssgo"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStylesheetHandler(t *testing.T) {
+	const css = ".foo{color: red;}"
+	h, err := NewStylesheetHandler(cssgo.Stylesheet{cssgo.Class("foo").Props(cssgo.TextColor(cssgo.Red))})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	get := func(path string, header ...string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		for i := 0; i < len(header); i += 2 {
+			r.Header.Set(header[i], header[i+1])
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	plain := get("/app.css")
+	if plain.Code != http.StatusOK || plain.Body.String() != css {
+		t.Fatalf("FAIL\ngot: %d %s != want: 200 %s", plain.Code, plain.Body.String(), css)
+	}
+	if got := plain.Header().Get("Content-Type"); got != "text/css; charset=utf-8" {
+		t.Fatalf("unexpected content type %q", got)
+	}
+	if got := plain.Header().Get("Cache-Control"); got != "no-cache" {
+		t.Fatalf("unhashed paths should be revalidated, got %q", got)
+	}
+
+	hashed := get("/css/" + h.FileName("app"))
+	if got := hashed.Header().Get("Cache-Control"); got != "public, max-age=31536000, immutable" {
+		t.Fatalf("hashed paths should be immutable, got %q", got)
+	}
+
+	etag := plain.Header().Get("ETag")
+	if etag == "" || etag[0] != '"' {
+		t.Fatalf("expected a strong ETag, got %q", etag)
+	}
+	if notModified := get("/app.css", "If-None-Match", etag); notModified.Code != http.StatusNotModified {
+		t.Fatalf("expected 304, got %d", notModified.Code)
+	}
+
+	gzipped := get("/app.css", "Accept-Encoding", "br, gzip")
+	if gzipped.Header().Get("Content-Encoding") != "gzip" || gzipped.Header().Get("ETag") == etag {
+		t.Fatalf("expected a gzip variant with its own ETag, got %v", gzipped.Header())
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(gzipped.Body.Bytes()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body, _ := io.ReadAll(zr); string(body) != css {
+		t.Fatalf("FAIL\ngot: %s != want: %s", body, css)
+	}
+
+	if refused := get("/app.css", "Accept-Encoding", "gzip;q=0"); refused.Header().Get("Content-Encoding") != "" {
+		t.Fatalf("gzip should not be used when refused")
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/app.css", nil))
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("expected 405, got %d", w.Code)
+	}
+}
+
+func TestStylesheetLink(t *testing.T) {
+	got := StylesheetLink(`/css/app.css?v="1"`).String()
+	want := `<link rel="stylesheet" href="/css/app.css?v=&#34;1&#34;">`
+	if got != want {
+		t.Fatalf("FAIL\ngot: %s != want: %s", got, want)
+	}
+}
@@ -1,9 +1,15 @@
 package html
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
 	"github.com/avearmin/cssgo"
+	stdhtml "html"
 	"io"
 	"maragu.dev/gomponents"
+	"regexp"
 	"strings"
 )
 
@@ -23,22 +29,73 @@ func (e ElNodeFunc) Type() gomponents.NodeType {
 	return gomponents.ElementType
 }
 
+// StyleEl creates a <style> element containing the given rules.
+// The CSS is escaped so that it cannot close the element early.
+// Rules that fail to render are left out of the element so that the HTML stays well-formed;
+// their errors are joined and returned from Render.
 func StyleEl(rules ...cssgo.RuleNode) ElNodeFunc {
+	return StyleElWith(StyleElOptions{}, rules...)
+}
+
+// StyleElOptions configures the attributes of a <style> element created with StyleElWith.
+type StyleElOptions struct {
+	// Nonce is emitted as the nonce attribute, allowing the element under a
+	// Content-Security-Policy with a matching `style-src 'nonce-...'` source.
+	Nonce string
+	// Validation is the validation mode used to render the rules.
+	Validation cssgo.ValidationMode
+}
+
+// StyleElWith creates a <style> element like StyleEl, using the given options.
+// Example: StyleElWith(StyleElOptions{Nonce: "r4nd0m"}, rules...) -> `<style nonce="r4nd0m">...</style>`
+func StyleElWith(opts StyleElOptions, rules ...cssgo.RuleNode) ElNodeFunc {
 	return ElNodeFunc(func(w io.Writer) error {
-		if _, err := w.Write([]byte("<style>")); err != nil {
-			return err
-		}
+		css, errs := renderStyleEl(rules, opts.Validation)
 
-		for _, rule := range rules {
-			rule.RenderCSS(w)
+		open := "<style>"
+		if opts.Nonce != "" {
+			open = "<style nonce=\"" + stdhtml.EscapeString(opts.Nonce) + "\">"
 		}
 
-		_, err := w.Write([]byte("</style>"))
+		if _, err := w.Write([]byte(open + css + "</style>")); err != nil {
+			return err
+		}
 
-		return err
+		return errors.Join(errs...)
 	})
 }
 
+// StyleHash computes the hash of the content of the <style> element that StyleEl
+// renders for the given rules, in the "sha256-<base64>" form used by the style-src
+// directive of a Content-Security-Policy header.
+// Example: "style-src '" + hash + "'"
+func StyleHash(rules ...cssgo.RuleNode) (string, error) {
+	return StyleHashWith(StyleElOptions{}, rules...)
+}
+
+// StyleHashWith computes the hash of the content of the <style> element that
+// StyleElWith renders for the given options and rules, like StyleHash.
+func StyleHashWith(opts StyleElOptions, rules ...cssgo.RuleNode) (string, error) {
+	css, errs := renderStyleEl(rules, opts.Validation)
+	if len(errs) > 0 {
+		return "", errors.Join(errs...)
+	}
+
+	sum := sha256.Sum256([]byte(css))
+	return "sha256-" + base64.StdEncoding.EncodeToString(sum[:]), nil
+}
+
+// renderStyleEl renders the escaped content of a <style> element.
+func renderStyleEl(rules []cssgo.RuleNode, mode cssgo.ValidationMode) (string, []error) {
+	nodes := make([]cssgo.Node, len(rules))
+	for i, rule := range rules {
+		nodes[i] = rule
+	}
+
+	css, errs := renderAll(nodes, mode)
+	return escapeStyleEl(css), errs
+}
+
 type AttrNodeFunc func(writer io.Writer) error
 
 func (a AttrNodeFunc) Render(w io.Writer) error {
@@ -55,18 +112,97 @@ func (a AttrNodeFunc) Type() gomponents.NodeType {
 	return gomponents.AttributeType
 }
 
+// Style creates a style attribute containing the given properties.
+// Conflicting declarations are resolved with cssgo.MergeProps, so composed
+// style groups produce a minimal attribute in which the last declaration wins.
+// The CSS is HTML-escaped so that values cannot break out of the attribute.
+// Properties that fail to render, and nested rules, which a style attribute cannot contain,
+// are left out of the attribute; their errors are joined and returned from Render.
 func Style(props ...cssgo.PropertyNode) AttrNodeFunc {
+	return StyleWith(StyleOptions{}, props...)
+}
+
+// StyleOptions configures a style attribute created with StyleWith.
+type StyleOptions struct {
+	// Validation is the validation mode used to render the properties.
+	Validation cssgo.ValidationMode
+}
+
+// StyleWith creates a style attribute like Style, using the given options.
+// Example: StyleWith(StyleOptions{Validation: cssgo.ValidationLenient}, cssgo.TextColor(cssgo.RGB(300, 0, 0)))
+// -> ` style="color: rgb(255, 0, 0);"`
+func StyleWith(opts StyleOptions, props ...cssgo.PropertyNode) AttrNodeFunc {
 	return AttrNodeFunc(func(w io.Writer) error {
-		if _, err := w.Write([]byte(" style=\"")); err != nil {
+		nodes := make([]cssgo.Node, len(props))
+		for i, prop := range props {
+			nodes[i] = declarationsOnly(prop, opts.Validation)
+		}
+
+		css, errs := renderAll(nodes, opts.Validation)
+
+		// CSS that cannot be parsed back is kept as rendered rather than dropped.
+		var merged strings.Builder
+		if err := cssgo.MergeProps(cssgo.Property(func(w io.Writer) error {
+			_, err := w.Write([]byte(css))
+			return err
+		})).RenderCSS(&merged); err == nil {
+			css = merged.String()
+		}
+
+		if _, err := w.Write([]byte(" style=\"" + stdhtml.EscapeString(css) + "\"")); err != nil {
 			return err
 		}
 
-		for _, prop := range props {
-			prop.RenderCSS(w)
+		return errors.Join(errs...)
+	})
+}
+
+// declarationsOnly renders prop with the validation mode, failing with cssgo.ErrNestedRule
+// if it contains a nested rule.
+func declarationsOnly(prop cssgo.PropertyNode, mode cssgo.ValidationMode) cssgo.Property {
+	return cssgo.Property(func(w io.Writer) error {
+		var b strings.Builder
+		if err := cssgo.RenderWith(&b, cssgo.RenderOptions{Validation: mode}, prop); err != nil {
+			return err
 		}
 
-		_, err := w.Write([]byte("\""))
+		css := b.String()
+		if _, err := cssgo.Declarations(cssgo.Property(func(w io.Writer) error {
+			_, err := w.Write([]byte(css))
+			return err
+		})); errors.Is(err, cssgo.ErrNestedRule) {
+			return err
+		}
 
+		_, err := w.Write([]byte(css))
 		return err
 	})
 }
+
+// renderAll renders every node into a buffer with the validation mode, so that a node that fails halfway
+// does not leave partial CSS behind. The errors of failing nodes are collected.
+func renderAll(nodes []cssgo.Node, mode cssgo.ValidationMode) (string, []error) {
+	var css strings.Builder
+	var buf bytes.Buffer
+	var errs []error
+
+	for _, node := range nodes {
+		buf.Reset()
+		if err := cssgo.RenderWith(&buf, cssgo.RenderOptions{Validation: mode}, node); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		css.Write(buf.Bytes())
+	}
+
+	return css.String(), errs
+}
+
+// styleEndTag matches anything that would end a <style> element, in any letter case.
+var styleEndTag = regexp.MustCompile(`(?i)</(style)`)
+
+// escapeStyleEl escapes "</style" as "<\/style". The backslash is a valid CSS escape
+// for "/", so the CSS keeps its meaning while the HTML parser no longer sees an end tag.
+func escapeStyleEl(css string) string {
+	return styleEndTag.ReplaceAllString(css, `<\/$1`)
+}
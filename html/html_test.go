@@ -1,7 +1,11 @@
 package html
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
 	"github.com/avearmin/cssgo"
+	"io"
 	"maragu.dev/gomponents"
 	ghtml "maragu.dev/gomponents/html"
 	"strings"
@@ -37,6 +41,17 @@ func TestStyles(t *testing.T) {
 			),
 			want: "<div style=\"color: red;background-color: #ffffff;\">hello world</div>",
 		},
+		{
+			name: "conflicting props in style attr",
+			input: ghtml.Div(
+				Style(
+					cssgo.GroupProps(cssgo.Padding1(cssgo.PX(16)), cssgo.Width(cssgo.PX(1))),
+					cssgo.GroupProps(cssgo.PaddingLeft(cssgo.PX(8)), cssgo.PaddingRight(cssgo.PX(8))),
+					cssgo.Width(cssgo.PX(2)),
+				),
+			),
+			want: "<div style=\"padding: 16px 8px;width: 2px;\"></div>",
+		},
 	}
 
 	for _, test := range tests {
@@ -50,3 +65,294 @@ func TestStyles(t *testing.T) {
 		}(t)
 	}
 }
+
+// failingWriter fails once more than limit bytes have been written.
+type failingWriter struct {
+	limit   int
+	written int
+}
+
+var errWrite = errors.New("write failed")
+
+func (f *failingWriter) Write(p []byte) (int, error) {
+	if f.written+len(p) > f.limit {
+		return 0, errWrite
+	}
+	f.written += len(p)
+	return len(p), nil
+}
+
+func TestRenderErrors(t *testing.T) {
+	errRule := errors.New("rule failed")
+	errProp := errors.New("property failed")
+
+	failingRule := cssgo.RuleNodeFunc(func(w io.Writer) error {
+		_, _ = w.Write([]byte(".broken{"))
+		return errRule
+	})
+	failingProp := cssgo.Property(func(w io.Writer) error {
+		_, _ = w.Write([]byte("color:"))
+		return errProp
+	})
+
+	tests := []struct {
+		name    string
+		input   gomponents.Node
+		writer  io.Writer
+		want    string
+		wantErr []error
+	}{
+		{
+			name:    "failing writer in style element",
+			input:   StyleEl(cssgo.Class("foo").Props(cssgo.TextColor(cssgo.Blue))),
+			writer:  &failingWriter{limit: 10},
+			wantErr: []error{errWrite},
+		},
+		{
+			name:    "failing writer in style attr",
+			input:   Style(cssgo.TextColor(cssgo.Blue)),
+			writer:  &failingWriter{limit: 10},
+			wantErr: []error{errWrite},
+		},
+		{
+			name: "invalid rules are skipped and joined",
+			input: StyleEl(
+				failingRule,
+				cssgo.Class("foo").Props(cssgo.TextColor(cssgo.Blue)),
+				failingRule,
+			),
+			want:    "<style>.foo{color: blue;}</style>",
+			wantErr: []error{errRule},
+		},
+		{
+			name:    "invalid props are skipped and joined",
+			input:   Style(failingProp, cssgo.TextColor(cssgo.Red)),
+			want:    " style=\"color: red;\"",
+			wantErr: []error{errProp},
+		},
+	}
+
+	for _, test := range tests {
+		func(t2 *testing.T) {
+			var b strings.Builder
+			w := test.writer
+			if w == nil {
+				w = &b
+			}
+
+			err := test.input.Render(w)
+			for _, want := range test.wantErr {
+				if !errors.Is(err, want) {
+					t2.Fatalf("TESTCASE %s: FAIL\ngot error: %v, want: %v", test.name, err, want)
+				}
+			}
+			if test.writer == nil && b.String() != test.want {
+				t2.Fatalf("TESTCASE %s: FAIL\ngot: %s != want: %s", test.name, b.String(), test.want)
+			}
+		}(t)
+	}
+}
+
+func TestRenderInvalidValues(t *testing.T) {
+	var b strings.Builder
+	err := ghtml.Div(
+		StyleWith(StyleOptions{Validation: cssgo.ValidationStrict}, cssgo.TextColor(cssgo.RGB(300, 0, 0)), cssgo.BackgroundColor(cssgo.Hex(0x1000000)), cssgo.Width(cssgo.PX(10))),
+	).Render(&b)
+
+	if !errors.Is(err, cssgo.ErrInvalidColor) {
+		t.Fatalf("got error: %v, want ErrInvalidColor", err)
+	}
+	if got := strings.Count(err.Error(), "invalid color"); got != 2 {
+		t.Fatalf("expected 2 joined errors, got %d: %v", got, err)
+	}
+}
+
+func TestRenderNestedRules(t *testing.T) {
+	var b strings.Builder
+	err := ghtml.Div(
+		Style(cssgo.TextColor(cssgo.Red), cssgo.Nest(cssgo.Hover(), cssgo.TextColor(cssgo.Blue)), cssgo.GroupProps(cssgo.NestMedia("print", cssgo.Width(cssgo.PX(1))))),
+	).Render(&b)
+
+	if !errors.Is(err, cssgo.ErrNestedRule) {
+		t.Fatalf("got error: %v, want ErrNestedRule", err)
+	}
+	if want := `<div style="color: red;"`; b.String() != want {
+		t.Fatalf("got: %s != want: %s", b.String(), want)
+	}
+
+	c := NewCollector()
+	if err := ghtml.Div(c.Atomic(cssgo.Nest(cssgo.Hover(), cssgo.TextColor(cssgo.Blue))), c.StyleEl()).Render(io.Discard); !errors.Is(err, cssgo.ErrNestedRule) {
+		t.Fatalf("got error: %v, want ErrNestedRule", err)
+	}
+}
+
+func TestRenderLenientValues(t *testing.T) {
+	lenient := cssgo.ValidationLenient
+	collector := NewCollector()
+	collector.Add(cssgo.Class("b").Props(cssgo.TextColor(cssgo.RGB(0, 300, 0))))
+
+	var b strings.Builder
+	err := ghtml.Div(
+		StyleWith(StyleOptions{Validation: lenient}, cssgo.TextColor(cssgo.RGB(300, 0, 0))),
+		StyleElWith(StyleElOptions{Validation: lenient}, cssgo.Class("a").Props(cssgo.TextColor(cssgo.Hex(0x1000000)))),
+		collector.StyleElWith(StyleElOptions{Validation: lenient}),
+	).Render(&b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `<div style="color: rgb(255, 0, 0);"><style>.a{color: #ffffff;}</style><style>.b{color: rgb(0, 255, 0);}</style></div>`
+	if b.String() != want {
+		t.Fatalf("got: %s != want: %s", b.String(), want)
+	}
+}
+
+func TestEscaping(t *testing.T) {
+	tests := []struct {
+		name  string
+		input gomponents.Node
+		want  string
+	}{
+		{
+			name: "url breaking out of style attr",
+			input: ghtml.Div(
+				Style(cssgo.BackgroundImage(cssgo.Url(`a.png" onload="alert(1)`))),
+			),
+			want: `<div style="background-image: url(&#39;a.png&#34; onload=&#34;alert(1)&#39;);"></div>`,
+		},
+		{
+			name: "font name breaking out of style attr",
+			input: ghtml.Div(
+				Style(cssgo.FontFamily(cssgo.FontName(`Arial"><script>alert(1)</script>`))),
+			),
+			want: `<div style="font-family: &#34;Arial\&#34;&gt;&lt;script&gt;alert(1)&lt;/script&gt;&#34;;"></div>`,
+		},
+		{
+			name: "custom property breaking out of style attr",
+			input: ghtml.Div(
+				Style(cssgo.CustomProp("brand", cssgo.Color(`red" data-x="&`))),
+			),
+			want: `<div style="--brand: red&#34; data-x=&#34;&amp;;"></div>`,
+		},
+		{
+			name: "url closing the style element",
+			input: StyleEl(
+				cssgo.Class("foo").Props(cssgo.BackgroundImage(cssgo.Url(`x');}</style><script>alert(1)</script>`))),
+			),
+			want: `<style>.foo{background-image: url('x\');}<\/style><script>alert(1)</script>');}</style>`,
+		},
+		{
+			name: "font name closing the style element in upper case",
+			input: StyleEl(
+				cssgo.El("body").Props(cssgo.FontFamily(cssgo.FontName(`</STYLE><img src=x onerror=alert(1)>`))),
+			),
+			want: `<style>body{font-family: "<\/STYLE><img src=x onerror=alert(1)>";}</style>`,
+		},
+		{
+			name: "custom property closing the style element",
+			input: StyleEl(
+				cssgo.Root().Props(cssgo.CustomProp("x", cssgo.Color(`</style >`))),
+			),
+			want: `<style>:root{--x: <\/style >;}</style>`,
+		},
+		{
+			name: "quotes are kept in style element",
+			input: StyleEl(
+				cssgo.El("body").Props(cssgo.FontFamily(cssgo.FontName("Helvetica Neue"), cssgo.SansSerif)),
+			),
+			want: `<style>body{font-family: "Helvetica Neue", sans-serif;}</style>`,
+		},
+	}
+
+	for _, test := range tests {
+		func(t2 *testing.T) {
+			w := strings.Builder{}
+			test.input.Render(&w)
+			got := w.String()
+			if got != test.want {
+				t2.Fatalf("TESTCASE %s: FAIL\ngot: %s != want: %s", test.name, got, test.want)
+			}
+		}(t)
+	}
+}
+
+func TestStyleElNonce(t *testing.T) {
+	tests := []struct {
+		name  string
+		input gomponents.Node
+		want  string
+	}{
+		{
+			name:  "nonce attribute",
+			input: StyleElWith(StyleElOptions{Nonce: "r4nd0m"}, cssgo.Class("foo").Props(cssgo.TextColor(cssgo.Blue))),
+			want:  `<style nonce="r4nd0m">.foo{color: blue;}</style>`,
+		},
+		{
+			name:  "nonce is escaped",
+			input: StyleElWith(StyleElOptions{Nonce: `a"><script>`}, cssgo.Class("foo").Props(cssgo.TextColor(cssgo.Blue))),
+			want:  `<style nonce="a&#34;&gt;&lt;script&gt;">.foo{color: blue;}</style>`,
+		},
+		{
+			name:  "empty options",
+			input: StyleElWith(StyleElOptions{}, cssgo.Class("foo").Props(cssgo.TextColor(cssgo.Blue))),
+			want:  `<style>.foo{color: blue;}</style>`,
+		},
+	}
+
+	for _, test := range tests {
+		func(t2 *testing.T) {
+			w := strings.Builder{}
+			test.input.Render(&w)
+			got := w.String()
+			if got != test.want {
+				t2.Fatalf("TESTCASE %s: FAIL\ngot: %s != want: %s", test.name, got, test.want)
+			}
+		}(t)
+	}
+}
+
+func TestStyleHash(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []cssgo.RuleNode
+		want  string
+	}{
+		{
+			name:  "simple rule",
+			input: []cssgo.RuleNode{cssgo.Class("foo").Props(cssgo.TextColor(cssgo.Blue))},
+			want:  "sha256-CoW+45B2OTV3DbkIaQ0VIK5vhDpr0lcfIK0Xe0UCqNc=",
+		},
+		{
+			name:  "hash covers the escaped content",
+			input: []cssgo.RuleNode{cssgo.Class("foo").Props(cssgo.BackgroundImage(cssgo.Url("x")))},
+			want:  "sha256-" + sha256Base64(".foo{background-image: url('x');}"),
+		},
+		{
+			name:  "escaped end tag",
+			input: []cssgo.RuleNode{cssgo.Class("foo").Props(cssgo.BackgroundImage(cssgo.Url("x"))), cssgo.RuleNodeFunc(func(w io.Writer) error { _, err := w.Write([]byte("</style>")); return err })},
+			want:  "sha256-0NEVPZuFFSGFqTROhfcFRcEcUFOVauB48chcuBZt5Pw=",
+		},
+	}
+
+	for _, test := range tests {
+		func(t2 *testing.T) {
+			got, err := StyleHash(test.input...)
+			if err != nil {
+				t2.Fatalf("TESTCASE %s: unexpected error: %v", test.name, err)
+			}
+			if got != test.want {
+				t2.Fatalf("TESTCASE %s: FAIL\ngot: %s != want: %s", test.name, got, test.want)
+			}
+		}(t)
+	}
+
+	failing := cssgo.RuleNodeFunc(func(w io.Writer) error { return errWrite })
+	if _, err := StyleHash(failing); !errors.Is(err, errWrite) {
+		t.Fatalf("got error: %v, want: %v", err, errWrite)
+	}
+}
+
+func sha256Base64(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
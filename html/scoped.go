@@ -0,0 +1,21 @@
+package html
+
+import (
+	"github.com/avearmin/cssgo"
+	"maragu.dev/gomponents"
+	ghtml "maragu.dev/gomponents/html"
+)
+
+// Styles scopes the properties to a class name derived from their content.
+// It returns the class attribute to put on the element and the rule to render in a
+// <style> element, which unlike the inline Style attribute can be extended with
+// hover states and media queries.
+// Example:
+//
+//	class, rule := Styles(cssgo.TextColor(cssgo.Red))
+//	ghtml.Div(class)  -> <div class="css-1a2b3c4d"></div>
+//	StyleEl(rule)     -> <style>.css-1a2b3c4d{color: red;}</style>
+func Styles(props ...cssgo.PropertyNode) (gomponents.Node, cssgo.RuleNode) {
+	name, rule := cssgo.ScopedClass(props...)
+	return ghtml.Class(name), rule
+}
@@ -0,0 +1,42 @@
+package html
+
+import (
+	"github.com/avearmin/cssgo"
+	"maragu.dev/gomponents"
+	ghtml "maragu.dev/gomponents/html"
+	"strings"
+	"testing"
+)
+
+func TestStylesHelper(t *testing.T) {
+	class, rule := Styles(cssgo.TextColor(cssgo.Red), cssgo.Padding1(cssgo.PX(8)))
+	name, _ := cssgo.ScopedClass(cssgo.TextColor(cssgo.Red), cssgo.Padding1(cssgo.PX(8)))
+
+	tests := []struct {
+		name  string
+		input gomponents.Node
+		want  string
+	}{
+		{
+			name:  "class attribute",
+			input: ghtml.Div(class, gomponents.Text("hello")),
+			want:  `<div class="` + name + `">hello</div>`,
+		},
+		{
+			name:  "scoped rule in style element",
+			input: StyleEl(rule),
+			want:  `<style>.` + name + `{color: red;padding: 8px;}</style>`,
+		},
+	}
+
+	for _, test := range tests {
+		func(t2 *testing.T) {
+			w := strings.Builder{}
+			test.input.Render(&w)
+			got := w.String()
+			if got != test.want {
+				t2.Fatalf("TESTCASE %s: FAIL\ngot: %s != want: %s", test.name, got, test.want)
+			}
+		}(t)
+	}
+}
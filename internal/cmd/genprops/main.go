@@ -0,0 +1,547 @@
+// Command genprops generates typed constructors for the standard CSS properties from
+// the vendored property dataset in data/properties.json, which follows the layout of
+// the mdn-data package: every property has a value definition syntax, an initial value,
+// whether it is inherited and how it animates, and named syntaxes such as <line-style>
+// are defined once and referenced from the properties. See data/README.md for its source.
+//
+// For every property that is not written by hand in property.go, it emits:
+//   - a marker interface, e.g. OverflowXValue, implemented by the Go types that render
+//     the values of the property, including GlobalType and VarType;
+//   - a keyword type with a constant for every keyword that is a value on its own, e.g.
+//     OverflowXHidden, but not AlignContentSafe, as safe must be followed by a position;
+//   - a constructor, e.g. OverflowX(OverflowXHidden) -> "overflow-x: hidden;";
+//   - for comma separated lists of layers made of several values, a Layers constructor,
+//     e.g. TransitionLayers([]TransitionValue{Ident("opacity"), MS(200)}, ...) -> "transition: opacity 200ms, ...;".
+//
+// The tests of the generated code are generated alongside it.
+//
+// Usage, from the root of the module:
+//
+//	go generate
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	codeFile = "properties_gen.go"
+	testFile = "properties_gen_test.go"
+	header   = "// Code generated by genprops from data/properties.json; DO NOT EDIT.\n\n"
+)
+
+// handwritten are the properties whose constructors are written by hand in property.go.
+var handwritten = map[string]bool{
+	"accent-color": true, "background-color": true, "background-image": true, "border": true,
+	"border-bottom-left-radius": true, "border-bottom-right-radius": true, "border-bottom-width": true,
+	"border-color": true, "border-left-width": true, "border-radius": true, "border-right-width": true,
+	"border-style": true, "border-top-left-radius": true, "border-top-right-radius": true,
+	"border-top-width": true, "border-width": true, "color": true, "color-scheme": true, "content": true,
+	"display": true, "flex-direction": true, "font-family": true, "font-size": true, "height": true,
+	"letter-spacing": true, "line-height": true, "margin": true, "padding": true, "padding-bottom": true,
+	"padding-left": true, "padding-right": true, "padding-top": true, "width": true, "z-index": true,
+}
+
+// dataTypes maps the basic data types of the value definition syntax to the Go types rendering them.
+// Data types without a Go type, such as <transform-function>, can only be set with Var or Prop.
+var dataTypes = map[string][]string{
+	"length": {"Size"}, "length-percentage": {"Size"}, "percentage": {"Size"}, "flex": {"Size"},
+	"number": {"Number"}, "integer": {"Number"},
+	"color": {"Color", "RGBColor", "ColorFunction"},
+	"url":   {"UrlType"}, "image": {"UrlType"},
+	"string":       {"StringType"},
+	"time":         {"Time"},
+	"angle":        {"Angle"},
+	"custom-ident": {"IdentType"}, "dashed-ident": {"IdentType"},
+	"ratio": nil, "transform-function": nil, "filter-function": nil,
+}
+
+// sharedTypes lists the Go types that implement the marker interfaces of several properties,
+// in the order their marker methods are generated.
+var sharedTypes = []string{
+	"GlobalType", "VarType", "AutoType", "Size", "Number", "Color", "RGBColor",
+	"ColorFunction", "UrlType", "StringType", "Time", "Angle", "IdentType",
+}
+
+// examples are the values used in examples and tests for each data type, with their rendering.
+var examples = map[string][2]string{
+	"auto": {"Auto", "auto"}, "length": {"PX(1)", "1px"}, "length-percentage": {"PX(1)", "1px"},
+	"percentage": {"PCT(50)", "50%"}, "flex": {"FR(1)", "1fr"}, "number": {"Num(0.5)", "0.5"},
+	"integer": {"Int(1)", "1"}, "color": {"Red", "red"}, "url": {`Url("a.png")`, "url('a.png')"},
+	"image": {`Url("a.png")`, "url('a.png')"}, "string": {`Str("a")`, `"a"`}, "time": {"MS(200)", "200ms"},
+	"angle": {"Deg(45)", "45deg"}, "custom-ident": {`Ident("a")`, "a"}, "dashed-ident": {`Ident("--a")`, "--a"},
+	"global": {"Inherit", "inherit"},
+}
+
+// propertyExamples override the examples of properties whose first keyword or data type
+// is not a valid value on its own, such as the fill of border-image-slice.
+// They are used as is, without repeating them for comma separated properties,
+// except for layers, so the examples of layered properties must be valid list items.
+// Every layered property has one, made of several values of a layer.
+var propertyExamples = map[string][2]string{
+	"anchor-name":             {`Ident("--a")`, "--a"},
+	"anchor-scope":            {`Ident("--a")`, "--a"},
+	"animation":               {`Ident("fade"), S(1), AnimationIterationCountInfinite`, "fade 1s infinite"},
+	"animation-range":         {"AnimationRangeStartEntry, PCT(10)", "entry 10%"},
+	"animation-range-end":     {"AnimationRangeEndExit, PCT(90)", "exit 90%"},
+	"animation-range-start":   {"AnimationRangeStartEntry, PCT(10)", "entry 10%"},
+	"background-position":     {"BackgroundPositionRight, PX(8)", "right 8px"},
+	"background-position-x":   {"BackgroundPositionXRight, PX(8)", "right 8px"},
+	"background-position-y":   {"BackgroundPositionYBottom, PX(8)", "bottom 8px"},
+	"background-repeat":       {"BackgroundRepeatRepeat, BackgroundRepeatNoRepeat", "repeat no-repeat"},
+	"background-size":         {"PCT(50), Auto", "50% auto"},
+	"border-image-slice":      {"Num(1)", "1"},
+	"box-shadow":              {"PX(1), PX(2), Red", "1px 2px red"},
+	"contain-intrinsic-size":  {"Auto, PX(100)", "auto 100px"},
+	"font":                    {"FontCaption", "caption"},
+	"font-feature-settings":   {`Str("liga"), Int(1)`, `"liga" 1`},
+	"font-variation-settings": {`Str("wght"), Num(400)`, `"wght" 400`},
+	"grid":                    {"GridTemplateRowsNone", "none"},
+	"grid-template":           {"GridTemplateRowsNone", "none"},
+	"mask":                    {`Url("a.png"), MaskRepeatNoRepeat`, "url('a.png') no-repeat"},
+	"mask-position":           {"MaskPositionRight, PX(8)", "right 8px"},
+	"mask-repeat":             {"MaskRepeatRepeat, MaskRepeatNoRepeat", "repeat no-repeat"},
+	"mask-size":               {"PCT(50), Auto", "50% auto"},
+	"position-try-fallbacks":  {`Ident("--a"), PositionTryFallbacksFlipBlock`, "--a flip-block"},
+	"scroll-timeline":         {`Ident("--a"), ScrollTimelineAxisInline`, "--a inline"},
+	"text-indent":             {"PX(1)", "1px"},
+	"text-shadow":             {"PX(1), PX(2), Red", "1px 2px red"},
+	"timeline-scope":          {`Ident("--a")`, "--a"},
+	"transition":              {`Ident("opacity"), MS(200)`, "opacity 200ms"},
+	"view-timeline":           {`Ident("--a"), ViewTimelineAxisInline`, "--a inline"},
+	"view-timeline-inset":     {"PX(10), Auto", "10px auto"},
+}
+
+var globalKeywords = map[string]bool{"inherit": true, "initial": true, "unset": true, "revert": true, "revert-layer": true}
+
+func main() {
+	dir := flag.String("dir", ".", "the directory of the cssgo package")
+	flag.Parse()
+
+	if err := run(*dir); err != nil {
+		fmt.Fprintln(os.Stderr, "genprops:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir string) error {
+	code, tests, err := generate(dir)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, codeFile), code, 0o644); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, testFile), tests, 0o644)
+}
+
+// dataset is the layout of data/properties.json.
+type dataset struct {
+	Properties map[string]struct {
+		Syntax string `json:"syntax"`
+		// Initial is the initial value, or the longhands of a shorthand.
+		Initial any `json:"initial"`
+	} `json:"properties"`
+	Syntaxes map[string]struct {
+		Syntax string `json:"syntax"`
+	} `json:"syntaxes"`
+}
+
+// property is what is generated for one CSS property.
+type property struct {
+	name      string
+	syntax    string
+	funcName  string
+	typeName  string // the prefix of the marker interface, keyword type and constants
+	keywords  []string
+	consts    []string // the names of the keyword constants
+	dataTypes []string // the data types of the syntax, and auto, in order of appearance
+	types     []string // the Go types implementing the marker interface, besides the keyword type
+	// longhands are the generated longhands of a shorthand, whose keyword types also implement its marker interface.
+	longhands []string
+	single    bool // the property takes a single value
+	comma     bool // multiple values are separated by commas
+	layered   bool // the property is a comma separated list of layers, each made of several values
+}
+
+func generate(dir string) ([]byte, []byte, error) {
+	raw, err := os.ReadFile(filepath.Join(dir, "data", "properties.json"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data dataset
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, nil, fmt.Errorf("data/properties.json: %w", err)
+	}
+
+	declared, err := declaredNames(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var props []property
+	generated := map[string]string{}
+	declare := func(ident, property string) error {
+		if declared[ident] {
+			return fmt.Errorf("property %s: %s is already declared in the package", property, ident)
+		}
+		if other, ok := generated[ident]; ok {
+			return fmt.Errorf("property %s: %s is already generated for property %s", property, ident, other)
+		}
+		generated[ident] = property
+		return nil
+	}
+
+	a := analyzer{data: data}
+	byName := map[string]*property{}
+	for _, name := range sortedKeys(data.Properties) {
+		if handwritten[name] {
+			continue
+		}
+
+		p := property{name: name, syntax: data.Properties[name].Syntax, typeName: camel(name)}
+		p.funcName = p.typeName
+		if declared[p.funcName] {
+			p.funcName += "Prop"
+		}
+
+		keywords, types, err := a.collect(p.syntax, map[string]bool{})
+		if err != nil {
+			return nil, nil, fmt.Errorf("property %s: %w", name, err)
+		}
+		standalone, err := a.standalone(p.syntax)
+		if err != nil {
+			return nil, nil, fmt.Errorf("property %s: %w", name, err)
+		}
+		for _, k := range keywords {
+			if standalone[k] && !globalKeywords[k] {
+				p.keywords = append(p.keywords, k)
+			}
+		}
+		p.dataTypes, p.types = types, goTypes(types)
+
+		if p.single, err = a.single(p.syntax, 0); err != nil {
+			return nil, nil, fmt.Errorf("property %s: %w", name, err)
+		}
+		if !p.single {
+			if p.comma, p.layered, err = a.commaList(p.syntax); err != nil {
+				return nil, nil, fmt.Errorf("property %s: %w", name, err)
+			}
+		}
+		if _, ok := propertyExamples[name]; p.layered && !ok {
+			return nil, nil, fmt.Errorf("property %s: layered properties need an example in propertyExamples", name)
+		}
+
+		props = append(props, p)
+	}
+	for i := range props {
+		byName[props[i].name] = &props[i]
+	}
+
+	// Shorthands accept the keywords of their longhands through the longhand keyword types,
+	// and only get constants for the keywords that none of their longhands have.
+	for i := range props {
+		p := &props[i]
+		covered := map[string]bool{}
+		for _, l := range longhands(data, p.name) {
+			if lp, ok := byName[l]; ok && len(lp.keywords) > 0 {
+				p.longhands = append(p.longhands, l)
+				for _, k := range lp.keywords {
+					covered[k] = true
+				}
+			}
+		}
+
+		keywords := p.keywords[:0]
+		for _, k := range p.keywords {
+			if !covered[k] {
+				keywords = append(keywords, k)
+			}
+		}
+		p.keywords = keywords
+	}
+
+	for i := range props {
+		p := &props[i]
+		idents := []string{p.funcName, p.typeName + "Value"}
+		if p.layered {
+			idents = append(idents, p.typeName+"Layers")
+		}
+		if len(p.keywords) > 0 {
+			idents = append(idents, p.typeName+"Keyword")
+		}
+		for _, ident := range idents {
+			if err := declare(ident, p.name); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	// Keyword constants are declared last, as they can clash with the constructor of another
+	// property: the auto-flow keyword of grid would be GridAutoFlow, so it becomes GridKeywordAutoFlow.
+	for i := range props {
+		p := &props[i]
+		for _, k := range p.keywords {
+			ident := p.typeName + camel(k)
+			if declared[ident] || generated[ident] != "" {
+				ident = p.typeName + "Keyword" + camel(k)
+			}
+			if err := declare(ident, p.name); err != nil {
+				return nil, nil, err
+			}
+			p.consts = append(p.consts, ident)
+		}
+	}
+
+	code, err := format.Source(writeCode(props))
+	if err != nil {
+		return nil, nil, fmt.Errorf("formatting %s: %w", codeFile, err)
+	}
+	tests, err := format.Source(writeTests(props))
+	if err != nil {
+		return nil, nil, fmt.Errorf("formatting %s: %w", testFile, err)
+	}
+	return code, tests, nil
+}
+
+// longhands returns every longhand of a shorthand, including the longhands of nested shorthands
+// such as border-block-start, or nothing if the property is not a shorthand.
+func longhands(data dataset, name string) []string {
+	initial, ok := data.Properties[name].Initial.([]any)
+	if !ok {
+		return nil
+	}
+
+	var out []string
+	for _, l := range initial {
+		l, _ := l.(string)
+		out = append(out, l)
+		out = append(out, longhands(data, l)...)
+	}
+	return out
+}
+
+// declaredNames returns the top-level identifiers declared by the hand-written files of the package.
+func declaredNames(dir string) (map[string]bool, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]bool{}
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") || strings.HasSuffix(file, "_gen.go") {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil {
+					names[decl.Name.Name] = true
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						names[spec.Name.Name] = true
+					case *ast.ValueSpec:
+						for _, n := range spec.Names {
+							names[n.Name] = true
+						}
+					}
+				}
+			}
+		}
+	}
+	return names, nil
+}
+
+func writeCode(props []property) []byte {
+	var b bytes.Buffer
+	b.WriteString(header + "package cssgo\n\nimport \"io\"\n")
+
+	// The keyword types of longhands also implement the marker interfaces of their shorthands.
+	shorthandMarkers := map[string][]string{}
+	for _, p := range props {
+		for _, l := range p.longhands {
+			shorthandMarkers[l] = append(shorthandMarkers[l], lowerFirst(p.typeName)+"Value")
+		}
+	}
+
+	markers := map[string][]string{}
+	for _, p := range props {
+		marker := lowerFirst(p.typeName) + "Value"
+		fmt.Fprintf(&b, "\n// %sValue is implemented by the values of the %q property.\n", p.typeName, p.name)
+		fmt.Fprintf(&b, "type %sValue interface {\n\tValueNode\n\t%s()\n}\n", p.typeName, marker)
+
+		if len(p.keywords) > 0 {
+			keyword := p.typeName + "Keyword"
+			fmt.Fprintf(&b, "\n// %s represents a keyword of the %q property.\ntype %s string\n\n", keyword, p.name, keyword)
+			fmt.Fprintf(&b, "func (k %s) RenderCSS(w io.Writer) error {\n\t_, err := w.Write([]byte(k))\n\treturn err\n}\n\n", keyword)
+			fmt.Fprintf(&b, "func (k %s) valueNode() {}\nfunc (k %s) %s() {}\n", keyword, keyword, marker)
+			if len(shorthandMarkers[p.name]) > 0 {
+				fmt.Fprintf(&b, "\n// %s also implements the value interfaces of the shorthands of %q.\n", keyword, p.name)
+			}
+			for _, m := range shorthandMarkers[p.name] {
+				fmt.Fprintf(&b, "func (k %s) %s() {}\n", keyword, m)
+			}
+			fmt.Fprintf(&b, "\n// %s value constants.\nconst (\n", keyword)
+			for i, k := range p.keywords {
+				fmt.Fprintf(&b, "\t%s %s = %q\n", p.consts[i], keyword, k)
+			}
+			b.WriteString(")\n")
+		}
+
+		for _, t := range append([]string{"GlobalType", "VarType"}, p.types...) {
+			markers[t] = append(markers[t], marker)
+		}
+
+		arg, css := p.example()
+		fmt.Fprintf(&b, "\n// %s creates %s %q property.\n", p.funcName, article(p.name), p.name)
+		fmt.Fprintf(&b, "// Syntax: %s\n", p.syntax)
+		fmt.Fprintf(&b, "// Example: %s(%s) -> \"%s: %s;\"\n", p.funcName, arg, p.name, css)
+		if p.layered {
+			fmt.Fprintf(&b, "// It sets a single layer; use %sLayers for several.\n", p.typeName)
+		}
+		switch {
+		case p.single:
+			fmt.Fprintf(&b, "func %s(value %sValue) Property {\n\treturn Prop(%q, value)\n}\n", p.funcName, p.typeName, p.name)
+		case p.layered:
+			fmt.Fprintf(&b, "func %s(values ...%sValue) Property {\n\treturn Prop(%q, valueNodes(values)...)\n}\n", p.funcName, p.typeName, p.name)
+			fmt.Fprintf(&b, "\n// %sLayers creates %s %q property from comma separated layers,\n", p.typeName, article(p.name), p.name)
+			fmt.Fprintf(&b, "// each made of values separated by spaces.\n")
+			fmt.Fprintf(&b, "// Example: %sLayers([]%sValue{%s}, []%sValue{%s}) -> \"%s: %s, %s;\"\n", p.typeName, p.typeName, arg, p.typeName, arg, p.name, css, css)
+			fmt.Fprintf(&b, "func %sLayers(layers ...[]%sValue) Property {\n\treturn commaProp(%q, layerNodes(layers)...)\n}\n", p.typeName, p.typeName, p.name)
+		case p.comma:
+			fmt.Fprintf(&b, "func %s(values ...%sValue) Property {\n\treturn commaProp(%q, valueNodes(values)...)\n}\n", p.funcName, p.typeName, p.name)
+		default:
+			fmt.Fprintf(&b, "func %s(values ...%sValue) Property {\n\treturn Prop(%q, valueNodes(values)...)\n}\n", p.funcName, p.typeName, p.name)
+		}
+	}
+
+	for _, t := range sharedTypes {
+		if len(markers[t]) == 0 {
+			continue
+		}
+		recv := strings.ToLower(t[:1])
+		b.WriteString("\n")
+		for _, m := range markers[t] {
+			fmt.Fprintf(&b, "func (%s %s) %s() {}\n", recv, t, m)
+		}
+	}
+	return b.Bytes()
+}
+
+func writeTests(props []property) []byte {
+	var b bytes.Buffer
+	b.WriteString(header + "package cssgo\n\nimport \"testing\"\n\n")
+	b.WriteString("// generatedPropertyTests has an example for every generated property constructor.\nvar generatedPropertyTests = []test{\n")
+	for _, p := range props {
+		arg, css := p.example()
+		if _, ok := propertyExamples[p.name]; !ok && p.comma && !p.layered {
+			arg, css = arg+", "+arg, css+", "+css
+		}
+		fmt.Fprintf(&b, "\t{name: %q, input: %s(%s), want: %q},\n", p.name, p.funcName, arg, p.name+": "+css+";")
+		if p.layered {
+			layer := "[]" + p.typeName + "Value{" + arg + "}"
+			fmt.Fprintf(&b, "\t{name: %q, input: %sLayers(%s, %s), want: %q},\n", p.name+" layers", p.typeName, layer, layer, p.name+": "+css+", "+css+";")
+		}
+	}
+	b.WriteString("}\n\nfunc TestGeneratedProperties(t *testing.T) {\n\tRunTests(t, generatedPropertyTests...)\n}\n")
+	return b.Bytes()
+}
+
+// example returns a Go expression for a value of the property and its rendering.
+func (p property) example() (string, string) {
+	if e, ok := propertyExamples[p.name]; ok {
+		return e[0], e[1]
+	}
+	if len(p.keywords) > 0 {
+		return p.consts[0], p.keywords[0]
+	}
+	for _, t := range p.dataTypes {
+		if e, ok := examples[t]; ok {
+			return e[0], e[1]
+		}
+	}
+	return examples["global"][0], examples["global"][1]
+}
+
+// goTypes returns the Go types rendering the given data types and shared keywords, without duplicates.
+func goTypes(types []string) []string {
+	seen := map[string]bool{}
+	var out []string
+	add := func(t string) {
+		if !seen[t] {
+			seen[t] = true
+			out = append(out, t)
+		}
+	}
+	for _, t := range types {
+		if t == "auto" {
+			add("AutoType")
+			continue
+		}
+		for _, goType := range dataTypes[t] {
+			add(goType)
+		}
+	}
+
+	// Keep the order of sharedTypes, so that marker methods are grouped deterministically.
+	order := map[string]int{}
+	for i, t := range sharedTypes {
+		order[t] = i
+	}
+	sort.Slice(out, func(i, j int) bool { return order[out[i]] < order[out[j]] })
+	return out
+}
+
+// camel converts a CSS name to an exported Go name.
+// Example: camel("overflow-x") -> "OverflowX"
+func camel(name string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(name, "-") {
+		if part != "" {
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return b.String()
+}
+
+func lowerFirst(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+func article(name string) string {
+	if strings.ContainsRune("aeiou", rune(name[0])) {
+		return "an"
+	}
+	return "a"
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGeneratedFilesAreUpToDate(t *testing.T) {
+	dir := filepath.Join("..", "..", "..")
+	code, tests, err := generate(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for file, want := range map[string][]byte{codeFile: code, testFile: tests} {
+		got, err := os.ReadFile(filepath.Join(dir, file))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Fatalf("%s is out of date, run go generate", file)
+		}
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		syntax string
+		want   []string
+	}{
+		{"<length [0,∞]>{1,2} | auto", []string{"<length>", "{1,2}", "|", "auto"}},
+		{"[ <'width'> || none ]#", []string{"[", "<'width'>", "||", "none", "]", "#"}},
+		{"fit-content( <length-percentage> ) | '[' <custom-ident>* ']'", []string{"fit-content( <length-percentage> )", "|", "'['", "<custom-ident>", "*", "']'"}},
+		{"<number>#{1,4} && a / b", []string{"<number>", "#{1,4}", "&&", "a", "/", "b"}},
+	}
+
+	for _, test := range tests {
+		tokens, err := tokenize(test.syntax)
+		if err != nil {
+			t.Fatalf("TESTCASE %s: unexpected error: %v", test.syntax, err)
+		}
+		var got []string
+		for _, token := range tokens {
+			got = append(got, token.source())
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Fatalf("TESTCASE %s: FAIL\ngot: %q != want: %q", test.syntax, got, test.want)
+		}
+	}
+}
+
+func TestSingle(t *testing.T) {
+	a := analyzer{data: dataset{Syntaxes: map[string]struct {
+		Syntax string `json:"syntax"`
+	}{"line-style": {"none | solid"}, "pair": {"<length>{2}"}}}}
+
+	tests := []struct {
+		syntax string
+		want   bool
+	}{
+		{"auto | <length> | fit-content( <length> )", true},
+		{"<line-style> | [ thin | thick ]", true},
+		{"<length>{1,2}", false},
+		{"auto | <pair>", false},
+		{"<length> <length>", false},
+	}
+
+	for _, test := range tests {
+		got, err := a.single(test.syntax, 0)
+		if err != nil {
+			t.Fatalf("TESTCASE %s: unexpected error: %v", test.syntax, err)
+		}
+		if got != test.want {
+			t.Fatalf("TESTCASE %s: FAIL\ngot: %v != want: %v", test.syntax, got, test.want)
+		}
+	}
+}
+
+func TestCommaList(t *testing.T) {
+	a := analyzer{data: dataset{Syntaxes: map[string]struct {
+		Syntax string `json:"syntax"`
+	}{"single-transition": {"<time> || <custom-ident>"}, "name": {"none | <custom-ident>"}}}}
+
+	tests := []struct {
+		syntax         string
+		comma, layered bool
+	}{
+		{"<time>#", true, false},
+		{"none | <name>#", true, false},
+		{"<single-transition>#", true, true},
+		{"none | [ <length> <length>? ]#", true, true},
+		{"[ <length> | auto ]#", true, false},
+		{"<length>{1,2}", false, false},
+	}
+
+	for _, test := range tests {
+		comma, layered, err := a.commaList(test.syntax)
+		if err != nil {
+			t.Fatalf("TESTCASE %s: unexpected error: %v", test.syntax, err)
+		}
+		if comma != test.comma || layered != test.layered {
+			t.Fatalf("TESTCASE %s: FAIL\ngot: %v %v != want: %v %v", test.syntax, comma, layered, test.comma, test.layered)
+		}
+	}
+}
+
+func TestStandalone(t *testing.T) {
+	a := analyzer{data: dataset{Syntaxes: map[string]struct {
+		Syntax string `json:"syntax"`
+	}{"overflow-position": {"unsafe | safe"}, "content-position": {"center | start"}}}}
+
+	tests := []struct {
+		syntax string
+		want   string
+	}{
+		{"normal | <overflow-position>? <content-position>", "center normal start"},
+		{"none | [ x | y ] [ mandatory | proximity ]?", "none x y"},
+		{"<length> && hanging? && each-line?", ""},
+		{"[ auto | reverse ] || <angle>", "auto reverse"},
+		{"none | [ x | y | <number>{3} ] && <angle>", "none"},
+		{"[ first | last ]{2} | [ a? b? ]!", "a b"},
+	}
+
+	for _, test := range tests {
+		keywords, err := a.standalone(test.syntax)
+		if err != nil {
+			t.Fatalf("TESTCASE %s: unexpected error: %v", test.syntax, err)
+		}
+		if got := strings.Join(sortedKeys(keywords), " "); got != test.want {
+			t.Fatalf("TESTCASE %s: FAIL\ngot: %q != want: %q", test.syntax, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,519 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	keywordToken    tokenKind = iota // auto
+	refToken                         // <length [0,∞]>, <'width'> or <inset()>
+	functionToken                    // fit-content( <length-percentage> ), arguments included
+	literalToken                     // '[' or ,
+	openToken                        // [
+	closeToken                       // ]
+	combinatorToken                  // |, ||, &&
+	multiplierToken                  // ?, *, +, #, !, {1,4}, #{1,4}
+)
+
+type syntaxToken struct {
+	kind  tokenKind
+	value string
+	prop  bool // a reference to the syntax of a property, as in <'width'>
+}
+
+// tokenize splits a value definition syntax into tokens.
+// Example: tokenize("<length>{1,2} | auto") -> [<length>, {1,2}, |, auto]
+func tokenize(syntax string) ([]syntaxToken, error) {
+	var tokens []syntaxToken
+	for i := 0; i < len(syntax); {
+		c := syntax[i]
+		switch {
+		case c == ' ':
+			i++
+
+		case c == '<':
+			end := strings.IndexByte(syntax[i:], '>')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated data type in %q", syntax)
+			}
+			name := syntax[i+1 : i+end]
+			if bracket := strings.IndexByte(name, '['); bracket >= 0 {
+				name = name[:bracket]
+			}
+			name = strings.TrimSpace(name)
+			prop := strings.HasPrefix(name, "'")
+			tokens = append(tokens, syntaxToken{kind: refToken, value: strings.Trim(name, "'"), prop: prop})
+			i += end + 1
+
+		case c == '[':
+			tokens = append(tokens, syntaxToken{kind: openToken, value: "["})
+			i++
+
+		case c == ']':
+			tokens = append(tokens, syntaxToken{kind: closeToken, value: "]"})
+			i++
+
+		case c == '|' || c == '&':
+			n := 1
+			if i+1 < len(syntax) && syntax[i+1] == c {
+				n = 2
+			}
+			tokens = append(tokens, syntaxToken{kind: combinatorToken, value: syntax[i : i+n]})
+			i += n
+
+		case c == '?' || c == '*' || c == '+' || c == '#' || c == '!' || c == '{':
+			end := i + 1
+			if end < len(syntax) && syntax[end] == '{' || c == '{' {
+				close := strings.IndexByte(syntax[i:], '}')
+				if close < 0 {
+					return nil, fmt.Errorf("unterminated multiplier in %q", syntax)
+				}
+				end = i + close + 1
+			}
+			tokens = append(tokens, syntaxToken{kind: multiplierToken, value: syntax[i:end]})
+			i = end
+
+		case c == '\'':
+			end := strings.IndexByte(syntax[i+1:], '\'')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated literal in %q", syntax)
+			}
+			tokens = append(tokens, syntaxToken{kind: literalToken, value: syntax[i+1 : i+1+end]})
+			i += end + 2
+
+		case c == ',' || c == '/':
+			tokens = append(tokens, syntaxToken{kind: literalToken, value: string(c)})
+			i++
+
+		case isIdentByte(c):
+			start := i
+			for i < len(syntax) && isIdentByte(syntax[i]) {
+				i++
+			}
+			if i == len(syntax) || syntax[i] != '(' {
+				tokens = append(tokens, syntaxToken{kind: keywordToken, value: syntax[start:i]})
+				continue
+			}
+
+			depth := 0
+			for ; i < len(syntax); i++ {
+				if syntax[i] == '(' {
+					depth++
+				} else if syntax[i] == ')' {
+					if depth--; depth == 0 {
+						break
+					}
+				}
+			}
+			if i == len(syntax) {
+				return nil, fmt.Errorf("unterminated function in %q", syntax)
+			}
+			i++
+			tokens = append(tokens, syntaxToken{kind: functionToken, value: syntax[start:i]})
+
+		default:
+			return nil, fmt.Errorf("unexpected %q in %q", c, syntax)
+		}
+	}
+	return tokens, nil
+}
+
+func isIdentByte(c byte) bool {
+	return c == '-' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// analyzer answers questions about the syntaxes of the dataset.
+type analyzer struct {
+	data dataset
+}
+
+// resolve returns the syntax a reference token refers to, or false for basic data types and functions.
+func (a analyzer) resolve(t syntaxToken) (string, bool, error) {
+	if t.prop {
+		p, ok := a.data.Properties[t.value]
+		if !ok {
+			return "", false, fmt.Errorf("unknown property <'%s'>", t.value)
+		}
+		return p.Syntax, true, nil
+	}
+	if _, ok := dataTypes[t.value]; ok {
+		return "", false, nil
+	}
+	s, ok := a.data.Syntaxes[t.value]
+	if !ok {
+		return "", false, fmt.Errorf("unknown data type <%s>", t.value)
+	}
+	if strings.HasSuffix(t.value, "()") {
+		return "", false, nil
+	}
+	return s.Syntax, true, nil
+}
+
+// collect returns the keywords and basic data types that can appear as top-level values
+// of a syntax, in order of appearance. The auto keyword is returned as a data type, as it
+// is rendered by the shared AutoType. Keywords and types inside functions are skipped,
+// as functions cannot be created with the generated constructors.
+func (a analyzer) collect(syntax string, seen map[string]bool) (keywords, types []string, err error) {
+	tokens, err := tokenize(syntax)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, t := range tokens {
+		switch t.kind {
+		case keywordToken:
+			if seen["keyword "+t.value] {
+				continue
+			}
+			seen["keyword "+t.value] = true
+			if t.value == "auto" {
+				types = append(types, t.value)
+			} else {
+				keywords = append(keywords, t.value)
+			}
+
+		case refToken:
+			key := "ref " + t.value
+			if t.prop {
+				key = "prop " + t.value
+			}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			ref, ok, err := a.resolve(t)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !ok {
+				if _, basic := dataTypes[t.value]; basic && !t.prop {
+					types = append(types, t.value)
+				}
+				continue
+			}
+
+			k, ty, err := a.collect(ref, seen)
+			if err != nil {
+				return nil, nil, err
+			}
+			keywords, types = append(keywords, k...), append(types, ty...)
+		}
+	}
+	return keywords, types, nil
+}
+
+// single reports whether every alternative of a syntax is a single value,
+// such as `auto | <length>`, so that the property takes exactly one value.
+// Function alternatives are ignored.
+func (a analyzer) single(syntax string, depth int) (bool, error) {
+	if depth > 16 {
+		return false, fmt.Errorf("syntax %q is too deeply nested", syntax)
+	}
+
+	tokens, err := tokenize(syntax)
+	if err != nil {
+		return false, err
+	}
+
+	for _, alt := range alternatives(tokens) {
+		switch {
+		case len(alt) == 1 && (alt[0].kind == keywordToken || alt[0].kind == functionToken):
+		case len(alt) == 1 && alt[0].kind == refToken:
+			ref, ok, err := a.resolve(alt[0])
+			if err != nil {
+				return false, err
+			}
+			if !ok {
+				continue
+			}
+			if s, err := a.single(ref, depth+1); err != nil || !s {
+				return false, err
+			}
+		case len(alt) > 2 && alt[0].kind == openToken && closing(alt) == len(alt)-1:
+			inner := make([]string, 0, len(alt)-2)
+			for _, t := range alt[1 : len(alt)-1] {
+				inner = append(inner, t.source())
+			}
+			if s, err := a.single(strings.Join(inner, " "), depth+1); err != nil || !s {
+				return false, err
+			}
+		default:
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// commaList reports whether a syntax is a comma-separated list, such as `<time>#`, and whether
+// the items of the list are made of several values, as in `<single-transition>#`, so that they
+// are layers whose values are separated by spaces.
+func (a analyzer) commaList(syntax string) (comma, layered bool, err error) {
+	tokens, err := tokenize(syntax)
+	if err != nil {
+		return false, false, err
+	}
+
+	depth := 0
+	for i, t := range tokens {
+		switch t.kind {
+		case openToken:
+			depth++
+		case closeToken:
+			depth--
+		case multiplierToken:
+			if depth > 0 || !strings.HasPrefix(t.value, "#") || i == 0 {
+				continue
+			}
+			comma = true
+
+			// The item is the token before the multiplier, or the bracketed group it closes.
+			item := tokens[i-1 : i]
+			if item[0].kind == closeToken {
+				for start := i - 1; start >= 0; start-- {
+					if tokens[start].kind == openToken && closing(tokens[start:i]) == i-1-start {
+						item = tokens[start:i]
+						break
+					}
+				}
+			}
+			parts := make([]string, len(item))
+			for k, t := range item {
+				parts[k] = t.source()
+			}
+			single, err := a.single(strings.Join(parts, " "), 0)
+			if err != nil {
+				return false, false, err
+			}
+			layered = layered || !single
+		}
+	}
+	return comma, layered, nil
+}
+
+// standalone returns the keywords that are a whole value of a syntax on their own, such as
+// center in `<overflow-position>? <content-position>`, but not safe, which needs a position.
+func (a analyzer) standalone(syntax string) (map[string]bool, error) {
+	tokens, err := tokenize(syntax)
+	if err != nil {
+		return nil, err
+	}
+	keywords := map[string]bool{}
+	return keywords, a.alone(tokens, keywords, 0)
+}
+
+// alone adds the keywords that match tokens on their own to keywords.
+func (a analyzer) alone(tokens []syntaxToken, keywords map[string]bool, depth int) error {
+	if depth > 32 {
+		return fmt.Errorf("syntax is too deeply nested")
+	}
+
+	// Any alternative of `a | b` and any component of `a || b` can be the whole value.
+	for _, combinator := range []string{"|", "||"} {
+		if parts := split(tokens, combinator); len(parts) > 1 {
+			for _, part := range parts {
+				if err := a.alone(part, keywords, depth+1); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	// A component of `a && b` or `a b` can only be the whole value if the others are optional.
+	parts := split(tokens, "&&")
+	if len(parts) == 1 {
+		parts = terms(tokens)
+	}
+	if len(parts) > 1 {
+		for i, part := range parts {
+			others := true
+			for j, other := range parts {
+				if j == i || !others {
+					continue
+				}
+				optional, err := a.optional(other, depth+1)
+				if err != nil {
+					return err
+				}
+				others = optional
+			}
+			if others {
+				if err := a.alone(part, keywords, depth+1); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	if len(tokens) == 0 || repeats(tokens) > 1 {
+		return nil
+	}
+	switch t := tokens[0]; t.kind {
+	case keywordToken:
+		keywords[t.value] = true
+	case openToken:
+		return a.alone(tokens[1:closing(tokens)], keywords, depth+1)
+	case refToken:
+		ref, ok, err := a.resolve(t)
+		if err != nil || !ok {
+			return err
+		}
+		refTokens, err := tokenize(ref)
+		if err != nil {
+			return err
+		}
+		return a.alone(refTokens, keywords, depth+1)
+	}
+	return nil
+}
+
+// optional reports whether tokens can match nothing, as `<length>?` or `[ a? b? ]` do.
+func (a analyzer) optional(tokens []syntaxToken, depth int) (bool, error) {
+	if depth > 32 {
+		return false, fmt.Errorf("syntax is too deeply nested")
+	}
+
+	if parts := split(tokens, "|"); len(parts) > 1 {
+		for _, part := range parts {
+			if optional, err := a.optional(part, depth+1); err != nil || optional {
+				return optional, err
+			}
+		}
+		return false, nil
+	}
+
+	parts := split(tokens, "||")
+	if len(parts) == 1 {
+		parts = split(tokens, "&&")
+	}
+	if len(parts) == 1 {
+		parts = terms(tokens)
+	}
+	if len(parts) > 1 {
+		for _, part := range parts {
+			if optional, err := a.optional(part, depth+1); err != nil || !optional {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+
+	if len(tokens) == 0 || repeats(tokens) == 0 {
+		return true, nil
+	}
+	switch t := tokens[0]; t.kind {
+	case openToken:
+		if tokens[len(tokens)-1].value == "!" {
+			return false, nil
+		}
+		return a.optional(tokens[1:closing(tokens)], depth+1)
+	case refToken:
+		ref, ok, err := a.resolve(t)
+		if err != nil || !ok {
+			return false, err
+		}
+		refTokens, err := tokenize(ref)
+		if err != nil {
+			return false, err
+		}
+		return a.optional(refTokens, depth+1)
+	}
+	return false, nil
+}
+
+// terms splits juxtaposed components into terms, each a token or a bracketed group,
+// followed by its multipliers.
+func terms(tokens []syntaxToken) [][]syntaxToken {
+	var out [][]syntaxToken
+	for i := 0; i < len(tokens); {
+		end := i + 1
+		if tokens[i].kind == openToken {
+			end = i + closing(tokens[i:]) + 1
+		}
+		for end < len(tokens) && tokens[end].kind == multiplierToken {
+			end++
+		}
+		out = append(out, tokens[i:end])
+		i = end
+	}
+	return out
+}
+
+// repeats returns the minimum number of times a term is repeated, according to its multipliers.
+// Example: repeats of <length>{2,4} -> 2
+func repeats(term []syntaxToken) int {
+	start := 1
+	if term[0].kind == openToken {
+		start = closing(term) + 1
+	}
+
+	n := 1
+	for _, t := range term[start:] {
+		switch m := strings.TrimPrefix(t.value, "#"); {
+		case m == "?" || m == "*":
+			n = 0
+		case strings.HasPrefix(m, "{"):
+			lo, _, _ := strings.Cut(strings.Trim(m, "{}"), ",")
+			n, _ = strconv.Atoi(lo)
+		}
+	}
+	return n
+}
+
+// alternatives splits tokens on their top-level `|` combinators.
+func alternatives(tokens []syntaxToken) [][]syntaxToken {
+	return split(tokens, "|")
+}
+
+// split splits tokens on a top-level combinator.
+func split(tokens []syntaxToken, combinator string) [][]syntaxToken {
+	var parts [][]syntaxToken
+	depth, start := 0, 0
+	for i, t := range tokens {
+		switch {
+		case t.kind == openToken:
+			depth++
+		case t.kind == closeToken:
+			depth--
+		case t.kind == combinatorToken && t.value == combinator && depth == 0:
+			parts = append(parts, tokens[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, tokens[start:])
+}
+
+// closing returns the index of the bracket closing the one opening tokens.
+func closing(tokens []syntaxToken) int {
+	depth := 0
+	for i, t := range tokens {
+		switch t.kind {
+		case openToken:
+			depth++
+		case closeToken:
+			if depth--; depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// source returns the syntax of a token.
+func (t syntaxToken) source() string {
+	switch {
+	case t.kind == refToken && t.prop:
+		return "<'" + t.value + "'>"
+	case t.kind == refToken:
+		return "<" + t.value + ">"
+	case t.kind == literalToken && t.value != "," && t.value != "/":
+		return "'" + t.value + "'"
+	}
+	return t.value
+}
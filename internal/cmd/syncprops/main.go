@@ -0,0 +1,234 @@
+// Command syncprops updates the vendored property dataset in data/properties.json from
+// the css/properties.json and css/syntaxes.json files of mdn-data (https://github.com/mdn/data),
+// either a release downloaded from npm with -version, or a checkout with -mdn.
+//
+// It keeps every standard property that is not vendor-prefixed, the properties already in
+// the dataset and the ones given with -add, and only their syntax, initial value, inheritance
+// and animation type. Named syntaxes are kept when a kept property or syntax references them.
+//
+// Usage, from the root of the module:
+//
+//	go run ./internal/cmd/syncprops -version 2.12.2
+//	go generate
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	dataFile = "data/properties.json"
+	// releaseURL is the npm tarball of an mdn-data release.
+	releaseURL = "https://registry.npmjs.org/mdn-data/-/mdn-data-%s.tgz"
+)
+
+// property is the part of an mdn-data property that is vendored.
+// The fields are in the order of their keys, so that the output is sorted.
+type property struct {
+	AnimationType json.RawMessage `json:"animationType"`
+	Inherited     bool            `json:"inherited"`
+	Initial       json.RawMessage `json:"initial"`
+	Syntax        string          `json:"syntax"`
+}
+
+// upstreamProperty is an mdn-data property, with the status that decides whether it is vendored.
+type upstreamProperty struct {
+	property
+	Status string `json:"status"`
+}
+
+type syntax struct {
+	Syntax string `json:"syntax"`
+}
+
+type dataset struct {
+	Properties map[string]property `json:"properties"`
+	Syntaxes   map[string]syntax   `json:"syntaxes"`
+}
+
+// references matches the named syntaxes referenced by a value definition syntax, such as <line-style>.
+var references = regexp.MustCompile(`<([a-z][a-z0-9-]*(?:\(\))?)(?: \[[^\]]*\])?>`)
+
+func main() {
+	dir := flag.String("dir", ".", "the directory of the cssgo package")
+	version := flag.String("version", "", "the mdn-data release to download, such as 2.12.2")
+	mdn := flag.String("mdn", "", "the css directory of an mdn-data checkout, instead of -version")
+	add := flag.String("add", "", "comma separated properties to add to the dataset")
+	flag.Parse()
+
+	if (*version == "") == (*mdn == "") {
+		fmt.Fprintln(os.Stderr, "syncprops: one of -version and -mdn is required")
+		os.Exit(2)
+	}
+	if err := run(*dir, *version, *mdn, *add); err != nil {
+		fmt.Fprintln(os.Stderr, "syncprops:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir, version, mdn, add string) error {
+	if version != "" {
+		tmp, err := os.MkdirTemp("", "mdn-data")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmp)
+		if err := download(version, tmp); err != nil {
+			return err
+		}
+		mdn = tmp
+	}
+
+	var current dataset
+	if err := readJSON(filepath.Join(dir, dataFile), &current); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(current.Properties))
+	for name := range current.Properties {
+		names = append(names, name)
+	}
+	if add != "" {
+		names = append(names, strings.Split(add, ",")...)
+	}
+
+	data, err := sync(mdn, names)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, dataFile), data, 0o644)
+}
+
+// sync returns the dataset of the standard and named properties in the mdn-data css directory.
+func sync(mdn string, names []string) ([]byte, error) {
+	var upstream struct {
+		Properties map[string]upstreamProperty
+		Syntaxes   map[string]syntax
+	}
+	if err := readJSON(filepath.Join(mdn, "properties.json"), &upstream.Properties); err != nil {
+		return nil, err
+	}
+	if err := readJSON(filepath.Join(mdn, "syntaxes.json"), &upstream.Syntaxes); err != nil {
+		return nil, err
+	}
+
+	out := dataset{Properties: map[string]property{}, Syntaxes: map[string]syntax{}}
+	var pending []string
+	for _, name := range append(standard(upstream.Properties), names...) {
+		p, ok := upstream.Properties[name]
+		if !ok {
+			return nil, fmt.Errorf("property %s is not in %s", name, mdn)
+		}
+		out.Properties[name] = p.property
+		pending = append(pending, p.Syntax)
+	}
+
+	for len(pending) > 0 {
+		s := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		for _, m := range references.FindAllStringSubmatch(s, -1) {
+			ref, ok := upstream.Syntaxes[m[1]]
+			if _, seen := out.Syntaxes[m[1]]; !ok || seen {
+				continue
+			}
+			out.Syntaxes[m[1]] = ref
+			pending = append(pending, ref.Syntax)
+		}
+	}
+
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", " ")
+	if err := enc.Encode(out); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// standard returns the names of the standard properties that are not vendor-prefixed, sorted.
+func standard(properties map[string]upstreamProperty) []string {
+	var names []string
+	for name, p := range properties {
+		if p.Status == "standard" && !strings.HasPrefix(name, "-") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// download extracts the css/properties.json and css/syntaxes.json files of an mdn-data release to dir.
+func download(version, dir string) error {
+	resp, err := http.Get(fmt.Sprintf(releaseURL, version))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("mdn-data %s: %s", version, resp.Status)
+	}
+
+	gz, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return fmt.Errorf("mdn-data %s: %w", version, err)
+	}
+	want := map[string]bool{"package/css/properties.json": true, "package/css/syntaxes.json": true}
+	archive := tar.NewReader(gz)
+	for len(want) > 0 {
+		header, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			return fmt.Errorf("mdn-data %s: missing %s", version, strings.Join(sortedKeys(want), ", "))
+		}
+		if err != nil {
+			return fmt.Errorf("mdn-data %s: %w", version, err)
+		}
+		if !want[header.Name] {
+			continue
+		}
+		delete(want, header.Name)
+
+		raw, err := io.ReadAll(archive)
+		if err != nil {
+			return fmt.Errorf("mdn-data %s: %w", version, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, path.Base(header.Name)), raw, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func readJSON(file string, v any) error {
+	raw, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("%s: %w", file, err)
+	}
+	return nil
+}
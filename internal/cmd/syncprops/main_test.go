@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSyncReproducesDataset(t *testing.T) {
+	want, err := os.ReadFile(filepath.Join("..", "..", "..", dataFile))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var data struct {
+		Properties map[string]map[string]any `json:"properties"`
+		Syntaxes   map[string]map[string]any `json:"syntaxes"`
+	}
+	if err := json.Unmarshal(want, &data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// mdn-data has more properties, syntaxes and fields than are vendored.
+	names := make([]string, 0, len(data.Properties))
+	for name, p := range data.Properties {
+		names = append(names, name)
+		p["status"] = "standard"
+	}
+	data.Properties["-webkit-box-reflect"] = map[string]any{"syntax": "<unused-syntax>", "initial": "none", "status": "standard"}
+	data.Properties["box-flex"] = map[string]any{"syntax": "<unused-syntax>", "initial": "0", "status": "nonstandard"}
+	data.Syntaxes["unused-syntax"] = map[string]any{"syntax": "above | below"}
+
+	mdn := t.TempDir()
+	for file, v := range map[string]any{"properties.json": data.Properties, "syntaxes.json": data.Syntaxes} {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(mdn, file), raw, 0o644); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	got, err := sync(mdn, names)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("synced dataset differs from %s", dataFile)
+	}
+
+	// Every standard property that is not vendor-prefixed is kept without being named.
+	if got, err = sync(mdn, nil); err != nil || !bytes.Equal(got, want) {
+		t.Fatalf("synced standard properties differ from %s: %v", dataFile, err)
+	}
+
+	if _, err := sync(mdn, []string{"colour"}); err == nil {
+		t.Fatalf("sync of an unknown property: got no error")
+	}
+}
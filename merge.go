@@ -0,0 +1,118 @@
+package cssgo
+
+import (
+	"io"
+	"strings"
+)
+
+// MergeProps combines properties into one, resolving conflicting declarations the way the
+// browser would, so that composed style groups render a minimal and predictable set of declarations.
+// Unlike GroupProps, which renders every declaration:
+//   - a declaration is dropped when a later one sets the same property, or a shorthand
+//     covering it, unless it is `!important` and the later one is not;
+//   - a longhand following its box shorthand is folded into it: `padding: 8px;padding-left: 4px;` -> `padding: 8px 8px 8px 4px;`;
+//   - the four longhands of a box shorthand are collapsed into it.
+//
+// Declarations that may be fallbacks for older browsers, such as `color: red;` before
+// `color: oklch(0.6 0.2 30);`, are kept, as in Optimize.
+// Example: MergeProps(Width(PX(1)), TextColor(Red), Width(PX(2))) -> "color: red;width: 2px;"
+func MergeProps(props ...PropertyNode) Property {
+	return Property(func(w io.Writer) error {
+		decls, err := declarations(w, props...)
+		if err != nil {
+			return err
+		}
+
+		for _, decl := range mergeDeclarations(decls) {
+			if err := decl.RenderCSS(w); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// mergeDeclarations resolves conflicting declarations of a block.
+func mergeDeclarations(decls []Declaration) []Declaration {
+	items := make([]cssItem, len(decls))
+	for i, decl := range decls {
+		items[i] = cssItem{kind: declItem, decl: decl}
+	}
+
+	items = collapseShorthands(foldLonghands(removeOverridden(items)))
+
+	merged := make([]Declaration, len(items))
+	for i, item := range items {
+		merged[i] = item.decl
+	}
+	return merged
+}
+
+// foldLonghands folds the longhands that follow a box shorthand into its value.
+// Longhands using functions or CSS-wide keywords are left alone, since folding them
+// could make the whole shorthand invalid.
+func foldLonghands(items []cssItem) []cssItem {
+	removed := make([]bool, len(items))
+	for i := range items {
+		sides, ok := boxShorthands[items[i].decl.Property]
+		if items[i].kind != declItem || !ok {
+			continue
+		}
+
+		for j := i + 1; j < len(items); j++ {
+			shorthand, later := items[i].decl, items[j].decl
+			if items[j].kind != declItem || removed[j] || !intersects(later.Property, shorthand.Property) {
+				continue
+			}
+
+			side := -1
+			for s := range sides {
+				if sides[s] == later.Property {
+					side = s
+				}
+			}
+			values, ok := expandBox(shorthand.Value)
+			if side < 0 || !ok || later.Important != shorthand.Important || !foldable(later.Value) {
+				// Another declaration sets these longhands; later longhands must stay after it.
+				break
+			}
+
+			values[side] = later.Value
+			items[i].decl.Value = collapseBox(values)
+			removed[j] = true
+		}
+	}
+
+	kept := items[:0:0]
+	for i, item := range items {
+		if !removed[i] {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
+
+// expandBox returns the top, right, bottom and left values of a box shorthand value.
+func expandBox(value string) ([4]string, bool) {
+	if !foldable(value) {
+		return [4]string{}, false
+	}
+
+	v := strings.Fields(value)
+	switch len(v) {
+	case 1:
+		return [4]string{v[0], v[0], v[0], v[0]}, true
+	case 2:
+		return [4]string{v[0], v[1], v[0], v[1]}, true
+	case 3:
+		return [4]string{v[0], v[1], v[2], v[1]}, true
+	case 4:
+		return [4]string{v[0], v[1], v[2], v[3]}, true
+	}
+	return [4]string{}, false
+}
+
+// foldable reports whether a value can be moved into or out of a box shorthand.
+func foldable(value string) bool {
+	return !strings.Contains(value, "(") && !isGlobalKeyword(value)
+}
@@ -0,0 +1,18 @@
+package cssgo
+
+import "testing"
+
+func TestMergeProps(t *testing.T) {
+	RunTests(t,
+		test{"last wins", MergeProps(Width(PX(1)), Width(PX(2))), "width: 2px;"},
+		test{"order of the winners is kept", MergeProps(Width(PX(1)), TextColor(Red), Width(PX(2))), "color: red;width: 2px;"},
+		test{"nested groups", MergeProps(GroupProps(Padding1(PX(16)), TextColor(Red)), GroupProps(Padding2(PX(0), PX(8)))), "color: red;padding: 0px 8px;"},
+		test{"shorthand overrides longhands", MergeProps(PaddingLeft(PX(4)), Padding1(PX(8))), "padding: 8px;"},
+		test{"longhands fold into the shorthand", MergeProps(Padding1(PX(16)), PaddingLeft(PX(8)), PaddingRight(PX(8))), "padding: 16px 8px;"},
+		test{"longhands collapse into the shorthand", MergeProps(PaddingTop(PX(1)), PaddingRight(PX(2)), PaddingBottom(PX(3)), PaddingLeft(PX(2))), "padding: 1px 2px 3px;"},
+		test{"important wins", MergeProps(Declaration{Property: "color", Value: "red", Important: true}, TextColor(Blue)), "color: red !important;"},
+		test{"important longhands are not folded", MergeProps(Padding1(PX(16)), Declaration{Property: "padding-left", Value: "0", Important: true}), "padding: 16px;padding-left: 0 !important;"},
+		test{"functions are not folded", MergeProps(Padding1(PX(16)), Declaration{Property: "padding-left", Value: "calc(1px + 2px)"}), "padding: 16px;padding-left: calc(1px + 2px);"},
+		test{"fallbacks are kept", MergeProps(TextColor(Red), TextColor(OKLCH(0.6, 0.2, 30))), "color: red;color: oklch(0.6 0.2 30);"},
+	)
+}
@@ -0,0 +1,243 @@
+package cssgo
+
+import (
+	"errors"
+	"io"
+	"strings"
+)
+
+// ErrNestedRule is returned when a nested rule, created with Nest or NestMedia, is used
+// where only declarations are allowed, such as in Important, MergeProps or a style attribute.
+var ErrNestedRule = errors.New("cssgo: nested rule outside of a rule block")
+
+// NestedRule is a rule nested inside the block of the enclosing rule.
+// It implements the PropertyNode interface so that it can be passed to Props, Nest,
+// NestMedia and GroupProps. It is not a declaration: functions that work on declarations,
+// such as Declarations, MergeProps, Property.Important and Atomize, fail with ErrNestedRule.
+type NestedRule func(io.Writer) error
+
+func (n NestedRule) RenderCSS(w io.Writer) error {
+	return n(w)
+}
+
+func (n NestedRule) String() string {
+	var b strings.Builder
+	_ = n.RenderCSS(&b)
+	return b.String()
+}
+
+func (n NestedRule) propertyNode() {}
+
+// Nest creates a rule nested inside the block of the enclosing rule, using the `&`
+// nesting selector to refer to it. Selectors starting with a pseudo-class or pseudo-element
+// are attached to the parent, other selectors without `&` match its descendants.
+// It renders native CSS Nesting syntax; use Flatten for browsers without nesting support.
+// Example: Class("card").Props(Padding1(PX(8)), Nest(Hover(), BackgroundColor(Gray)))
+// -> `.card{padding: 8px;&:hover{background-color: gray;}}`
+//
+// Parameters:
+// - sel (Selector): The nested selector, e.g. Hover(), Class("title") or a selector containing `&`.
+// - props (...PropertyNode): The declarations of the nested rule, which may nest further.
+//
+// Returns:
+// - NestedRule: The nested rule, to be placed inside a Props block.
+func Nest(sel Selector, props ...PropertyNode) NestedRule {
+	return NestedRule(func(w io.Writer) error {
+		var b strings.Builder
+		if err := sel.RenderCSS(&b); err != nil {
+			return err
+		}
+
+		selectors := splitSelectorList(b.String())
+		for i, s := range selectors {
+			switch {
+			case strings.Contains(s, "&"):
+			case strings.HasPrefix(s, ":"):
+				selectors[i] = "&" + s
+			default:
+				selectors[i] = "& " + s
+			}
+		}
+
+		return Selector(func(w io.Writer) error {
+			_, err := w.Write([]byte(strings.Join(selectors, ", ")))
+			return err
+		}).Props(props...).RenderCSS(w)
+	})
+}
+
+// NestMedia creates an `@media` rule nested inside the block of the enclosing rule,
+// whose declarations apply to the enclosing rule when the query matches.
+// Example: Class("card").Props(NestMedia("(min-width: 600px)", Padding1(PX(16))))
+// -> `.card{@media (min-width: 600px){padding: 16px;}}`
+func NestMedia(query string, props ...PropertyNode) NestedRule {
+	return NestedRule(func(w io.Writer) error {
+		if _, err := w.Write([]byte("@media " + query + "{")); err != nil {
+			return err
+		}
+
+		for _, prop := range props {
+			if err := prop.RenderCSS(w); err != nil {
+				return err
+			}
+		}
+
+		_, err := w.Write([]byte("}"))
+		return err
+	})
+}
+
+// Flatten expands nested rules into plain rules, for browsers without CSS Nesting support.
+// Nested selectors are resolved against every selector of their parent, and declarations
+// following a nested rule are emitted in a new rule, so the cascade order is kept.
+// Specificity can differ slightly from native nesting, which treats `&` like `:is()`,
+// when the selectors of a parent list have different specificities.
+// Example: Flatten(Class("card").Props(Padding1(PX(8)), Nest(Hover(), BackgroundColor(Gray))))
+// -> `.card{padding: 8px;}.card:hover{background-color: gray;}`
+func Flatten(nodes ...Node) (Stylesheet, error) {
+	var b strings.Builder
+	for _, node := range nodes {
+		if err := node.RenderCSS(&b); err != nil {
+			return nil, err
+		}
+	}
+
+	items, err := parseCSS(b.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return itemsStylesheet(flattenItems(items, "")), nil
+}
+
+// itemsStylesheet turns parsed items into a Stylesheet with one node per top-level item.
+func itemsStylesheet(items []cssItem) Stylesheet {
+	sheet := make(Stylesheet, len(items))
+	for i, item := range items {
+		css := printCSS([]cssItem{item}, RenderOptions{})
+		sheet[i] = RuleNodeFunc(func(w io.Writer) error {
+			_, err := w.Write([]byte(css))
+			return err
+		})
+	}
+	return sheet
+}
+
+// groupingAtRules are the at-rules whose blocks contain rules of the enclosing context,
+// so that nested rules inside them are resolved against the parent selector.
+// Other at-rules, such as @keyframes or @font-face, are copied as they are.
+var groupingAtRules = map[string]bool{"@media": true, "@supports": true, "@container": true, "@layer": true}
+
+// flattenItems expands the nested rules of items whose parent selector is `parent`.
+// Runs of declarations are wrapped in a rule for the parent.
+func flattenItems(items []cssItem, parent string) []cssItem {
+	var out, decls []cssItem
+	flush := func() {
+		if len(decls) == 0 {
+			return
+		}
+		if parent == "" {
+			out = append(out, decls...)
+		} else {
+			out = append(out, cssItem{kind: ruleItem, prelude: parent, children: decls})
+		}
+		decls = nil
+	}
+
+	for _, item := range items {
+		name, _, _ := strings.Cut(item.prelude, " ")
+		switch {
+		case item.kind == declItem:
+			decls = append(decls, item)
+		case item.kind == statementItem:
+			flush()
+			out = append(out, item)
+		case strings.HasPrefix(item.prelude, "@"):
+			flush()
+			if groupingAtRules[name] {
+				item.children = flattenItems(item.children, parent)
+			}
+			out = append(out, item)
+		default:
+			flush()
+			out = append(out, flattenItems(item.children, resolveNesting(item.prelude, parent))...)
+		}
+	}
+	flush()
+
+	return out
+}
+
+// resolveNesting resolves a nested selector list against its parent selector list.
+// Example: resolveNesting("&:hover, & .title", ".a, .b") -> ".a:hover, .b:hover, .a .title, .b .title"
+func resolveNesting(selector, parent string) string {
+	if parent == "" {
+		return selector
+	}
+
+	var resolved []string
+	for _, s := range splitSelectorList(selector) {
+		if !strings.Contains(s, "&") {
+			s = "& " + s
+		}
+		for _, p := range splitSelectorList(parent) {
+			resolved = append(resolved, replaceNesting(s, p))
+		}
+	}
+	return strings.Join(resolved, ", ")
+}
+
+// replaceNesting replaces every `&` of a selector outside of strings with parent.
+func replaceNesting(selector, parent string) string {
+	var b strings.Builder
+	var quote byte
+	for i := 0; i < len(selector); i++ {
+		c := selector[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && i+1 < len(selector) {
+				b.WriteByte(c)
+				i++
+				c = selector[i]
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '&':
+			b.WriteString(parent)
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// splitSelectorList splits a selector list on its top-level commas, skipping those
+// inside strings, brackets and functional pseudo-classes such as :is().
+func splitSelectorList(list string) []string {
+	var parts []string
+	var quote byte
+	depth, start := 0, 0
+	for i := 0; i < len(list); i++ {
+		c := list[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '(' || c == '[':
+			depth++
+		case c == ')' || c == ']':
+			depth--
+		case c == ',' && depth == 0:
+			parts = append(parts, strings.TrimSpace(list[start:i]))
+			start = i + 1
+		}
+	}
+	return append(parts, strings.TrimSpace(list[start:]))
+}
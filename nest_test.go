@@ -0,0 +1,76 @@
+package cssgo
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestNest(t *testing.T) {
+	card := Class("card").Props(
+		Padding1(PX(8)),
+		Nest(Hover(), BackgroundColor(Gray)),
+		Nest(Class("title"), TextColor(Blue), Nest(After(), Content(Str("!")))),
+		Nest(Selector(func(w io.Writer) error {
+			_, err := w.Write([]byte(`.dark &, &[title="a & b"]`))
+			return err
+		}), TextColor(White)),
+		NestMedia("(min-width: 600px)", Padding1(PX(16)), Nest(Focus().Or(FocusVisible()), TextColor(Red))),
+		Width(PX(10)),
+	)
+
+	RunTests(t,
+		test{"pseudo selectors", Hover().Or(Before()), ":hover, ::before"},
+		test{"native nesting", card,
+			`.card{padding: 8px;&:hover{background-color: gray;}` +
+				`& .title{color: blue;&::after{content: "!";}}` +
+				`.dark &, &[title="a & b"]{color: white;}` +
+				`@media (min-width: 600px){padding: 16px;&:focus, &:focus-visible{color: red;}}` +
+				`width: 10px;}`,
+		},
+	)
+
+	sheet, err := Flatten(card, Class("a").Or(Class("b")).Props(Nest(Hover(), TextColor(Red))))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `.card{padding: 8px;}.card:hover{background-color: gray;}` +
+		`.card .title{color: blue;}.card .title::after{content: "!";}` +
+		`.dark .card, .card[title="a & b"]{color: white;}` +
+		`@media (min-width: 600px){.card{padding: 16px;}.card:focus, .card:focus-visible{color: red;}}` +
+		`.card{width: 10px;}` +
+		`.a:hover, .b:hover{color: red;}`
+	if got := sheet.String(); got != want {
+		t.Fatalf("FAIL\ngot: %s != want: %s", got, want)
+	}
+
+	var b strings.Builder
+	if err := RenderWith(&b, RenderOptions{Flatten: true, Minify: true}, Class("x").Props(Nest(Hover(), TextColor(Red)))); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := ".x:hover{color:red}"; b.String() != want {
+		t.Fatalf("FAIL\ngot: %s != want: %s", b.String(), want)
+	}
+}
+
+func TestNestedRuleErrors(t *testing.T) {
+	nested := Nest(Hover(), TextColor(Red))
+	tests := []struct {
+		name string
+		prop PropertyNode
+	}{
+		{"important", GroupProps(TextColor(Blue), nested).Important()},
+		{"merge", MergeProps(TextColor(Blue), NestMedia("print", Width(PX(1))))},
+	}
+	for _, tc := range tests {
+		if err := tc.prop.RenderCSS(io.Discard); !errors.Is(err, ErrNestedRule) {
+			t.Fatalf("TESTCASE %s: FAIL\ngot error: %v, want ErrNestedRule", tc.name, err)
+		}
+	}
+
+	if _, err := Atomize(nested); !errors.Is(err, ErrNestedRule) {
+		t.Fatalf("got error: %v, want ErrNestedRule", err)
+	}
+}
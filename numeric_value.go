@@ -0,0 +1,79 @@
+package cssgo
+
+import (
+	"io"
+	"strconv"
+)
+
+// Number represents a CSS number or integer value (e.g., "1.5", "2").
+// It is used by generated properties accepting `<number>` or `<integer>`, such as FlexGrow or Order.
+type Number string
+
+func (n Number) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(n))
+	return err
+}
+
+func (n Number) valueNode() {}
+
+// Num generates a CSS number.
+// Example: Num(1.5) -> "1.5"
+func Num(value float64) Number {
+	return Number(strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// Int generates a CSS integer.
+// Example: Int(-1) -> "-1"
+func Int(value int) Number {
+	return Number(strconv.Itoa(value))
+}
+
+// Time represents a CSS time value (e.g., "200ms", "1.5s").
+type Time string
+
+func (t Time) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(t))
+	return err
+}
+
+func (t Time) valueNode() {}
+
+// S generates a time in seconds (s).
+// Example: S(1.5) -> "1.5s"
+func S(value float64) Time {
+	return Time(strconv.FormatFloat(value, 'f', -1, 64) + "s")
+}
+
+// MS generates a time in milliseconds (ms).
+// Example: MS(200) -> "200ms"
+func MS(value float64) Time {
+	return Time(strconv.FormatFloat(value, 'f', -1, 64) + "ms")
+}
+
+// Angle represents a CSS angle value (e.g., "45deg", "0.25turn").
+type Angle string
+
+func (a Angle) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(a))
+	return err
+}
+
+func (a Angle) valueNode() {}
+
+// Deg generates an angle in degrees (deg).
+// Example: Deg(45) -> "45deg"
+func Deg(value float64) Angle {
+	return Angle(strconv.FormatFloat(value, 'f', -1, 64) + "deg")
+}
+
+// Rad generates an angle in radians (rad).
+// Example: Rad(1.57) -> "1.57rad"
+func Rad(value float64) Angle {
+	return Angle(strconv.FormatFloat(value, 'f', -1, 64) + "rad")
+}
+
+// Turn generates an angle in turns (turn).
+// Example: Turn(0.25) -> "0.25turn"
+func Turn(value float64) Angle {
+	return Angle(strconv.FormatFloat(value, 'f', -1, 64) + "turn")
+}
@@ -0,0 +1,17 @@
+package cssgo
+
+import (
+	"testing"
+)
+
+func TestNumbers(t *testing.T) {
+	RunTests(t,
+		test{"number", Num(1.5), "1.5"},
+		test{"integer", Int(-1), "-1"},
+		test{"seconds", S(1.5), "1.5s"},
+		test{"milliseconds", MS(200), "200ms"},
+		test{"degrees", Deg(45), "45deg"},
+		test{"radians", Rad(1.57), "1.57rad"},
+		test{"turns", Turn(0.25), "0.25turn"},
+	)
+}
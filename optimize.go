@@ -0,0 +1,382 @@
+package cssgo
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Optimize returns a stylesheet equivalent to the nodes that is smaller when rendered.
+// Only transformations that cannot change which declarations apply are made:
+//   - values are shortened: `#ffffff` -> `#fff`, `0px` -> `0`, `#ff0000` -> `red`, `white` -> `#fff`;
+//   - declarations overridden later in the same block are removed, unless they may be
+//     a fallback for browsers that do not support the overriding value;
+//   - the four longhands of `padding`, `margin`, `border-width`, `border-style` and
+//     `border-color` are collapsed into the shorthand;
+//   - adjacent rules with the same selector are combined, and adjacent rules with identical
+//     declarations and no nested rules are merged into one selector list, when every browser
+//     supports their selectors. Rules that are not adjacent are never
+//     moved, since that could change the cascade order.
+//
+// Example: Optimize(Class("a").Props(TextColor(Hex(0xffffff))), Class("b").Props(TextColor(White))) -> `.a, .b{color: #fff;}`
+func Optimize(nodes ...Node) (Stylesheet, error) {
+	var b strings.Builder
+	for _, node := range nodes {
+		if err := node.RenderCSS(&b); err != nil {
+			return nil, err
+		}
+	}
+
+	items, err := parseCSS(b.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return itemsStylesheet(optimizeBlock(items)), nil
+}
+
+// optimizeBlock optimizes the declarations and rules of a block.
+func optimizeBlock(items []cssItem) []cssItem {
+	for i := range items {
+		if items[i].kind == ruleItem {
+			items[i].children = optimizeBlock(items[i].children)
+		}
+	}
+
+	items = optimizeDeclarations(items)
+	return mergeRules(items)
+}
+
+// optimizeDeclarations shortens values, removes overridden declarations and collapses
+// longhands into shorthands. Nested rules keep their position.
+func optimizeDeclarations(items []cssItem) []cssItem {
+	for i := range items {
+		if items[i].kind == declItem {
+			items[i].decl.Value = shortenValue(items[i].decl.Property, items[i].decl.Value)
+		}
+	}
+
+	return collapseShorthands(removeOverridden(items))
+}
+
+// removeOverridden removes declarations that never apply because a later declaration
+// in the block sets the same longhands with at least the same importance, and normal
+// declarations that follow an important one for the same longhands.
+func removeOverridden(items []cssItem) []cssItem {
+	removed := make([]bool, len(items))
+	for i, earlier := range items {
+		if earlier.kind != declItem {
+			continue
+		}
+		for j := i + 1; j < len(items) && !removed[i]; j++ {
+			later := items[j]
+			if later.kind != declItem || removed[j] {
+				continue
+			}
+
+			switch {
+			case overrides(later.decl.Property, earlier.decl.Property) && wins(later.decl, earlier.decl) && !isFallback(earlier.decl, later.decl):
+				removed[i] = true
+			case overrides(earlier.decl.Property, later.decl.Property) && !wins(later.decl, earlier.decl) && !isFallback(later.decl, earlier.decl):
+				removed[j] = true
+			}
+		}
+	}
+
+	kept := items[:0:0]
+	for i, item := range items {
+		if !removed[i] {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
+
+// collapseShorthands replaces the four longhands of a box shorthand with the shorthand,
+// when they have the same importance and the block does not otherwise set the shorthand.
+// The shorthand takes the position of the last longhand.
+func collapseShorthands(items []cssItem) []cssItem {
+	for _, shorthand := range boxShorthandOrder {
+		sides := boxShorthands[shorthand]
+		index := map[string]int{}
+		conflict := false
+		for i, item := range items {
+			if item.kind != declItem {
+				continue
+			}
+			switch p := item.decl.Property; {
+			case isSide(sides, p):
+				if _, dup := index[p]; dup {
+					conflict = true
+				}
+				index[p] = i
+			case intersects(p, shorthand):
+				conflict = true
+			}
+		}
+		if conflict || len(index) != 4 {
+			continue
+		}
+
+		var values [4]string
+		last := 0
+		important := items[index[sides[0]]].decl.Important
+		for s, side := range sides {
+			d := items[index[side]].decl
+			if d.Important != important || isGlobalKeyword(d.Value) || strings.Contains(d.Value, "var(") {
+				conflict = true
+			}
+			values[s] = d.Value
+			last = max(last, index[side])
+		}
+		if conflict {
+			continue
+		}
+
+		collapsed := make([]cssItem, 0, len(items)-3)
+		for i, item := range items {
+			switch {
+			case i == last:
+				collapsed = append(collapsed, cssItem{kind: declItem, decl: Declaration{
+					Property: shorthand, Value: collapseBox(values), Important: important,
+				}})
+			case item.kind == declItem && isSide(sides, item.decl.Property):
+			default:
+				collapsed = append(collapsed, item)
+			}
+		}
+		items = collapsed
+	}
+	return items
+}
+
+func isSide(sides [4]string, property string) bool {
+	for _, side := range sides {
+		if side == property {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeRules combines adjacent rules with the same selector or grouping at-rule prelude, and
+// merges adjacent style rules with identical declarations into one selector list.
+// Only rules without nested rules and with selectors every browser supports are merged into a list.
+// Empty rules are removed. Rules such as @font-face define one thing per block and are never combined.
+func mergeRules(items []cssItem) []cssItem {
+	var merged []cssItem
+	for _, item := range items {
+		if item.kind == ruleItem && len(item.children) == 0 {
+			continue
+		}
+		if len(merged) == 0 || item.kind != ruleItem || merged[len(merged)-1].kind != ruleItem {
+			merged = append(merged, item)
+			continue
+		}
+
+		prev := &merged[len(merged)-1]
+		atRule := strings.HasPrefix(item.prelude, "@")
+		name, _, _ := strings.Cut(item.prelude, " ")
+
+		switch {
+		case item.prelude == prev.prelude && (!atRule || groupingAtRules[name]):
+			children := append(append([]cssItem(nil), prev.children...), item.children...)
+			prev.children = optimizeBlock(children)
+		case !atRule && !strings.HasPrefix(prev.prelude, "@") && supportedSelector(item.prelude) && supportedSelector(prev.prelude) &&
+			onlyDeclarations(item.children) && onlyDeclarations(prev.children) &&
+			printCSS(item.children, RenderOptions{}) == printCSS(prev.children, RenderOptions{}):
+			prev.prelude += ", " + item.prelude
+		default:
+			merged = append(merged, item)
+		}
+	}
+	return merged
+}
+
+// supportedSelector reports whether every selector in a list is built from type, class and id
+// selectors, combinators and pseudo-classes or pseudo-elements every browser supports.
+// Browsers drop a whole selector list when one selector is unknown, so other selectors,
+// such as `:focus-visible` or `::-moz-selection`, must stay in rules of their own.
+func supportedSelector(selector string) bool {
+	if !simpleSelector.MatchString(selector) {
+		return false
+	}
+	for _, pseudo := range selectorPseudo.FindAllString(selector, -1) {
+		if !universalPseudos[strings.ToLower(pseudo)] {
+			return false
+		}
+	}
+	return true
+}
+
+// onlyDeclarations reports whether a block contains no nested rules.
+func onlyDeclarations(items []cssItem) bool {
+	for _, item := range items {
+		if item.kind != declItem {
+			return false
+		}
+	}
+	return true
+}
+
+const compoundSelector = `(\*|[a-zA-Z][a-zA-Z0-9-]*)?([.#][a-zA-Z_-][a-zA-Z0-9_-]*|::?[a-zA-Z-]+)*`
+
+var (
+	simpleSelector = regexp.MustCompile(`^(` + compoundSelector + `)(\s*[>+~,]\s*(` + compoundSelector + `)|\s+(` + compoundSelector + `))*$`)
+	selectorPseudo = regexp.MustCompile(`::?[a-zA-Z-]+`)
+
+	// universalPseudos are the pseudo-classes and pseudo-elements every browser supports.
+	universalPseudos = map[string]bool{
+		":hover": true, ":active": true, ":focus": true, ":link": true, ":visited": true, ":target": true,
+		":checked": true, ":disabled": true, ":enabled": true, ":root": true, ":empty": true,
+		":first-child": true, ":last-child": true, ":only-child": true,
+		":first-of-type": true, ":last-of-type": true, ":only-of-type": true,
+		":before": true, ":after": true, ":first-line": true, ":first-letter": true,
+		"::before": true, "::after": true, "::first-line": true, "::first-letter": true,
+	}
+
+	zeroLength  = regexp.MustCompile(`^[+-]?(0+\.?0*|\.0+)([a-zA-Z]+)$`)
+	lengthUnits = map[string]bool{
+		"px": true, "em": true, "rem": true, "ex": true, "ch": true, "cm": true, "mm": true, "q": true,
+		"in": true, "pt": true, "pc": true, "vw": true, "vh": true, "vmin": true, "vmax": true,
+		"cap": true, "ic": true, "lh": true, "rlh": true, "vi": true, "vb": true,
+		"svw": true, "svh": true, "lvw": true, "lvh": true, "dvw": true, "dvh": true,
+		"cqw": true, "cqh": true, "cqi": true, "cqb": true, "cqmin": true, "cqmax": true,
+	}
+
+	// shortestNames maps sRGB values to their shortest color name.
+	shortestNames = map[uint32]string{}
+)
+
+func init() {
+	for name, rgb := range namedColors {
+		current, ok := shortestNames[rgb]
+		if !ok || len(name) < len(current) || len(name) == len(current) && string(name) < current {
+			shortestNames[rgb] = string(name)
+		}
+	}
+}
+
+// colorProperty reports whether the value of a property may contain named colors.
+// Named colors are only rewritten in such properties, so that identifiers such as
+// animation or grid area names are never mistaken for colors.
+func colorProperty(property string) bool {
+	if strings.HasSuffix(property, "color") || strings.HasPrefix(property, "border") || strings.HasPrefix(property, "outline") {
+		return true
+	}
+	switch property {
+	case "background", "box-shadow", "text-shadow", "fill", "stroke", "column-rule", "text-decoration":
+		return true
+	}
+	return false
+}
+
+// shortenValue shortens the tokens of a declaration value. Strings and url() are left
+// untouched, and units are only dropped from zero lengths outside of functions such as calc().
+func shortenValue(property, value string) string {
+	if strings.HasPrefix(property, "--") {
+		return value
+	}
+
+	var b strings.Builder
+	depth := 0
+	for i := 0; i < len(value); {
+		c := value[i]
+		switch {
+		case c == '"' || c == '\'':
+			j := i + 1
+			for ; j < len(value) && value[j] != c; j++ {
+				if value[j] == '\\' {
+					j++
+				}
+			}
+			j = min(j+1, len(value))
+			b.WriteString(value[i:j])
+			i = j
+		case c == '(':
+			depth++
+			b.WriteByte(c)
+			i++
+		case c == ')':
+			depth--
+			b.WriteByte(c)
+			i++
+		case strings.IndexByte(" \t\n,/", c) >= 0:
+			b.WriteByte(c)
+			i++
+		default:
+			j := i
+			for j < len(value) && strings.IndexByte(" \t\n,/()\"'", value[j]) < 0 {
+				j++
+			}
+			token := value[i:j]
+			if j < len(value) && value[j] == '(' && strings.EqualFold(token, "url") {
+				end := strings.IndexByte(value[j:], ')')
+				if end < 0 {
+					end = len(value) - j - 1
+				}
+				b.WriteString(value[i : j+end+1])
+				i = j + end + 1
+				continue
+			}
+			if j < len(value) && value[j] == '(' {
+				b.WriteString(token)
+			} else {
+				b.WriteString(shortenToken(property, token, depth))
+			}
+			i = j
+		}
+	}
+	return b.String()
+}
+
+// shortenToken returns the shortest equivalent of a single value token.
+func shortenToken(property, token string, depth int) string {
+	if m := zeroLength.FindStringSubmatch(token); m != nil {
+		if depth == 0 && property != "flex" && lengthUnits[strings.ToLower(m[2])] {
+			return "0"
+		}
+		return token
+	}
+
+	rgb, ok := namedColors[Color(strings.ToLower(token))]
+	if ok && !colorProperty(property) {
+		return token
+	}
+	if !ok {
+		if len(token) != 7 && len(token) != 9 || token[0] != '#' {
+			return token
+		}
+		v, err := strconv.ParseUint(token[1:], 16, 32)
+		if err != nil {
+			return token
+		}
+		if len(token) == 9 {
+			return shortHex(strings.ToLower(token))
+		}
+		rgb = uint32(v)
+	}
+
+	shortest := shortHex(fmt.Sprintf("#%06x", rgb))
+	if name, ok := shortestNames[rgb]; ok && colorProperty(property) && len(name) < len(shortest) {
+		shortest = name
+	}
+	if len(shortest) < len(token) || len(shortest) == len(token) && shortest != token && token[0] == '#' {
+		return shortest
+	}
+	return token
+}
+
+// shortHex shortens a lowercase #rrggbb or #rrggbbaa color to #rgb or #rgba when possible.
+func shortHex(hex string) string {
+	var short strings.Builder
+	short.WriteByte('#')
+	for i := 1; i < len(hex); i += 2 {
+		if hex[i] != hex[i+1] {
+			return hex
+		}
+		short.WriteByte(hex[i])
+	}
+	return short.String()
+}
@@ -0,0 +1,123 @@
+package cssgo
+
+import (
+	"io"
+	"testing"
+)
+
+// raw creates a rule from literal CSS, for inputs the typed API cannot express.
+func raw(css string) RuleNodeFunc {
+	return RuleNodeFunc(func(w io.Writer) error {
+		_, err := w.Write([]byte(css))
+		return err
+	})
+}
+
+func TestOptimize(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []Node
+		want  string
+	}{
+		{
+			name:  "shorten hex colors",
+			input: []Node{Class("a").Props(BackgroundColor(Hex(0xffffff)), BorderColor1(Hex(0x112233)), TextColor(Hex(0x123456)))},
+			want:  ".a{background-color: #fff;border-color: #123;color: #123456;}",
+		},
+		{
+			name:  "prefer the shortest color spelling",
+			input: []Node{Class("a").Props(TextColor(Hex(0xff0000)), BackgroundColor(White), AccentColor(Fuchsia))},
+			want:  ".a{color: red;background-color: #fff;accent-color: #f0f;}",
+		},
+		{
+			name:  "named colors are only rewritten in color properties",
+			input: []Node{raw(".a{animation-name: white;grid-area: red;}")},
+			want:  ".a{animation-name: white;grid-area: red;}",
+		},
+		{
+			name:  "zero lengths",
+			input: []Node{Class("a").Props(Margin2(PX(0), REM(1.5)), Width(PCT(0))), raw(".b{width: calc(0px + 1em);flex: 1 1 0px;--gap: 0px;}")},
+			want:  ".a{margin: 0 1.5rem;width: 0%;}.b{width: calc(0px + 1em);flex: 1 1 0px;--gap: 0px;}",
+		},
+		{
+			name:  "strings and urls are untouched",
+			input: []Node{Class("a").Props(Content(Str("#ffffff 0px")), Prop("background", Url("#ffffff.png")))},
+			want:  `.a{content: "#ffffff 0px";background: url('#ffffff.png');}`,
+		},
+		{
+			name:  "remove overridden declarations",
+			input: []Node{Class("a").Props(Width(PX(1)), TextColor(Red), Width(PX(2)), PaddingLeft(PX(4)), Padding1(PX(8)))},
+			want:  ".a{color: red;width: 2px;padding: 8px;}",
+		},
+		{
+			name:  "important declarations win over later ones",
+			input: []Node{raw(".a{color: red !important;color: blue;width: 1px;width: 2px !important;}")},
+			want:  ".a{color: red !important;width: 2px !important;}",
+		},
+		{
+			name:  "keep fallbacks",
+			input: []Node{raw(".a{color: red;color: oklch(0.6 0.2 30);display: -webkit-box;display: flex;display: grid;}")},
+			want:  ".a{color: red;color: oklch(0.6 0.2 30);display: -webkit-box;display: grid;}",
+		},
+		{
+			name:  "collapse longhands",
+			input: []Node{Class("a").Props(PaddingTop(PX(1)), PaddingRight(PX(2)), TextColor(Red), PaddingBottom(PX(1)), PaddingLeft(PX(2)))},
+			want:  ".a{color: red;padding: 1px 2px;}",
+		},
+		{
+			name:  "do not collapse longhands of mixed importance or with keywords",
+			input: []Node{raw(".a{margin-top: 0;margin-right: 0;margin-bottom: 0;margin-left: 0 !important;}.b{padding-top: 0;padding-right: 0;padding-bottom: 0;padding-left: inherit;}")},
+			want:  ".a{margin-top: 0;margin-right: 0;margin-bottom: 0;margin-left: 0 !important;}.b{padding-top: 0;padding-right: 0;padding-bottom: 0;padding-left: inherit;}",
+		},
+		{
+			name: "merge rules with identical declarations",
+			input: []Node{
+				Class("a").Props(TextColor(Red)),
+				Class("b").Props(TextColor(Red)),
+				Class("c").Props(TextColor(Blue)),
+				Class("d").Props(TextColor(Red)),
+			},
+			want: ".a, .b{color: red;}.c{color: blue;}.d{color: red;}",
+		},
+		{
+			name: "combine adjacent rules with the same selector",
+			input: []Node{
+				Class("a").Props(TextColor(Red), Width(PX(1))),
+				Class("a").Props(TextColor(Blue)),
+				Media("(min-width: 600px)", Class("a").Props(Width(PX(2)))),
+				Media("(min-width: 600px)", Class("b").Props(Width(PX(2)))),
+			},
+			want: ".a{width: 1px;color: blue;}@media (min-width: 600px){.a, .b{width: 2px;}}",
+		},
+		{
+			name:  "keep vendor selectors and font faces apart",
+			input: []Node{raw("::-moz-selection{color: red;}::selection{color: red;}@font-face{font-family: a;}@font-face{font-family: b;}.e{}")},
+			want:  "::-moz-selection{color: red;}::selection{color: red;}@font-face{font-family: a;}@font-face{font-family: b;}",
+		},
+		{
+			name:  "keep selectors some browsers do not support apart",
+			input: []Node{raw(".a{color: red;}.b:focus-visible{color: red;}nav > a:hover{color: red;}")},
+			want:  ".a{color: red;}.b:focus-visible{color: red;}nav > a:hover{color: red;}",
+		},
+		{
+			name:  "merge rules with universally supported selectors",
+			input: []Node{raw("nav > a:hover{color: red;}#b::before{color: red;}")},
+			want:  "nav > a:hover, #b::before{color: red;}",
+		},
+		{
+			name:  "keep rules with nested rules apart",
+			input: []Node{raw(".a{&:hover{color: red;}}#b{&:hover{color: red;}}")},
+			want:  ".a{&:hover{color: red;}}#b{&:hover{color: red;}}",
+		},
+	}
+
+	for _, test := range tests {
+		sheet, err := Optimize(test.input...)
+		if err != nil {
+			t.Fatalf("TESTCASE %s: unexpected error: %v", test.name, err)
+		}
+		if got := sheet.String(); got != test.want {
+			t.Fatalf("TESTCASE %s: FAIL\ngot: %s != want: %s", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package cssgo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrParse is returned when rendered CSS cannot be parsed back into declarations and rules.
+var ErrParse = errors.New("cssgo: cannot parse css")
+
+// itemKind identifies the kind of a parsed CSS item.
+type itemKind int
+
+const (
+	declItem      itemKind = iota // a declaration, e.g. `color: red;`
+	ruleItem                      // a rule with a block, e.g. `.foo{...}` or `@media ...{...}`
+	statementItem                 // an at-rule without a block, e.g. `@import url('a.css');`
+)
+
+// cssItem is a node of parsed CSS. Rules keep their selector or at-rule prelude
+// verbatim and hold their declarations and nested rules as children.
+type cssItem struct {
+	kind     itemKind
+	decl     Declaration
+	prelude  string
+	children []cssItem
+}
+
+// parseCSS parses rendered CSS into items. It understands style rules, at-rules,
+// nested rules and bare declarations, which is what nodes of this package render.
+// Strings, parentheses and comments are skipped over when looking for delimiters.
+func parseCSS(css string) ([]cssItem, error) {
+	p := parser{src: css}
+	items, err := p.block(false)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+type parser struct {
+	src string
+	pos int
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: %s at offset %d", ErrParse, fmt.Sprintf(format, args...), p.pos)
+}
+
+// block parses items until the end of the input or, when nested, until the closing brace.
+func (p *parser) block(nested bool) ([]cssItem, error) {
+	var items []cssItem
+	var buf strings.Builder
+	depth := 0
+
+	flush := func() error {
+		text := strings.TrimSpace(buf.String())
+		buf.Reset()
+		if text == "" {
+			return nil
+		}
+		if strings.HasPrefix(text, "@") {
+			items = append(items, cssItem{kind: statementItem, prelude: text})
+			return nil
+		}
+		decl, err := parseDeclaration(text)
+		if err != nil {
+			return err
+		}
+		items = append(items, cssItem{kind: declItem, decl: decl})
+		return nil
+	}
+
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case c == '"' || c == '\'':
+			start := p.pos
+			if err := p.skipString(c); err != nil {
+				return nil, err
+			}
+			buf.WriteString(p.src[start:p.pos])
+			continue
+		case c == '\\' && p.pos+1 < len(p.src):
+			buf.WriteString(p.src[p.pos : p.pos+2])
+			p.pos += 2
+			continue
+		case c == '/' && strings.HasPrefix(p.src[p.pos:], "/*"):
+			end := strings.Index(p.src[p.pos+2:], "*/")
+			if end < 0 {
+				return nil, p.errorf("unterminated comment")
+			}
+			p.pos += end + 4
+			continue
+		case c == '(' || c == '[':
+			depth++
+		case c == ')' || c == ']':
+			depth--
+		case depth > 0:
+		case c == ';':
+			p.pos++
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			continue
+		case c == '{':
+			prelude := strings.TrimSpace(buf.String())
+			buf.Reset()
+			p.pos++
+			children, err := p.block(true)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, cssItem{kind: ruleItem, prelude: prelude, children: children})
+			continue
+		case c == '}':
+			if !nested {
+				return nil, p.errorf("unexpected '}'")
+			}
+			p.pos++
+			return items, flush()
+		}
+		buf.WriteByte(c)
+		p.pos++
+	}
+
+	if nested {
+		return nil, p.errorf("missing '}'")
+	}
+	return items, flush()
+}
+
+// skipString advances past a string delimited by quote, honouring escapes.
+func (p *parser) skipString(quote byte) error {
+	for p.pos++; p.pos < len(p.src); p.pos++ {
+		switch p.src[p.pos] {
+		case '\\':
+			p.pos++
+		case quote:
+			p.pos++
+			return nil
+		}
+	}
+	return p.errorf("unterminated string")
+}
+
+// parseDeclaration parses the text of a single declaration without its semicolon.
+func parseDeclaration(text string) (Declaration, error) {
+	name, value, found := strings.Cut(text, ":")
+	name = strings.TrimSpace(name)
+	if !found || name == "" || strings.ContainsAny(name, " \t\n") {
+		return Declaration{}, fmt.Errorf("%w: invalid declaration %q", ErrParse, text)
+	}
+
+	value = strings.TrimSpace(value)
+	important := false
+	if i := strings.LastIndexByte(value, '!'); i >= 0 && strings.EqualFold(strings.TrimSpace(value[i+1:]), "important") {
+		value = strings.TrimSpace(value[:i])
+		important = true
+	}
+
+	return Declaration{Property: name, Value: value, Important: important}, nil
+}
@@ -0,0 +1,403 @@
+package cssgo
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidQuery is returned when a browser target query cannot be parsed.
+var ErrInvalidQuery = errors.New("cssgo: invalid browser query")
+
+// compatJSON is a vendored compatibility table, so that prefixing works offline
+// and produces the same output on every machine.
+//
+//go:embed data/compat.json
+var compatJSON []byte
+
+// support maps browsers to the first version that supports a feature, or its unprefixed form.
+// Older versions need the prefixed or downleveled form; browsers missing from the map never
+// need it, and 9999 marks browsers that do not support the feature yet.
+type support map[string]float64
+
+// compatTable is the schema of data/compat.json.
+type compatTable struct {
+	// Browsers lists the known versions of each browser, oldest first.
+	Browsers   map[string][]float64 `json:"browsers"`
+	FirefoxESR []float64            `json:"firefoxESR"`
+	// Properties, Selectors and AtRules map standard names to their prefixed forms.
+	Properties map[string]map[string]support `json:"properties"`
+	Values     []valueCompat                 `json:"values"`
+	Selectors  map[string]map[string]support `json:"selectors"`
+	AtRules    map[string]map[string]support `json:"atRules"`
+	// Features maps modern syntax to the versions supporting it, for Downlevel.
+	Features map[string]support `json:"features"`
+}
+
+// valueCompat describes a value keyword or function that needs a prefix in some properties.
+type valueCompat struct {
+	Properties []string           `json:"properties"`
+	Value      string             `json:"value"`
+	Prefixed   map[string]support `json:"prefixed"`
+}
+
+var compat compatTable
+
+func init() {
+	if err := json.Unmarshal(compatJSON, &compat); err != nil {
+		panic(fmt.Sprintf("cssgo: invalid compatibility table: %v", err))
+	}
+}
+
+// Target is a browser version that the generated CSS must support.
+type Target struct {
+	Browser string
+	Version float64
+}
+
+// Targets is a list of browser versions, usually created with ParseTargets.
+type Targets []Target
+
+// browserAliases maps the browser names accepted in queries to the names of the compatibility table.
+var browserAliases = map[string]string{
+	"chrome": "chrome", "edge": "edge", "firefox": "firefox", "ff": "firefox",
+	"safari": "safari", "ios_saf": "ios_saf", "ios": "ios_saf", "ios_safari": "ios_saf",
+}
+
+// ParseTargets evaluates a Browserslist-style query against the vendored compatibility table.
+// Queries are separated by commas or `or`, and the following forms are supported:
+//   - `safari >= 12`, `chrome > 100`, `firefox <= 115`, `ios_saf 15.4`, `safari 12-14`
+//   - `last 2 versions`, `last 2 chrome versions`
+//   - `firefox esr`
+//   - `defaults`, which is `last 2 versions, firefox esr`
+//   - `not <query>`, which removes versions selected by the previous queries
+//
+// Usage based queries, such as `> 0.5%`, are not supported since they need usage data.
+// Example: ParseTargets("safari >= 12, ios_saf >= 12, last 2 chrome versions")
+func ParseTargets(query string) (Targets, error) {
+	selected := map[Target]bool{}
+
+	for _, q := range splitQuery(query) {
+		remove := false
+		if rest, ok := strings.CutPrefix(q, "not "); ok {
+			q, remove = strings.TrimSpace(rest), true
+		}
+
+		targets, err := evalQuery(q)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range targets {
+			selected[t] = !remove
+		}
+	}
+
+	var targets Targets
+	for t, ok := range selected {
+		if ok {
+			targets = append(targets, t)
+		}
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		if targets[i].Browser != targets[j].Browser {
+			return targets[i].Browser < targets[j].Browser
+		}
+		return targets[i].Version < targets[j].Version
+	})
+	return targets, nil
+}
+
+// splitQuery splits a query on commas and `or`, lowercasing the parts.
+func splitQuery(query string) []string {
+	var parts []string
+	for _, part := range strings.Split(strings.ToLower(query), ",") {
+		for _, q := range strings.Split(part, " or ") {
+			if q = strings.Join(strings.Fields(q), " "); q != "" {
+				parts = append(parts, q)
+			}
+		}
+	}
+	return parts
+}
+
+// evalQuery evaluates a single query without `not`.
+func evalQuery(q string) (Targets, error) {
+	invalid := func(reason string) error {
+		return fmt.Errorf("%w: %q: %s", ErrInvalidQuery, q, reason)
+	}
+
+	fields := strings.Fields(q)
+	switch {
+	case q == "defaults":
+		last, _ := evalQuery("last 2 versions")
+		esr, _ := evalQuery("firefox esr")
+		return append(last, esr...), nil
+
+	case fields[0] == "last" && len(fields) >= 3 && strings.HasPrefix(fields[len(fields)-1], "version"):
+		n, err := strconv.Atoi(fields[1])
+		if err != nil || n < 1 || len(fields) > 4 {
+			return nil, invalid("expected `last N versions` or `last N <browser> versions`")
+		}
+
+		browsers := make([]string, 0, len(compat.Browsers))
+		if len(fields) == 4 {
+			browser, ok := browserAliases[fields[2]]
+			if !ok {
+				return nil, invalid("unknown browser " + fields[2])
+			}
+			browsers = append(browsers, browser)
+		} else {
+			for browser := range compat.Browsers {
+				browsers = append(browsers, browser)
+			}
+		}
+
+		var targets Targets
+		for _, browser := range browsers {
+			versions := compat.Browsers[browser]
+			for _, v := range versions[max(0, len(versions)-n):] {
+				targets = append(targets, Target{browser, v})
+			}
+		}
+		return targets, nil
+	}
+
+	browser, ok := browserAliases[fields[0]]
+	if !ok {
+		return nil, invalid("unknown browser " + fields[0])
+	}
+	versions := compat.Browsers[browser]
+
+	selectVersions := func(keep func(v float64) bool) Targets {
+		var targets Targets
+		for _, v := range versions {
+			if keep(v) {
+				targets = append(targets, Target{browser, v})
+			}
+		}
+		return targets
+	}
+
+	switch {
+	case len(fields) == 2 && fields[1] == "esr":
+		if browser != "firefox" {
+			return nil, invalid("only firefox has esr versions")
+		}
+		targets := Targets{}
+		for _, v := range compat.FirefoxESR {
+			targets = append(targets, Target{browser, v})
+		}
+		return targets, nil
+
+	case len(fields) == 3:
+		v, err := strconv.ParseFloat(fields[2], 64)
+		if err != nil {
+			return nil, invalid("invalid version " + fields[2])
+		}
+		compare := map[string]func(float64) bool{
+			">=": func(x float64) bool { return x >= v },
+			">":  func(x float64) bool { return x > v },
+			"<=": func(x float64) bool { return x <= v },
+			"<":  func(x float64) bool { return x < v },
+		}[fields[1]]
+		if compare == nil {
+			return nil, invalid("unknown operator " + fields[1])
+		}
+		return selectVersions(compare), nil
+
+	case len(fields) == 2:
+		from, to, isRange := strings.Cut(fields[1], "-")
+		if !isRange {
+			to = from
+		}
+		lo, err1 := strconv.ParseFloat(from, 64)
+		hi, err2 := strconv.ParseFloat(to, 64)
+		if err1 != nil || err2 != nil {
+			return nil, invalid("invalid version " + fields[1])
+		}
+		targets := selectVersions(func(x float64) bool { return x >= lo && x <= hi })
+		if len(targets) == 0 {
+			return nil, invalid("unknown version " + fields[1])
+		}
+		return targets, nil
+	}
+
+	return nil, invalid("unsupported query")
+}
+
+// needs reports whether any of the targets is older than the versions in s.
+func (targets Targets) needs(s support) bool {
+	for _, t := range targets {
+		if v, ok := s[t.Browser]; ok && t.Version < v {
+			return true
+		}
+	}
+	return false
+}
+
+// prefixed returns the prefixed forms of a feature that the targets need, sorted.
+func (targets Targets) prefixed(forms map[string]support) []string {
+	var names []string
+	for name, s := range forms {
+		if targets.needs(s) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Prefix returns the nodes as a stylesheet with the vendor-prefixed properties, values,
+// pseudo-classes, pseudo-elements and at-rules that the targets need, according to the
+// vendored compatibility table. Prefixed declarations are inserted before the standard ones,
+// prefixed selectors and at-rules get rules of their own, and prefixed forms that are
+// already present, such as hand-written `-webkit-` properties, are not duplicated.
+// Example:
+//
+//	targets, _ := ParseTargets("safari >= 12")
+//	Prefix(targets, Class("a").Props(Declaration{Property: "user-select", Value: "none"}))
+//	-> `.a{-webkit-user-select: none;user-select: none;}`
+func Prefix(targets Targets, nodes ...Node) (Stylesheet, error) {
+	var b strings.Builder
+	for _, node := range nodes {
+		if err := node.RenderCSS(&b); err != nil {
+			return nil, err
+		}
+	}
+
+	items, err := parseCSS(b.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return itemsStylesheet(targets.prefixItems(items)), nil
+}
+
+// prefixItems adds the prefixed forms of the items of a block.
+func (targets Targets) prefixItems(items []cssItem) []cssItem {
+	present := map[Declaration]bool{}
+	for _, item := range items {
+		if item.kind == declItem {
+			present[item.decl] = true
+		}
+	}
+
+	var out []cssItem
+	for _, item := range items {
+		switch {
+		case item.kind == declItem:
+			for _, decl := range targets.prefixDeclaration(item.decl) {
+				if !present[decl] {
+					present[decl] = true
+					out = append(out, cssItem{kind: declItem, decl: decl})
+				}
+			}
+			out = append(out, item)
+
+		case item.kind == ruleItem && strings.HasPrefix(item.prelude, "@"):
+			item.children = targets.prefixItems(item.children)
+			for name, forms := range compat.AtRules {
+				rest, ok := strings.CutPrefix(item.prelude, name)
+				if !ok || rest != "" && rest[0] != ' ' {
+					continue
+				}
+				for _, form := range targets.prefixed(forms) {
+					out = append(out, cssItem{kind: ruleItem, prelude: form + rest, children: item.children})
+				}
+			}
+			out = append(out, item)
+
+		case item.kind == ruleItem:
+			item.children = targets.prefixItems(item.children)
+			out = append(out, targets.prefixSelectors(item)...)
+			out = append(out, item)
+
+		default:
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// prefixDeclaration returns the prefixed declarations to insert before a declaration.
+func (targets Targets) prefixDeclaration(d Declaration) []Declaration {
+	var decls []Declaration
+	for _, name := range targets.prefixed(compat.Properties[d.Property]) {
+		decls = append(decls, Declaration{Property: name, Value: d.Value, Important: d.Important})
+	}
+
+	for _, v := range compat.Values {
+		if !slices.Contains(v.Properties, d.Property) {
+			continue
+		}
+		for _, form := range targets.prefixed(v.Prefixed) {
+			if value, ok := replaceWord(d.Value, v.Value, form); ok {
+				decls = append(decls, Declaration{Property: d.Property, Value: value, Important: d.Important})
+			}
+		}
+	}
+	return decls
+}
+
+// prefixSelectors returns copies of a style rule for the prefixed forms of its pseudo-classes
+// and pseudo-elements. Only the selectors of the list using the pseudo are copied, since
+// browsers drop a whole selector list when they do not know one of its selectors.
+func (targets Targets) prefixSelectors(rule cssItem) []cssItem {
+	pseudos := make([]string, 0, len(compat.Selectors))
+	for pseudo := range compat.Selectors {
+		pseudos = append(pseudos, pseudo)
+	}
+	sort.Strings(pseudos)
+
+	var rules []cssItem
+	for _, pseudo := range pseudos {
+		for _, form := range targets.prefixed(compat.Selectors[pseudo]) {
+			var selectors []string
+			for _, s := range splitSelectorList(rule.prelude) {
+				if prefixed, ok := replaceWord(s, pseudo, form); ok {
+					selectors = append(selectors, prefixed)
+				}
+			}
+			if len(selectors) > 0 {
+				rules = append(rules, cssItem{kind: ruleItem, prelude: strings.Join(selectors, ", "), children: rule.children})
+			}
+		}
+	}
+	return rules
+}
+
+// replaceWord replaces the occurrences of word in s that are outside of strings and not
+// part of a longer identifier, reporting whether any were found.
+func replaceWord(s, word, replacement string) (string, bool) {
+	var b strings.Builder
+	var quote byte
+	found := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && i+1 < len(s) {
+				b.WriteByte(c)
+				i++
+				c = s[i]
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case strings.HasPrefix(s[i:], word) && (i == 0 || !isIdentByte(s[i-1]) || !isIdentByte(word[0])) &&
+			(i+len(word) == len(s) || !isIdentByte(s[i+len(word)])):
+			b.WriteString(replacement)
+			i += len(word) - 1
+			found = true
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return b.String(), found
+}
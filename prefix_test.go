@@ -0,0 +1,107 @@
+package cssgo
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseTargets(t *testing.T) {
+	tests := []struct {
+		query string
+		want  Targets
+	}{
+		{"safari >= 17.5", Targets{{"safari", 17.5}, {"safari", 17.6}, {"safari", 18.0}, {"safari", 18.1}}},
+		{"Safari > 18 or ios 12", Targets{{"ios_saf", 12}, {"safari", 18.1}}},
+		{"last 2 chrome versions, firefox esr", Targets{{"chrome", 130}, {"chrome", 131}, {"firefox", 115}, {"firefox", 128}}},
+		{"safari 15.4-15.6, not safari 15.5", Targets{{"safari", 15.4}, {"safari", 15.6}}},
+	}
+
+	for _, test := range tests {
+		got, err := ParseTargets(test.query)
+		if err != nil {
+			t.Fatalf("TESTCASE %s: unexpected error: %v", test.query, err)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Fatalf("TESTCASE %s: FAIL\ngot: %v != want: %v", test.query, got, test.want)
+		}
+	}
+
+	if defaults, err := ParseTargets("defaults"); err != nil || len(defaults) != 2*len(compat.Browsers)+2 {
+		t.Fatalf("unexpected defaults: %v, %v", defaults, err)
+	}
+
+	for _, query := range []string{"> 0.5%", "opera 12", "safari 3", "chrome esr", "last x versions", "safari ~ 12"} {
+		if _, err := ParseTargets(query); !errors.Is(err, ErrInvalidQuery) {
+			t.Fatalf("TESTCASE %s: expected ErrInvalidQuery, got %v", query, err)
+		}
+	}
+}
+
+func TestPrefix(t *testing.T) {
+	oldSafari, _ := ParseTargets("safari >= 12, ios_saf >= 12")
+	modern, _ := ParseTargets("last 1 chrome versions, last 1 firefox versions")
+	oldFirefox, _ := ParseTargets("firefox 60")
+	safari10, _ := ParseTargets("safari 10")
+
+	tests := []struct {
+		name    string
+		targets Targets
+		input   []Node
+		want    string
+	}{
+		{
+			name:    "properties",
+			targets: oldSafari,
+			input:   []Node{Class("a").Props(Prop("user-select", goType("none")), Prop("appearance", goType("none")).Important(), TextColor(Red))},
+			want: ".a{-webkit-user-select: none;user-select: none;" +
+				"-webkit-appearance: none !important;appearance: none !important;color: red;}",
+		},
+		{
+			name:    "hand-written prefixes are not duplicated",
+			targets: oldSafari,
+			input:   []Node{Class("a").Props(Prop("-webkit-backdrop-filter", Str("x")), Prop("backdrop-filter", Str("x")))},
+			want:    `.a{-webkit-backdrop-filter: "x";backdrop-filter: "x";}`,
+		},
+		{
+			name:    "values",
+			targets: oldSafari,
+			input:   []Node{Class("a").Props(Prop("position", Str("a sticky")), Prop("position", goType("sticky")))},
+			want:    `.a{position: "a sticky";position: -webkit-sticky;position: sticky;}`,
+		},
+		{
+			name:    "modern targets need nothing",
+			targets: modern,
+			input:   []Node{Class("a").Props(Prop("user-select", goType("none")), Prop("position", goType("sticky")))},
+			want:    "",
+		},
+		{
+			name:    "pseudo-elements",
+			targets: safari10,
+			input:   []Node{Class("a").Or(El("input::placeholder")).Props(TextColor(Gray))},
+			want:    "input::-webkit-input-placeholder{color: gray;}.a, input::placeholder{color: gray;}",
+		},
+		{
+			name:    "keyframes and nested rules",
+			targets: oldFirefox,
+			input: []Node{Media("(min-width: 600px)", raw("@keyframes spin{to{transform: rotate(1turn);}}"),
+				raw("::selection{color: red;}"))},
+			want: "@media (min-width: 600px){@keyframes spin{to{transform: rotate(1turn);}}" +
+				"::-moz-selection{color: red;}::selection{color: red;}}",
+		},
+	}
+
+	for _, test := range tests {
+		sheet, err := Prefix(test.targets, test.input...)
+		if err != nil {
+			t.Fatalf("TESTCASE %s: unexpected error: %v", test.name, err)
+		}
+		want := test.want
+		if want == "" {
+			want, _ = render(Stylesheet{test.input[0].(RuleNode)})
+		}
+		if got := sheet.String(); got != want {
+			t.Fatalf("TESTCASE %s: FAIL\ngot: %s != want: %s", test.name, got, want)
+		}
+	}
+}
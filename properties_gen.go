@@ -0,0 +1,13277 @@
+// Code generated by genprops from data/properties.json; DO NOT EDIT.
+
+package cssgo
+
+import "io"
+
+// AlignContentValue is implemented by the values of the "align-content" property.
+type AlignContentValue interface {
+	ValueNode
+	alignContentValue()
+}
+
+// AlignContentKeyword represents a keyword of the "align-content" property.
+type AlignContentKeyword string
+
+func (k AlignContentKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k AlignContentKeyword) valueNode()         {}
+func (k AlignContentKeyword) alignContentValue() {}
+
+// AlignContentKeyword also implements the value interfaces of the shorthands of "align-content".
+func (k AlignContentKeyword) placeContentValue() {}
+
+// AlignContentKeyword value constants.
+const (
+	AlignContentNormal       AlignContentKeyword = "normal"
+	AlignContentBaseline     AlignContentKeyword = "baseline"
+	AlignContentSpaceBetween AlignContentKeyword = "space-between"
+	AlignContentSpaceAround  AlignContentKeyword = "space-around"
+	AlignContentSpaceEvenly  AlignContentKeyword = "space-evenly"
+	AlignContentStretch      AlignContentKeyword = "stretch"
+	AlignContentCenter       AlignContentKeyword = "center"
+	AlignContentStart        AlignContentKeyword = "start"
+	AlignContentEnd          AlignContentKeyword = "end"
+	AlignContentFlexStart    AlignContentKeyword = "flex-start"
+	AlignContentFlexEnd      AlignContentKeyword = "flex-end"
+)
+
+// AlignContent creates an "align-content" property.
+// Syntax: normal | <baseline-position> | <content-distribution> | <overflow-position>? <content-position>
+// Example: AlignContent(AlignContentNormal) -> "align-content: normal;"
+func AlignContent(values ...AlignContentValue) Property {
+	return Prop("align-content", valueNodes(values)...)
+}
+
+// AlignItemsValue is implemented by the values of the "align-items" property.
+type AlignItemsValue interface {
+	ValueNode
+	alignItemsValue()
+}
+
+// AlignItemsKeyword represents a keyword of the "align-items" property.
+type AlignItemsKeyword string
+
+func (k AlignItemsKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k AlignItemsKeyword) valueNode()       {}
+func (k AlignItemsKeyword) alignItemsValue() {}
+
+// AlignItemsKeyword also implements the value interfaces of the shorthands of "align-items".
+func (k AlignItemsKeyword) placeItemsValue() {}
+
+// AlignItemsKeyword value constants.
+const (
+	AlignItemsNormal    AlignItemsKeyword = "normal"
+	AlignItemsStretch   AlignItemsKeyword = "stretch"
+	AlignItemsBaseline  AlignItemsKeyword = "baseline"
+	AlignItemsCenter    AlignItemsKeyword = "center"
+	AlignItemsStart     AlignItemsKeyword = "start"
+	AlignItemsEnd       AlignItemsKeyword = "end"
+	AlignItemsSelfStart AlignItemsKeyword = "self-start"
+	AlignItemsSelfEnd   AlignItemsKeyword = "self-end"
+	AlignItemsFlexStart AlignItemsKeyword = "flex-start"
+	AlignItemsFlexEnd   AlignItemsKeyword = "flex-end"
+)
+
+// AlignItems creates an "align-items" property.
+// Syntax: normal | stretch | <baseline-position> | [ <overflow-position>? <self-position> ]
+// Example: AlignItems(AlignItemsNormal) -> "align-items: normal;"
+func AlignItems(values ...AlignItemsValue) Property {
+	return Prop("align-items", valueNodes(values)...)
+}
+
+// AlignSelfValue is implemented by the values of the "align-self" property.
+type AlignSelfValue interface {
+	ValueNode
+	alignSelfValue()
+}
+
+// AlignSelfKeyword represents a keyword of the "align-self" property.
+type AlignSelfKeyword string
+
+func (k AlignSelfKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k AlignSelfKeyword) valueNode()      {}
+func (k AlignSelfKeyword) alignSelfValue() {}
+
+// AlignSelfKeyword also implements the value interfaces of the shorthands of "align-self".
+func (k AlignSelfKeyword) placeSelfValue() {}
+
+// AlignSelfKeyword value constants.
+const (
+	AlignSelfNormal    AlignSelfKeyword = "normal"
+	AlignSelfStretch   AlignSelfKeyword = "stretch"
+	AlignSelfBaseline  AlignSelfKeyword = "baseline"
+	AlignSelfCenter    AlignSelfKeyword = "center"
+	AlignSelfStart     AlignSelfKeyword = "start"
+	AlignSelfEnd       AlignSelfKeyword = "end"
+	AlignSelfSelfStart AlignSelfKeyword = "self-start"
+	AlignSelfSelfEnd   AlignSelfKeyword = "self-end"
+	AlignSelfFlexStart AlignSelfKeyword = "flex-start"
+	AlignSelfFlexEnd   AlignSelfKeyword = "flex-end"
+)
+
+// AlignSelf creates an "align-self" property.
+// Syntax: auto | normal | stretch | <baseline-position> | <overflow-position>? <self-position>
+// Example: AlignSelf(AlignSelfNormal) -> "align-self: normal;"
+func AlignSelf(values ...AlignSelfValue) Property {
+	return Prop("align-self", valueNodes(values)...)
+}
+
+// AlignmentBaselineValue is implemented by the values of the "alignment-baseline" property.
+type AlignmentBaselineValue interface {
+	ValueNode
+	alignmentBaselineValue()
+}
+
+// AlignmentBaselineKeyword represents a keyword of the "alignment-baseline" property.
+type AlignmentBaselineKeyword string
+
+func (k AlignmentBaselineKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k AlignmentBaselineKeyword) valueNode()              {}
+func (k AlignmentBaselineKeyword) alignmentBaselineValue() {}
+
+// AlignmentBaselineKeyword value constants.
+const (
+	AlignmentBaselineBaseline       AlignmentBaselineKeyword = "baseline"
+	AlignmentBaselineAlphabetic     AlignmentBaselineKeyword = "alphabetic"
+	AlignmentBaselineIdeographic    AlignmentBaselineKeyword = "ideographic"
+	AlignmentBaselineMiddle         AlignmentBaselineKeyword = "middle"
+	AlignmentBaselineCentral        AlignmentBaselineKeyword = "central"
+	AlignmentBaselineMathematical   AlignmentBaselineKeyword = "mathematical"
+	AlignmentBaselineTextBeforeEdge AlignmentBaselineKeyword = "text-before-edge"
+	AlignmentBaselineTextAfterEdge  AlignmentBaselineKeyword = "text-after-edge"
+)
+
+// AlignmentBaseline creates an "alignment-baseline" property.
+// Syntax: baseline | alphabetic | ideographic | middle | central | mathematical | text-before-edge | text-after-edge
+// Example: AlignmentBaseline(AlignmentBaselineBaseline) -> "alignment-baseline: baseline;"
+func AlignmentBaseline(value AlignmentBaselineValue) Property {
+	return Prop("alignment-baseline", value)
+}
+
+// AllValue is implemented by the values of the "all" property.
+type AllValue interface {
+	ValueNode
+	allValue()
+}
+
+// All creates an "all" property.
+// Syntax: initial | inherit | unset | revert | revert-layer
+// Example: All(Inherit) -> "all: inherit;"
+func All(value AllValue) Property {
+	return Prop("all", value)
+}
+
+// AnchorNameValue is implemented by the values of the "anchor-name" property.
+type AnchorNameValue interface {
+	ValueNode
+	anchorNameValue()
+}
+
+// AnchorNameKeyword represents a keyword of the "anchor-name" property.
+type AnchorNameKeyword string
+
+func (k AnchorNameKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k AnchorNameKeyword) valueNode()       {}
+func (k AnchorNameKeyword) anchorNameValue() {}
+
+// AnchorNameKeyword value constants.
+const (
+	AnchorNameNone AnchorNameKeyword = "none"
+)
+
+// AnchorName creates an "anchor-name" property.
+// Syntax: none | <dashed-ident>#
+// Example: AnchorName(Ident("--a")) -> "anchor-name: --a;"
+func AnchorName(values ...AnchorNameValue) Property {
+	return commaProp("anchor-name", valueNodes(values)...)
+}
+
+// AnchorScopeValue is implemented by the values of the "anchor-scope" property.
+type AnchorScopeValue interface {
+	ValueNode
+	anchorScopeValue()
+}
+
+// AnchorScopeKeyword represents a keyword of the "anchor-scope" property.
+type AnchorScopeKeyword string
+
+func (k AnchorScopeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k AnchorScopeKeyword) valueNode()        {}
+func (k AnchorScopeKeyword) anchorScopeValue() {}
+
+// AnchorScopeKeyword value constants.
+const (
+	AnchorScopeNone AnchorScopeKeyword = "none"
+	AnchorScopeAll  AnchorScopeKeyword = "all"
+)
+
+// AnchorScope creates an "anchor-scope" property.
+// Syntax: none | all | <dashed-ident>#
+// Example: AnchorScope(Ident("--a")) -> "anchor-scope: --a;"
+func AnchorScope(values ...AnchorScopeValue) Property {
+	return commaProp("anchor-scope", valueNodes(values)...)
+}
+
+// AnimationValue is implemented by the values of the "animation" property.
+type AnimationValue interface {
+	ValueNode
+	animationValue()
+}
+
+// Animation creates an "animation" property.
+// Syntax: <single-animation>#
+// Example: Animation(Ident("fade"), S(1), AnimationIterationCountInfinite) -> "animation: fade 1s infinite;"
+// It sets a single layer; use AnimationLayers for several.
+func Animation(values ...AnimationValue) Property {
+	return Prop("animation", valueNodes(values)...)
+}
+
+// AnimationLayers creates an "animation" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: AnimationLayers([]AnimationValue{Ident("fade"), S(1), AnimationIterationCountInfinite}, []AnimationValue{Ident("fade"), S(1), AnimationIterationCountInfinite}) -> "animation: fade 1s infinite, fade 1s infinite;"
+func AnimationLayers(layers ...[]AnimationValue) Property {
+	return commaProp("animation", layerNodes(layers)...)
+}
+
+// AnimationCompositionValue is implemented by the values of the "animation-composition" property.
+type AnimationCompositionValue interface {
+	ValueNode
+	animationCompositionValue()
+}
+
+// AnimationCompositionKeyword represents a keyword of the "animation-composition" property.
+type AnimationCompositionKeyword string
+
+func (k AnimationCompositionKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k AnimationCompositionKeyword) valueNode()                 {}
+func (k AnimationCompositionKeyword) animationCompositionValue() {}
+
+// AnimationCompositionKeyword value constants.
+const (
+	AnimationCompositionReplace    AnimationCompositionKeyword = "replace"
+	AnimationCompositionAdd        AnimationCompositionKeyword = "add"
+	AnimationCompositionAccumulate AnimationCompositionKeyword = "accumulate"
+)
+
+// AnimationComposition creates an "animation-composition" property.
+// Syntax: <single-animation-composition>#
+// Example: AnimationComposition(AnimationCompositionReplace) -> "animation-composition: replace;"
+func AnimationComposition(values ...AnimationCompositionValue) Property {
+	return commaProp("animation-composition", valueNodes(values)...)
+}
+
+// AnimationDelayValue is implemented by the values of the "animation-delay" property.
+type AnimationDelayValue interface {
+	ValueNode
+	animationDelayValue()
+}
+
+// AnimationDelay creates an "animation-delay" property.
+// Syntax: <time>#
+// Example: AnimationDelay(MS(200)) -> "animation-delay: 200ms;"
+func AnimationDelay(values ...AnimationDelayValue) Property {
+	return commaProp("animation-delay", valueNodes(values)...)
+}
+
+// AnimationDirectionValue is implemented by the values of the "animation-direction" property.
+type AnimationDirectionValue interface {
+	ValueNode
+	animationDirectionValue()
+}
+
+// AnimationDirectionKeyword represents a keyword of the "animation-direction" property.
+type AnimationDirectionKeyword string
+
+func (k AnimationDirectionKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k AnimationDirectionKeyword) valueNode()               {}
+func (k AnimationDirectionKeyword) animationDirectionValue() {}
+
+// AnimationDirectionKeyword also implements the value interfaces of the shorthands of "animation-direction".
+func (k AnimationDirectionKeyword) animationValue() {}
+
+// AnimationDirectionKeyword value constants.
+const (
+	AnimationDirectionNormal           AnimationDirectionKeyword = "normal"
+	AnimationDirectionReverse          AnimationDirectionKeyword = "reverse"
+	AnimationDirectionAlternate        AnimationDirectionKeyword = "alternate"
+	AnimationDirectionAlternateReverse AnimationDirectionKeyword = "alternate-reverse"
+)
+
+// AnimationDirection creates an "animation-direction" property.
+// Syntax: <single-animation-direction>#
+// Example: AnimationDirection(AnimationDirectionNormal) -> "animation-direction: normal;"
+func AnimationDirection(values ...AnimationDirectionValue) Property {
+	return commaProp("animation-direction", valueNodes(values)...)
+}
+
+// AnimationDurationValue is implemented by the values of the "animation-duration" property.
+type AnimationDurationValue interface {
+	ValueNode
+	animationDurationValue()
+}
+
+// AnimationDuration creates an "animation-duration" property.
+// Syntax: <time [0s,∞]>#
+// Example: AnimationDuration(MS(200)) -> "animation-duration: 200ms;"
+func AnimationDuration(values ...AnimationDurationValue) Property {
+	return commaProp("animation-duration", valueNodes(values)...)
+}
+
+// AnimationFillModeValue is implemented by the values of the "animation-fill-mode" property.
+type AnimationFillModeValue interface {
+	ValueNode
+	animationFillModeValue()
+}
+
+// AnimationFillModeKeyword represents a keyword of the "animation-fill-mode" property.
+type AnimationFillModeKeyword string
+
+func (k AnimationFillModeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k AnimationFillModeKeyword) valueNode()              {}
+func (k AnimationFillModeKeyword) animationFillModeValue() {}
+
+// AnimationFillModeKeyword also implements the value interfaces of the shorthands of "animation-fill-mode".
+func (k AnimationFillModeKeyword) animationValue() {}
+
+// AnimationFillModeKeyword value constants.
+const (
+	AnimationFillModeNone      AnimationFillModeKeyword = "none"
+	AnimationFillModeForwards  AnimationFillModeKeyword = "forwards"
+	AnimationFillModeBackwards AnimationFillModeKeyword = "backwards"
+	AnimationFillModeBoth      AnimationFillModeKeyword = "both"
+)
+
+// AnimationFillMode creates an "animation-fill-mode" property.
+// Syntax: <single-animation-fill-mode>#
+// Example: AnimationFillMode(AnimationFillModeNone) -> "animation-fill-mode: none;"
+func AnimationFillMode(values ...AnimationFillModeValue) Property {
+	return commaProp("animation-fill-mode", valueNodes(values)...)
+}
+
+// AnimationIterationCountValue is implemented by the values of the "animation-iteration-count" property.
+type AnimationIterationCountValue interface {
+	ValueNode
+	animationIterationCountValue()
+}
+
+// AnimationIterationCountKeyword represents a keyword of the "animation-iteration-count" property.
+type AnimationIterationCountKeyword string
+
+func (k AnimationIterationCountKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k AnimationIterationCountKeyword) valueNode()                    {}
+func (k AnimationIterationCountKeyword) animationIterationCountValue() {}
+
+// AnimationIterationCountKeyword also implements the value interfaces of the shorthands of "animation-iteration-count".
+func (k AnimationIterationCountKeyword) animationValue() {}
+
+// AnimationIterationCountKeyword value constants.
+const (
+	AnimationIterationCountInfinite AnimationIterationCountKeyword = "infinite"
+)
+
+// AnimationIterationCount creates an "animation-iteration-count" property.
+// Syntax: <single-animation-iteration-count>#
+// Example: AnimationIterationCount(AnimationIterationCountInfinite) -> "animation-iteration-count: infinite;"
+func AnimationIterationCount(values ...AnimationIterationCountValue) Property {
+	return commaProp("animation-iteration-count", valueNodes(values)...)
+}
+
+// AnimationNameValue is implemented by the values of the "animation-name" property.
+type AnimationNameValue interface {
+	ValueNode
+	animationNameValue()
+}
+
+// AnimationNameKeyword represents a keyword of the "animation-name" property.
+type AnimationNameKeyword string
+
+func (k AnimationNameKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k AnimationNameKeyword) valueNode()          {}
+func (k AnimationNameKeyword) animationNameValue() {}
+
+// AnimationNameKeyword also implements the value interfaces of the shorthands of "animation-name".
+func (k AnimationNameKeyword) animationValue() {}
+
+// AnimationNameKeyword value constants.
+const (
+	AnimationNameNone AnimationNameKeyword = "none"
+)
+
+// AnimationName creates an "animation-name" property.
+// Syntax: [ none | <keyframes-name> ]#
+// Example: AnimationName(AnimationNameNone) -> "animation-name: none;"
+func AnimationName(values ...AnimationNameValue) Property {
+	return commaProp("animation-name", valueNodes(values)...)
+}
+
+// AnimationPlayStateValue is implemented by the values of the "animation-play-state" property.
+type AnimationPlayStateValue interface {
+	ValueNode
+	animationPlayStateValue()
+}
+
+// AnimationPlayStateKeyword represents a keyword of the "animation-play-state" property.
+type AnimationPlayStateKeyword string
+
+func (k AnimationPlayStateKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k AnimationPlayStateKeyword) valueNode()               {}
+func (k AnimationPlayStateKeyword) animationPlayStateValue() {}
+
+// AnimationPlayStateKeyword also implements the value interfaces of the shorthands of "animation-play-state".
+func (k AnimationPlayStateKeyword) animationValue() {}
+
+// AnimationPlayStateKeyword value constants.
+const (
+	AnimationPlayStateRunning AnimationPlayStateKeyword = "running"
+	AnimationPlayStatePaused  AnimationPlayStateKeyword = "paused"
+)
+
+// AnimationPlayState creates an "animation-play-state" property.
+// Syntax: <single-animation-play-state>#
+// Example: AnimationPlayState(AnimationPlayStateRunning) -> "animation-play-state: running;"
+func AnimationPlayState(values ...AnimationPlayStateValue) Property {
+	return commaProp("animation-play-state", valueNodes(values)...)
+}
+
+// AnimationRangeValue is implemented by the values of the "animation-range" property.
+type AnimationRangeValue interface {
+	ValueNode
+	animationRangeValue()
+}
+
+// AnimationRange creates an "animation-range" property.
+// Syntax: [ <'animation-range-start'> <'animation-range-end'>? ]#
+// Example: AnimationRange(AnimationRangeStartEntry, PCT(10)) -> "animation-range: entry 10%;"
+// It sets a single layer; use AnimationRangeLayers for several.
+func AnimationRange(values ...AnimationRangeValue) Property {
+	return Prop("animation-range", valueNodes(values)...)
+}
+
+// AnimationRangeLayers creates an "animation-range" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: AnimationRangeLayers([]AnimationRangeValue{AnimationRangeStartEntry, PCT(10)}, []AnimationRangeValue{AnimationRangeStartEntry, PCT(10)}) -> "animation-range: entry 10%, entry 10%;"
+func AnimationRangeLayers(layers ...[]AnimationRangeValue) Property {
+	return commaProp("animation-range", layerNodes(layers)...)
+}
+
+// AnimationRangeEndValue is implemented by the values of the "animation-range-end" property.
+type AnimationRangeEndValue interface {
+	ValueNode
+	animationRangeEndValue()
+}
+
+// AnimationRangeEndKeyword represents a keyword of the "animation-range-end" property.
+type AnimationRangeEndKeyword string
+
+func (k AnimationRangeEndKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k AnimationRangeEndKeyword) valueNode()              {}
+func (k AnimationRangeEndKeyword) animationRangeEndValue() {}
+
+// AnimationRangeEndKeyword also implements the value interfaces of the shorthands of "animation-range-end".
+func (k AnimationRangeEndKeyword) animationRangeValue() {}
+
+// AnimationRangeEndKeyword value constants.
+const (
+	AnimationRangeEndNormal        AnimationRangeEndKeyword = "normal"
+	AnimationRangeEndCover         AnimationRangeEndKeyword = "cover"
+	AnimationRangeEndContain       AnimationRangeEndKeyword = "contain"
+	AnimationRangeEndEntry         AnimationRangeEndKeyword = "entry"
+	AnimationRangeEndExit          AnimationRangeEndKeyword = "exit"
+	AnimationRangeEndEntryCrossing AnimationRangeEndKeyword = "entry-crossing"
+	AnimationRangeEndExitCrossing  AnimationRangeEndKeyword = "exit-crossing"
+)
+
+// AnimationRangeEnd creates an "animation-range-end" property.
+// Syntax: [ normal | <length-percentage> | <timeline-range-name> <length-percentage>? ]#
+// Example: AnimationRangeEnd(AnimationRangeEndExit, PCT(90)) -> "animation-range-end: exit 90%;"
+// It sets a single layer; use AnimationRangeEndLayers for several.
+func AnimationRangeEnd(values ...AnimationRangeEndValue) Property {
+	return Prop("animation-range-end", valueNodes(values)...)
+}
+
+// AnimationRangeEndLayers creates an "animation-range-end" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: AnimationRangeEndLayers([]AnimationRangeEndValue{AnimationRangeEndExit, PCT(90)}, []AnimationRangeEndValue{AnimationRangeEndExit, PCT(90)}) -> "animation-range-end: exit 90%, exit 90%;"
+func AnimationRangeEndLayers(layers ...[]AnimationRangeEndValue) Property {
+	return commaProp("animation-range-end", layerNodes(layers)...)
+}
+
+// AnimationRangeStartValue is implemented by the values of the "animation-range-start" property.
+type AnimationRangeStartValue interface {
+	ValueNode
+	animationRangeStartValue()
+}
+
+// AnimationRangeStartKeyword represents a keyword of the "animation-range-start" property.
+type AnimationRangeStartKeyword string
+
+func (k AnimationRangeStartKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k AnimationRangeStartKeyword) valueNode()                {}
+func (k AnimationRangeStartKeyword) animationRangeStartValue() {}
+
+// AnimationRangeStartKeyword also implements the value interfaces of the shorthands of "animation-range-start".
+func (k AnimationRangeStartKeyword) animationRangeValue() {}
+
+// AnimationRangeStartKeyword value constants.
+const (
+	AnimationRangeStartNormal        AnimationRangeStartKeyword = "normal"
+	AnimationRangeStartCover         AnimationRangeStartKeyword = "cover"
+	AnimationRangeStartContain       AnimationRangeStartKeyword = "contain"
+	AnimationRangeStartEntry         AnimationRangeStartKeyword = "entry"
+	AnimationRangeStartExit          AnimationRangeStartKeyword = "exit"
+	AnimationRangeStartEntryCrossing AnimationRangeStartKeyword = "entry-crossing"
+	AnimationRangeStartExitCrossing  AnimationRangeStartKeyword = "exit-crossing"
+)
+
+// AnimationRangeStart creates an "animation-range-start" property.
+// Syntax: [ normal | <length-percentage> | <timeline-range-name> <length-percentage>? ]#
+// Example: AnimationRangeStart(AnimationRangeStartEntry, PCT(10)) -> "animation-range-start: entry 10%;"
+// It sets a single layer; use AnimationRangeStartLayers for several.
+func AnimationRangeStart(values ...AnimationRangeStartValue) Property {
+	return Prop("animation-range-start", valueNodes(values)...)
+}
+
+// AnimationRangeStartLayers creates an "animation-range-start" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: AnimationRangeStartLayers([]AnimationRangeStartValue{AnimationRangeStartEntry, PCT(10)}, []AnimationRangeStartValue{AnimationRangeStartEntry, PCT(10)}) -> "animation-range-start: entry 10%, entry 10%;"
+func AnimationRangeStartLayers(layers ...[]AnimationRangeStartValue) Property {
+	return commaProp("animation-range-start", layerNodes(layers)...)
+}
+
+// AnimationTimelineValue is implemented by the values of the "animation-timeline" property.
+type AnimationTimelineValue interface {
+	ValueNode
+	animationTimelineValue()
+}
+
+// AnimationTimelineKeyword represents a keyword of the "animation-timeline" property.
+type AnimationTimelineKeyword string
+
+func (k AnimationTimelineKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k AnimationTimelineKeyword) valueNode()              {}
+func (k AnimationTimelineKeyword) animationTimelineValue() {}
+
+// AnimationTimelineKeyword value constants.
+const (
+	AnimationTimelineNone AnimationTimelineKeyword = "none"
+)
+
+// AnimationTimeline creates an "animation-timeline" property.
+// Syntax: <single-animation-timeline>#
+// Example: AnimationTimeline(AnimationTimelineNone) -> "animation-timeline: none;"
+func AnimationTimeline(values ...AnimationTimelineValue) Property {
+	return commaProp("animation-timeline", valueNodes(values)...)
+}
+
+// AnimationTimingFunctionValue is implemented by the values of the "animation-timing-function" property.
+type AnimationTimingFunctionValue interface {
+	ValueNode
+	animationTimingFunctionValue()
+}
+
+// AnimationTimingFunctionKeyword represents a keyword of the "animation-timing-function" property.
+type AnimationTimingFunctionKeyword string
+
+func (k AnimationTimingFunctionKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k AnimationTimingFunctionKeyword) valueNode()                    {}
+func (k AnimationTimingFunctionKeyword) animationTimingFunctionValue() {}
+
+// AnimationTimingFunctionKeyword also implements the value interfaces of the shorthands of "animation-timing-function".
+func (k AnimationTimingFunctionKeyword) animationValue() {}
+
+// AnimationTimingFunctionKeyword value constants.
+const (
+	AnimationTimingFunctionLinear    AnimationTimingFunctionKeyword = "linear"
+	AnimationTimingFunctionEase      AnimationTimingFunctionKeyword = "ease"
+	AnimationTimingFunctionEaseIn    AnimationTimingFunctionKeyword = "ease-in"
+	AnimationTimingFunctionEaseOut   AnimationTimingFunctionKeyword = "ease-out"
+	AnimationTimingFunctionEaseInOut AnimationTimingFunctionKeyword = "ease-in-out"
+	AnimationTimingFunctionStepStart AnimationTimingFunctionKeyword = "step-start"
+	AnimationTimingFunctionStepEnd   AnimationTimingFunctionKeyword = "step-end"
+)
+
+// AnimationTimingFunction creates an "animation-timing-function" property.
+// Syntax: <easing-function>#
+// Example: AnimationTimingFunction(AnimationTimingFunctionLinear) -> "animation-timing-function: linear;"
+func AnimationTimingFunction(values ...AnimationTimingFunctionValue) Property {
+	return commaProp("animation-timing-function", valueNodes(values)...)
+}
+
+// AppearanceValue is implemented by the values of the "appearance" property.
+type AppearanceValue interface {
+	ValueNode
+	appearanceValue()
+}
+
+// AppearanceKeyword represents a keyword of the "appearance" property.
+type AppearanceKeyword string
+
+func (k AppearanceKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k AppearanceKeyword) valueNode()       {}
+func (k AppearanceKeyword) appearanceValue() {}
+
+// AppearanceKeyword value constants.
+const (
+	AppearanceNone           AppearanceKeyword = "none"
+	AppearanceMenulistButton AppearanceKeyword = "menulist-button"
+	AppearanceTextfield      AppearanceKeyword = "textfield"
+)
+
+// Appearance creates an "appearance" property.
+// Syntax: none | auto | menulist-button | textfield
+// Example: Appearance(AppearanceNone) -> "appearance: none;"
+func Appearance(value AppearanceValue) Property {
+	return Prop("appearance", value)
+}
+
+// AspectRatioValue is implemented by the values of the "aspect-ratio" property.
+type AspectRatioValue interface {
+	ValueNode
+	aspectRatioValue()
+}
+
+// AspectRatio creates an "aspect-ratio" property.
+// Syntax: auto || <ratio>
+// Example: AspectRatio(Auto) -> "aspect-ratio: auto;"
+func AspectRatio(values ...AspectRatioValue) Property {
+	return Prop("aspect-ratio", valueNodes(values)...)
+}
+
+// BackdropFilterValue is implemented by the values of the "backdrop-filter" property.
+type BackdropFilterValue interface {
+	ValueNode
+	backdropFilterValue()
+}
+
+// BackdropFilterKeyword represents a keyword of the "backdrop-filter" property.
+type BackdropFilterKeyword string
+
+func (k BackdropFilterKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BackdropFilterKeyword) valueNode()           {}
+func (k BackdropFilterKeyword) backdropFilterValue() {}
+
+// BackdropFilterKeyword value constants.
+const (
+	BackdropFilterNone BackdropFilterKeyword = "none"
+)
+
+// BackdropFilter creates a "backdrop-filter" property.
+// Syntax: none | <filter-function-list>
+// Example: BackdropFilter(BackdropFilterNone) -> "backdrop-filter: none;"
+func BackdropFilter(values ...BackdropFilterValue) Property {
+	return Prop("backdrop-filter", valueNodes(values)...)
+}
+
+// BackfaceVisibilityValue is implemented by the values of the "backface-visibility" property.
+type BackfaceVisibilityValue interface {
+	ValueNode
+	backfaceVisibilityValue()
+}
+
+// BackfaceVisibilityKeyword represents a keyword of the "backface-visibility" property.
+type BackfaceVisibilityKeyword string
+
+func (k BackfaceVisibilityKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BackfaceVisibilityKeyword) valueNode()               {}
+func (k BackfaceVisibilityKeyword) backfaceVisibilityValue() {}
+
+// BackfaceVisibilityKeyword value constants.
+const (
+	BackfaceVisibilityVisible BackfaceVisibilityKeyword = "visible"
+	BackfaceVisibilityHidden  BackfaceVisibilityKeyword = "hidden"
+)
+
+// BackfaceVisibility creates a "backface-visibility" property.
+// Syntax: visible | hidden
+// Example: BackfaceVisibility(BackfaceVisibilityVisible) -> "backface-visibility: visible;"
+func BackfaceVisibility(value BackfaceVisibilityValue) Property {
+	return Prop("backface-visibility", value)
+}
+
+// BackgroundValue is implemented by the values of the "background" property.
+type BackgroundValue interface {
+	ValueNode
+	backgroundValue()
+}
+
+// BackgroundKeyword represents a keyword of the "background" property.
+type BackgroundKeyword string
+
+func (k BackgroundKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BackgroundKeyword) valueNode()       {}
+func (k BackgroundKeyword) backgroundValue() {}
+
+// BackgroundKeyword value constants.
+const (
+	BackgroundNone BackgroundKeyword = "none"
+)
+
+// Background creates a "background" property.
+// Syntax: [ <bg-layer> , ]* <final-bg-layer>
+// Example: Background(BackgroundNone) -> "background: none;"
+func Background(values ...BackgroundValue) Property {
+	return Prop("background", valueNodes(values)...)
+}
+
+// BackgroundAttachmentValue is implemented by the values of the "background-attachment" property.
+type BackgroundAttachmentValue interface {
+	ValueNode
+	backgroundAttachmentValue()
+}
+
+// BackgroundAttachmentKeyword represents a keyword of the "background-attachment" property.
+type BackgroundAttachmentKeyword string
+
+func (k BackgroundAttachmentKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BackgroundAttachmentKeyword) valueNode()                 {}
+func (k BackgroundAttachmentKeyword) backgroundAttachmentValue() {}
+
+// BackgroundAttachmentKeyword also implements the value interfaces of the shorthands of "background-attachment".
+func (k BackgroundAttachmentKeyword) backgroundValue() {}
+
+// BackgroundAttachmentKeyword value constants.
+const (
+	BackgroundAttachmentScroll BackgroundAttachmentKeyword = "scroll"
+	BackgroundAttachmentFixed  BackgroundAttachmentKeyword = "fixed"
+	BackgroundAttachmentLocal  BackgroundAttachmentKeyword = "local"
+)
+
+// BackgroundAttachment creates a "background-attachment" property.
+// Syntax: <attachment>#
+// Example: BackgroundAttachment(BackgroundAttachmentScroll) -> "background-attachment: scroll;"
+func BackgroundAttachment(values ...BackgroundAttachmentValue) Property {
+	return commaProp("background-attachment", valueNodes(values)...)
+}
+
+// BackgroundBlendModeValue is implemented by the values of the "background-blend-mode" property.
+type BackgroundBlendModeValue interface {
+	ValueNode
+	backgroundBlendModeValue()
+}
+
+// BackgroundBlendModeKeyword represents a keyword of the "background-blend-mode" property.
+type BackgroundBlendModeKeyword string
+
+func (k BackgroundBlendModeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BackgroundBlendModeKeyword) valueNode()                {}
+func (k BackgroundBlendModeKeyword) backgroundBlendModeValue() {}
+
+// BackgroundBlendModeKeyword value constants.
+const (
+	BackgroundBlendModeNormal     BackgroundBlendModeKeyword = "normal"
+	BackgroundBlendModeMultiply   BackgroundBlendModeKeyword = "multiply"
+	BackgroundBlendModeScreen     BackgroundBlendModeKeyword = "screen"
+	BackgroundBlendModeOverlay    BackgroundBlendModeKeyword = "overlay"
+	BackgroundBlendModeDarken     BackgroundBlendModeKeyword = "darken"
+	BackgroundBlendModeLighten    BackgroundBlendModeKeyword = "lighten"
+	BackgroundBlendModeColorDodge BackgroundBlendModeKeyword = "color-dodge"
+	BackgroundBlendModeColorBurn  BackgroundBlendModeKeyword = "color-burn"
+	BackgroundBlendModeHardLight  BackgroundBlendModeKeyword = "hard-light"
+	BackgroundBlendModeSoftLight  BackgroundBlendModeKeyword = "soft-light"
+	BackgroundBlendModeDifference BackgroundBlendModeKeyword = "difference"
+	BackgroundBlendModeExclusion  BackgroundBlendModeKeyword = "exclusion"
+	BackgroundBlendModeHue        BackgroundBlendModeKeyword = "hue"
+	BackgroundBlendModeSaturation BackgroundBlendModeKeyword = "saturation"
+	BackgroundBlendModeColor      BackgroundBlendModeKeyword = "color"
+	BackgroundBlendModeLuminosity BackgroundBlendModeKeyword = "luminosity"
+)
+
+// BackgroundBlendMode creates a "background-blend-mode" property.
+// Syntax: <blend-mode>#
+// Example: BackgroundBlendMode(BackgroundBlendModeNormal) -> "background-blend-mode: normal;"
+func BackgroundBlendMode(values ...BackgroundBlendModeValue) Property {
+	return commaProp("background-blend-mode", valueNodes(values)...)
+}
+
+// BackgroundClipValue is implemented by the values of the "background-clip" property.
+type BackgroundClipValue interface {
+	ValueNode
+	backgroundClipValue()
+}
+
+// BackgroundClipKeyword represents a keyword of the "background-clip" property.
+type BackgroundClipKeyword string
+
+func (k BackgroundClipKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BackgroundClipKeyword) valueNode()           {}
+func (k BackgroundClipKeyword) backgroundClipValue() {}
+
+// BackgroundClipKeyword also implements the value interfaces of the shorthands of "background-clip".
+func (k BackgroundClipKeyword) backgroundValue() {}
+
+// BackgroundClipKeyword value constants.
+const (
+	BackgroundClipBorderBox  BackgroundClipKeyword = "border-box"
+	BackgroundClipPaddingBox BackgroundClipKeyword = "padding-box"
+	BackgroundClipContentBox BackgroundClipKeyword = "content-box"
+)
+
+// BackgroundClip creates a "background-clip" property.
+// Syntax: <box>#
+// Example: BackgroundClip(BackgroundClipBorderBox) -> "background-clip: border-box;"
+func BackgroundClip(values ...BackgroundClipValue) Property {
+	return commaProp("background-clip", valueNodes(values)...)
+}
+
+// BackgroundOriginValue is implemented by the values of the "background-origin" property.
+type BackgroundOriginValue interface {
+	ValueNode
+	backgroundOriginValue()
+}
+
+// BackgroundOriginKeyword represents a keyword of the "background-origin" property.
+type BackgroundOriginKeyword string
+
+func (k BackgroundOriginKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BackgroundOriginKeyword) valueNode()             {}
+func (k BackgroundOriginKeyword) backgroundOriginValue() {}
+
+// BackgroundOriginKeyword also implements the value interfaces of the shorthands of "background-origin".
+func (k BackgroundOriginKeyword) backgroundValue() {}
+
+// BackgroundOriginKeyword value constants.
+const (
+	BackgroundOriginBorderBox  BackgroundOriginKeyword = "border-box"
+	BackgroundOriginPaddingBox BackgroundOriginKeyword = "padding-box"
+	BackgroundOriginContentBox BackgroundOriginKeyword = "content-box"
+)
+
+// BackgroundOrigin creates a "background-origin" property.
+// Syntax: <box>#
+// Example: BackgroundOrigin(BackgroundOriginBorderBox) -> "background-origin: border-box;"
+func BackgroundOrigin(values ...BackgroundOriginValue) Property {
+	return commaProp("background-origin", valueNodes(values)...)
+}
+
+// BackgroundPositionValue is implemented by the values of the "background-position" property.
+type BackgroundPositionValue interface {
+	ValueNode
+	backgroundPositionValue()
+}
+
+// BackgroundPositionKeyword represents a keyword of the "background-position" property.
+type BackgroundPositionKeyword string
+
+func (k BackgroundPositionKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BackgroundPositionKeyword) valueNode()               {}
+func (k BackgroundPositionKeyword) backgroundPositionValue() {}
+
+// BackgroundPositionKeyword also implements the value interfaces of the shorthands of "background-position".
+func (k BackgroundPositionKeyword) backgroundValue() {}
+
+// BackgroundPositionKeyword value constants.
+const (
+	BackgroundPositionLeft   BackgroundPositionKeyword = "left"
+	BackgroundPositionCenter BackgroundPositionKeyword = "center"
+	BackgroundPositionRight  BackgroundPositionKeyword = "right"
+	BackgroundPositionTop    BackgroundPositionKeyword = "top"
+	BackgroundPositionBottom BackgroundPositionKeyword = "bottom"
+)
+
+// BackgroundPosition creates a "background-position" property.
+// Syntax: <bg-position>#
+// Example: BackgroundPosition(BackgroundPositionRight, PX(8)) -> "background-position: right 8px;"
+// It sets a single layer; use BackgroundPositionLayers for several.
+func BackgroundPosition(values ...BackgroundPositionValue) Property {
+	return Prop("background-position", valueNodes(values)...)
+}
+
+// BackgroundPositionLayers creates a "background-position" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: BackgroundPositionLayers([]BackgroundPositionValue{BackgroundPositionRight, PX(8)}, []BackgroundPositionValue{BackgroundPositionRight, PX(8)}) -> "background-position: right 8px, right 8px;"
+func BackgroundPositionLayers(layers ...[]BackgroundPositionValue) Property {
+	return commaProp("background-position", layerNodes(layers)...)
+}
+
+// BackgroundPositionXValue is implemented by the values of the "background-position-x" property.
+type BackgroundPositionXValue interface {
+	ValueNode
+	backgroundPositionXValue()
+}
+
+// BackgroundPositionXKeyword represents a keyword of the "background-position-x" property.
+type BackgroundPositionXKeyword string
+
+func (k BackgroundPositionXKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BackgroundPositionXKeyword) valueNode()                {}
+func (k BackgroundPositionXKeyword) backgroundPositionXValue() {}
+
+// BackgroundPositionXKeyword value constants.
+const (
+	BackgroundPositionXCenter BackgroundPositionXKeyword = "center"
+	BackgroundPositionXLeft   BackgroundPositionXKeyword = "left"
+	BackgroundPositionXRight  BackgroundPositionXKeyword = "right"
+	BackgroundPositionXXStart BackgroundPositionXKeyword = "x-start"
+	BackgroundPositionXXEnd   BackgroundPositionXKeyword = "x-end"
+)
+
+// BackgroundPositionX creates a "background-position-x" property.
+// Syntax: [ center | [ [ left | right | x-start | x-end ]? <length-percentage>? ]! ]#
+// Example: BackgroundPositionX(BackgroundPositionXRight, PX(8)) -> "background-position-x: right 8px;"
+// It sets a single layer; use BackgroundPositionXLayers for several.
+func BackgroundPositionX(values ...BackgroundPositionXValue) Property {
+	return Prop("background-position-x", valueNodes(values)...)
+}
+
+// BackgroundPositionXLayers creates a "background-position-x" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: BackgroundPositionXLayers([]BackgroundPositionXValue{BackgroundPositionXRight, PX(8)}, []BackgroundPositionXValue{BackgroundPositionXRight, PX(8)}) -> "background-position-x: right 8px, right 8px;"
+func BackgroundPositionXLayers(layers ...[]BackgroundPositionXValue) Property {
+	return commaProp("background-position-x", layerNodes(layers)...)
+}
+
+// BackgroundPositionYValue is implemented by the values of the "background-position-y" property.
+type BackgroundPositionYValue interface {
+	ValueNode
+	backgroundPositionYValue()
+}
+
+// BackgroundPositionYKeyword represents a keyword of the "background-position-y" property.
+type BackgroundPositionYKeyword string
+
+func (k BackgroundPositionYKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BackgroundPositionYKeyword) valueNode()                {}
+func (k BackgroundPositionYKeyword) backgroundPositionYValue() {}
+
+// BackgroundPositionYKeyword value constants.
+const (
+	BackgroundPositionYCenter BackgroundPositionYKeyword = "center"
+	BackgroundPositionYTop    BackgroundPositionYKeyword = "top"
+	BackgroundPositionYBottom BackgroundPositionYKeyword = "bottom"
+	BackgroundPositionYYStart BackgroundPositionYKeyword = "y-start"
+	BackgroundPositionYYEnd   BackgroundPositionYKeyword = "y-end"
+)
+
+// BackgroundPositionY creates a "background-position-y" property.
+// Syntax: [ center | [ [ top | bottom | y-start | y-end ]? <length-percentage>? ]! ]#
+// Example: BackgroundPositionY(BackgroundPositionYBottom, PX(8)) -> "background-position-y: bottom 8px;"
+// It sets a single layer; use BackgroundPositionYLayers for several.
+func BackgroundPositionY(values ...BackgroundPositionYValue) Property {
+	return Prop("background-position-y", valueNodes(values)...)
+}
+
+// BackgroundPositionYLayers creates a "background-position-y" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: BackgroundPositionYLayers([]BackgroundPositionYValue{BackgroundPositionYBottom, PX(8)}, []BackgroundPositionYValue{BackgroundPositionYBottom, PX(8)}) -> "background-position-y: bottom 8px, bottom 8px;"
+func BackgroundPositionYLayers(layers ...[]BackgroundPositionYValue) Property {
+	return commaProp("background-position-y", layerNodes(layers)...)
+}
+
+// BackgroundRepeatValue is implemented by the values of the "background-repeat" property.
+type BackgroundRepeatValue interface {
+	ValueNode
+	backgroundRepeatValue()
+}
+
+// BackgroundRepeatKeyword represents a keyword of the "background-repeat" property.
+type BackgroundRepeatKeyword string
+
+func (k BackgroundRepeatKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BackgroundRepeatKeyword) valueNode()             {}
+func (k BackgroundRepeatKeyword) backgroundRepeatValue() {}
+
+// BackgroundRepeatKeyword also implements the value interfaces of the shorthands of "background-repeat".
+func (k BackgroundRepeatKeyword) backgroundValue() {}
+
+// BackgroundRepeatKeyword value constants.
+const (
+	BackgroundRepeatRepeatX  BackgroundRepeatKeyword = "repeat-x"
+	BackgroundRepeatRepeatY  BackgroundRepeatKeyword = "repeat-y"
+	BackgroundRepeatRepeat   BackgroundRepeatKeyword = "repeat"
+	BackgroundRepeatSpace    BackgroundRepeatKeyword = "space"
+	BackgroundRepeatRound    BackgroundRepeatKeyword = "round"
+	BackgroundRepeatNoRepeat BackgroundRepeatKeyword = "no-repeat"
+)
+
+// BackgroundRepeat creates a "background-repeat" property.
+// Syntax: <repeat-style>#
+// Example: BackgroundRepeat(BackgroundRepeatRepeat, BackgroundRepeatNoRepeat) -> "background-repeat: repeat no-repeat;"
+// It sets a single layer; use BackgroundRepeatLayers for several.
+func BackgroundRepeat(values ...BackgroundRepeatValue) Property {
+	return Prop("background-repeat", valueNodes(values)...)
+}
+
+// BackgroundRepeatLayers creates a "background-repeat" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: BackgroundRepeatLayers([]BackgroundRepeatValue{BackgroundRepeatRepeat, BackgroundRepeatNoRepeat}, []BackgroundRepeatValue{BackgroundRepeatRepeat, BackgroundRepeatNoRepeat}) -> "background-repeat: repeat no-repeat, repeat no-repeat;"
+func BackgroundRepeatLayers(layers ...[]BackgroundRepeatValue) Property {
+	return commaProp("background-repeat", layerNodes(layers)...)
+}
+
+// BackgroundSizeValue is implemented by the values of the "background-size" property.
+type BackgroundSizeValue interface {
+	ValueNode
+	backgroundSizeValue()
+}
+
+// BackgroundSizeKeyword represents a keyword of the "background-size" property.
+type BackgroundSizeKeyword string
+
+func (k BackgroundSizeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BackgroundSizeKeyword) valueNode()           {}
+func (k BackgroundSizeKeyword) backgroundSizeValue() {}
+
+// BackgroundSizeKeyword also implements the value interfaces of the shorthands of "background-size".
+func (k BackgroundSizeKeyword) backgroundValue() {}
+
+// BackgroundSizeKeyword value constants.
+const (
+	BackgroundSizeCover   BackgroundSizeKeyword = "cover"
+	BackgroundSizeContain BackgroundSizeKeyword = "contain"
+)
+
+// BackgroundSize creates a "background-size" property.
+// Syntax: <bg-size>#
+// Example: BackgroundSize(PCT(50), Auto) -> "background-size: 50% auto;"
+// It sets a single layer; use BackgroundSizeLayers for several.
+func BackgroundSize(values ...BackgroundSizeValue) Property {
+	return Prop("background-size", valueNodes(values)...)
+}
+
+// BackgroundSizeLayers creates a "background-size" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: BackgroundSizeLayers([]BackgroundSizeValue{PCT(50), Auto}, []BackgroundSizeValue{PCT(50), Auto}) -> "background-size: 50% auto, 50% auto;"
+func BackgroundSizeLayers(layers ...[]BackgroundSizeValue) Property {
+	return commaProp("background-size", layerNodes(layers)...)
+}
+
+// BaselineShiftValue is implemented by the values of the "baseline-shift" property.
+type BaselineShiftValue interface {
+	ValueNode
+	baselineShiftValue()
+}
+
+// BaselineShiftKeyword represents a keyword of the "baseline-shift" property.
+type BaselineShiftKeyword string
+
+func (k BaselineShiftKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BaselineShiftKeyword) valueNode()          {}
+func (k BaselineShiftKeyword) baselineShiftValue() {}
+
+// BaselineShiftKeyword value constants.
+const (
+	BaselineShiftSub      BaselineShiftKeyword = "sub"
+	BaselineShiftSuper    BaselineShiftKeyword = "super"
+	BaselineShiftBaseline BaselineShiftKeyword = "baseline"
+)
+
+// BaselineShift creates a "baseline-shift" property.
+// Syntax: <length-percentage> | sub | super | baseline
+// Example: BaselineShift(BaselineShiftSub) -> "baseline-shift: sub;"
+func BaselineShift(value BaselineShiftValue) Property {
+	return Prop("baseline-shift", value)
+}
+
+// BaselineSourceValue is implemented by the values of the "baseline-source" property.
+type BaselineSourceValue interface {
+	ValueNode
+	baselineSourceValue()
+}
+
+// BaselineSourceKeyword represents a keyword of the "baseline-source" property.
+type BaselineSourceKeyword string
+
+func (k BaselineSourceKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BaselineSourceKeyword) valueNode()           {}
+func (k BaselineSourceKeyword) baselineSourceValue() {}
+
+// BaselineSourceKeyword value constants.
+const (
+	BaselineSourceFirst BaselineSourceKeyword = "first"
+	BaselineSourceLast  BaselineSourceKeyword = "last"
+)
+
+// BaselineSource creates a "baseline-source" property.
+// Syntax: auto | first | last
+// Example: BaselineSource(BaselineSourceFirst) -> "baseline-source: first;"
+func BaselineSource(value BaselineSourceValue) Property {
+	return Prop("baseline-source", value)
+}
+
+// BlockSizeValue is implemented by the values of the "block-size" property.
+type BlockSizeValue interface {
+	ValueNode
+	blockSizeValue()
+}
+
+// BlockSizeKeyword represents a keyword of the "block-size" property.
+type BlockSizeKeyword string
+
+func (k BlockSizeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BlockSizeKeyword) valueNode()      {}
+func (k BlockSizeKeyword) blockSizeValue() {}
+
+// BlockSizeKeyword value constants.
+const (
+	BlockSizeMinContent BlockSizeKeyword = "min-content"
+	BlockSizeMaxContent BlockSizeKeyword = "max-content"
+	BlockSizeFitContent BlockSizeKeyword = "fit-content"
+)
+
+// BlockSize creates a "block-size" property.
+// Syntax: <'width'>
+// Example: BlockSize(BlockSizeMinContent) -> "block-size: min-content;"
+func BlockSize(value BlockSizeValue) Property {
+	return Prop("block-size", value)
+}
+
+// BorderBlockValue is implemented by the values of the "border-block" property.
+type BorderBlockValue interface {
+	ValueNode
+	borderBlockValue()
+}
+
+// BorderBlock creates a "border-block" property.
+// Syntax: <'border-top-width'> || <'border-top-style'> || <color>
+// Example: BorderBlock(PX(1)) -> "border-block: 1px;"
+func BorderBlock(values ...BorderBlockValue) Property {
+	return Prop("border-block", valueNodes(values)...)
+}
+
+// BorderBlockColorValue is implemented by the values of the "border-block-color" property.
+type BorderBlockColorValue interface {
+	ValueNode
+	borderBlockColorValue()
+}
+
+// BorderBlockColor creates a "border-block-color" property.
+// Syntax: <'border-top-color'>{1,2}
+// Example: BorderBlockColor(Red) -> "border-block-color: red;"
+func BorderBlockColor(values ...BorderBlockColorValue) Property {
+	return Prop("border-block-color", valueNodes(values)...)
+}
+
+// BorderBlockEndValue is implemented by the values of the "border-block-end" property.
+type BorderBlockEndValue interface {
+	ValueNode
+	borderBlockEndValue()
+}
+
+// BorderBlockEnd creates a "border-block-end" property.
+// Syntax: <'border-top-width'> || <'border-top-style'> || <color>
+// Example: BorderBlockEnd(PX(1)) -> "border-block-end: 1px;"
+func BorderBlockEnd(values ...BorderBlockEndValue) Property {
+	return Prop("border-block-end", valueNodes(values)...)
+}
+
+// BorderBlockEndColorValue is implemented by the values of the "border-block-end-color" property.
+type BorderBlockEndColorValue interface {
+	ValueNode
+	borderBlockEndColorValue()
+}
+
+// BorderBlockEndColor creates a "border-block-end-color" property.
+// Syntax: <'border-top-color'>
+// Example: BorderBlockEndColor(Red) -> "border-block-end-color: red;"
+func BorderBlockEndColor(value BorderBlockEndColorValue) Property {
+	return Prop("border-block-end-color", value)
+}
+
+// BorderBlockEndStyleValue is implemented by the values of the "border-block-end-style" property.
+type BorderBlockEndStyleValue interface {
+	ValueNode
+	borderBlockEndStyleValue()
+}
+
+// BorderBlockEndStyleKeyword represents a keyword of the "border-block-end-style" property.
+type BorderBlockEndStyleKeyword string
+
+func (k BorderBlockEndStyleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BorderBlockEndStyleKeyword) valueNode()                {}
+func (k BorderBlockEndStyleKeyword) borderBlockEndStyleValue() {}
+
+// BorderBlockEndStyleKeyword also implements the value interfaces of the shorthands of "border-block-end-style".
+func (k BorderBlockEndStyleKeyword) borderBlockValue()      {}
+func (k BorderBlockEndStyleKeyword) borderBlockEndValue()   {}
+func (k BorderBlockEndStyleKeyword) borderBlockStyleValue() {}
+
+// BorderBlockEndStyleKeyword value constants.
+const (
+	BorderBlockEndStyleNone   BorderBlockEndStyleKeyword = "none"
+	BorderBlockEndStyleHidden BorderBlockEndStyleKeyword = "hidden"
+	BorderBlockEndStyleDotted BorderBlockEndStyleKeyword = "dotted"
+	BorderBlockEndStyleDashed BorderBlockEndStyleKeyword = "dashed"
+	BorderBlockEndStyleSolid  BorderBlockEndStyleKeyword = "solid"
+	BorderBlockEndStyleDouble BorderBlockEndStyleKeyword = "double"
+	BorderBlockEndStyleGroove BorderBlockEndStyleKeyword = "groove"
+	BorderBlockEndStyleRidge  BorderBlockEndStyleKeyword = "ridge"
+	BorderBlockEndStyleInset  BorderBlockEndStyleKeyword = "inset"
+	BorderBlockEndStyleOutset BorderBlockEndStyleKeyword = "outset"
+)
+
+// BorderBlockEndStyle creates a "border-block-end-style" property.
+// Syntax: <'border-top-style'>
+// Example: BorderBlockEndStyle(BorderBlockEndStyleNone) -> "border-block-end-style: none;"
+func BorderBlockEndStyle(value BorderBlockEndStyleValue) Property {
+	return Prop("border-block-end-style", value)
+}
+
+// BorderBlockEndWidthValue is implemented by the values of the "border-block-end-width" property.
+type BorderBlockEndWidthValue interface {
+	ValueNode
+	borderBlockEndWidthValue()
+}
+
+// BorderBlockEndWidthKeyword represents a keyword of the "border-block-end-width" property.
+type BorderBlockEndWidthKeyword string
+
+func (k BorderBlockEndWidthKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BorderBlockEndWidthKeyword) valueNode()                {}
+func (k BorderBlockEndWidthKeyword) borderBlockEndWidthValue() {}
+
+// BorderBlockEndWidthKeyword also implements the value interfaces of the shorthands of "border-block-end-width".
+func (k BorderBlockEndWidthKeyword) borderBlockValue()      {}
+func (k BorderBlockEndWidthKeyword) borderBlockEndValue()   {}
+func (k BorderBlockEndWidthKeyword) borderBlockWidthValue() {}
+
+// BorderBlockEndWidthKeyword value constants.
+const (
+	BorderBlockEndWidthThin   BorderBlockEndWidthKeyword = "thin"
+	BorderBlockEndWidthMedium BorderBlockEndWidthKeyword = "medium"
+	BorderBlockEndWidthThick  BorderBlockEndWidthKeyword = "thick"
+)
+
+// BorderBlockEndWidth creates a "border-block-end-width" property.
+// Syntax: <'border-top-width'>
+// Example: BorderBlockEndWidth(BorderBlockEndWidthThin) -> "border-block-end-width: thin;"
+func BorderBlockEndWidth(value BorderBlockEndWidthValue) Property {
+	return Prop("border-block-end-width", value)
+}
+
+// BorderBlockStartValue is implemented by the values of the "border-block-start" property.
+type BorderBlockStartValue interface {
+	ValueNode
+	borderBlockStartValue()
+}
+
+// BorderBlockStart creates a "border-block-start" property.
+// Syntax: <'border-top-width'> || <'border-top-style'> || <color>
+// Example: BorderBlockStart(PX(1)) -> "border-block-start: 1px;"
+func BorderBlockStart(values ...BorderBlockStartValue) Property {
+	return Prop("border-block-start", valueNodes(values)...)
+}
+
+// BorderBlockStartColorValue is implemented by the values of the "border-block-start-color" property.
+type BorderBlockStartColorValue interface {
+	ValueNode
+	borderBlockStartColorValue()
+}
+
+// BorderBlockStartColor creates a "border-block-start-color" property.
+// Syntax: <'border-top-color'>
+// Example: BorderBlockStartColor(Red) -> "border-block-start-color: red;"
+func BorderBlockStartColor(value BorderBlockStartColorValue) Property {
+	return Prop("border-block-start-color", value)
+}
+
+// BorderBlockStartStyleValue is implemented by the values of the "border-block-start-style" property.
+type BorderBlockStartStyleValue interface {
+	ValueNode
+	borderBlockStartStyleValue()
+}
+
+// BorderBlockStartStyleKeyword represents a keyword of the "border-block-start-style" property.
+type BorderBlockStartStyleKeyword string
+
+func (k BorderBlockStartStyleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BorderBlockStartStyleKeyword) valueNode()                  {}
+func (k BorderBlockStartStyleKeyword) borderBlockStartStyleValue() {}
+
+// BorderBlockStartStyleKeyword also implements the value interfaces of the shorthands of "border-block-start-style".
+func (k BorderBlockStartStyleKeyword) borderBlockValue()      {}
+func (k BorderBlockStartStyleKeyword) borderBlockStartValue() {}
+func (k BorderBlockStartStyleKeyword) borderBlockStyleValue() {}
+
+// BorderBlockStartStyleKeyword value constants.
+const (
+	BorderBlockStartStyleNone   BorderBlockStartStyleKeyword = "none"
+	BorderBlockStartStyleHidden BorderBlockStartStyleKeyword = "hidden"
+	BorderBlockStartStyleDotted BorderBlockStartStyleKeyword = "dotted"
+	BorderBlockStartStyleDashed BorderBlockStartStyleKeyword = "dashed"
+	BorderBlockStartStyleSolid  BorderBlockStartStyleKeyword = "solid"
+	BorderBlockStartStyleDouble BorderBlockStartStyleKeyword = "double"
+	BorderBlockStartStyleGroove BorderBlockStartStyleKeyword = "groove"
+	BorderBlockStartStyleRidge  BorderBlockStartStyleKeyword = "ridge"
+	BorderBlockStartStyleInset  BorderBlockStartStyleKeyword = "inset"
+	BorderBlockStartStyleOutset BorderBlockStartStyleKeyword = "outset"
+)
+
+// BorderBlockStartStyle creates a "border-block-start-style" property.
+// Syntax: <'border-top-style'>
+// Example: BorderBlockStartStyle(BorderBlockStartStyleNone) -> "border-block-start-style: none;"
+func BorderBlockStartStyle(value BorderBlockStartStyleValue) Property {
+	return Prop("border-block-start-style", value)
+}
+
+// BorderBlockStartWidthValue is implemented by the values of the "border-block-start-width" property.
+type BorderBlockStartWidthValue interface {
+	ValueNode
+	borderBlockStartWidthValue()
+}
+
+// BorderBlockStartWidthKeyword represents a keyword of the "border-block-start-width" property.
+type BorderBlockStartWidthKeyword string
+
+func (k BorderBlockStartWidthKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BorderBlockStartWidthKeyword) valueNode()                  {}
+func (k BorderBlockStartWidthKeyword) borderBlockStartWidthValue() {}
+
+// BorderBlockStartWidthKeyword also implements the value interfaces of the shorthands of "border-block-start-width".
+func (k BorderBlockStartWidthKeyword) borderBlockValue()      {}
+func (k BorderBlockStartWidthKeyword) borderBlockStartValue() {}
+func (k BorderBlockStartWidthKeyword) borderBlockWidthValue() {}
+
+// BorderBlockStartWidthKeyword value constants.
+const (
+	BorderBlockStartWidthThin   BorderBlockStartWidthKeyword = "thin"
+	BorderBlockStartWidthMedium BorderBlockStartWidthKeyword = "medium"
+	BorderBlockStartWidthThick  BorderBlockStartWidthKeyword = "thick"
+)
+
+// BorderBlockStartWidth creates a "border-block-start-width" property.
+// Syntax: <'border-top-width'>
+// Example: BorderBlockStartWidth(BorderBlockStartWidthThin) -> "border-block-start-width: thin;"
+func BorderBlockStartWidth(value BorderBlockStartWidthValue) Property {
+	return Prop("border-block-start-width", value)
+}
+
+// BorderBlockStyleValue is implemented by the values of the "border-block-style" property.
+type BorderBlockStyleValue interface {
+	ValueNode
+	borderBlockStyleValue()
+}
+
+// BorderBlockStyle creates a "border-block-style" property.
+// Syntax: <'border-top-style'>{1,2}
+// Example: BorderBlockStyle(Inherit) -> "border-block-style: inherit;"
+func BorderBlockStyle(values ...BorderBlockStyleValue) Property {
+	return Prop("border-block-style", valueNodes(values)...)
+}
+
+// BorderBlockWidthValue is implemented by the values of the "border-block-width" property.
+type BorderBlockWidthValue interface {
+	ValueNode
+	borderBlockWidthValue()
+}
+
+// BorderBlockWidth creates a "border-block-width" property.
+// Syntax: <'border-top-width'>{1,2}
+// Example: BorderBlockWidth(PX(1)) -> "border-block-width: 1px;"
+func BorderBlockWidth(values ...BorderBlockWidthValue) Property {
+	return Prop("border-block-width", valueNodes(values)...)
+}
+
+// BorderBottomValue is implemented by the values of the "border-bottom" property.
+type BorderBottomValue interface {
+	ValueNode
+	borderBottomValue()
+}
+
+// BorderBottomKeyword represents a keyword of the "border-bottom" property.
+type BorderBottomKeyword string
+
+func (k BorderBottomKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BorderBottomKeyword) valueNode()         {}
+func (k BorderBottomKeyword) borderBottomValue() {}
+
+// BorderBottomKeyword value constants.
+const (
+	BorderBottomThin   BorderBottomKeyword = "thin"
+	BorderBottomMedium BorderBottomKeyword = "medium"
+	BorderBottomThick  BorderBottomKeyword = "thick"
+)
+
+// BorderBottom creates a "border-bottom" property.
+// Syntax: <line-width> || <line-style> || <color>
+// Example: BorderBottom(BorderBottomThin) -> "border-bottom: thin;"
+func BorderBottom(values ...BorderBottomValue) Property {
+	return Prop("border-bottom", valueNodes(values)...)
+}
+
+// BorderBottomColorValue is implemented by the values of the "border-bottom-color" property.
+type BorderBottomColorValue interface {
+	ValueNode
+	borderBottomColorValue()
+}
+
+// BorderBottomColor creates a "border-bottom-color" property.
+// Syntax: <'border-top-color'>
+// Example: BorderBottomColor(Red) -> "border-bottom-color: red;"
+func BorderBottomColor(value BorderBottomColorValue) Property {
+	return Prop("border-bottom-color", value)
+}
+
+// BorderBottomStyleValue is implemented by the values of the "border-bottom-style" property.
+type BorderBottomStyleValue interface {
+	ValueNode
+	borderBottomStyleValue()
+}
+
+// BorderBottomStyleKeyword represents a keyword of the "border-bottom-style" property.
+type BorderBottomStyleKeyword string
+
+func (k BorderBottomStyleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BorderBottomStyleKeyword) valueNode()              {}
+func (k BorderBottomStyleKeyword) borderBottomStyleValue() {}
+
+// BorderBottomStyleKeyword also implements the value interfaces of the shorthands of "border-bottom-style".
+func (k BorderBottomStyleKeyword) borderBottomValue() {}
+
+// BorderBottomStyleKeyword value constants.
+const (
+	BorderBottomStyleNone   BorderBottomStyleKeyword = "none"
+	BorderBottomStyleHidden BorderBottomStyleKeyword = "hidden"
+	BorderBottomStyleDotted BorderBottomStyleKeyword = "dotted"
+	BorderBottomStyleDashed BorderBottomStyleKeyword = "dashed"
+	BorderBottomStyleSolid  BorderBottomStyleKeyword = "solid"
+	BorderBottomStyleDouble BorderBottomStyleKeyword = "double"
+	BorderBottomStyleGroove BorderBottomStyleKeyword = "groove"
+	BorderBottomStyleRidge  BorderBottomStyleKeyword = "ridge"
+	BorderBottomStyleInset  BorderBottomStyleKeyword = "inset"
+	BorderBottomStyleOutset BorderBottomStyleKeyword = "outset"
+)
+
+// BorderBottomStyle creates a "border-bottom-style" property.
+// Syntax: <line-style>
+// Example: BorderBottomStyle(BorderBottomStyleNone) -> "border-bottom-style: none;"
+func BorderBottomStyle(value BorderBottomStyleValue) Property {
+	return Prop("border-bottom-style", value)
+}
+
+// BorderCollapseValue is implemented by the values of the "border-collapse" property.
+type BorderCollapseValue interface {
+	ValueNode
+	borderCollapseValue()
+}
+
+// BorderCollapseKeyword represents a keyword of the "border-collapse" property.
+type BorderCollapseKeyword string
+
+func (k BorderCollapseKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BorderCollapseKeyword) valueNode()           {}
+func (k BorderCollapseKeyword) borderCollapseValue() {}
+
+// BorderCollapseKeyword value constants.
+const (
+	BorderCollapseCollapse BorderCollapseKeyword = "collapse"
+	BorderCollapseSeparate BorderCollapseKeyword = "separate"
+)
+
+// BorderCollapse creates a "border-collapse" property.
+// Syntax: collapse | separate
+// Example: BorderCollapse(BorderCollapseCollapse) -> "border-collapse: collapse;"
+func BorderCollapse(value BorderCollapseValue) Property {
+	return Prop("border-collapse", value)
+}
+
+// BorderEndEndRadiusValue is implemented by the values of the "border-end-end-radius" property.
+type BorderEndEndRadiusValue interface {
+	ValueNode
+	borderEndEndRadiusValue()
+}
+
+// BorderEndEndRadius creates a "border-end-end-radius" property.
+// Syntax: <length-percentage [0,∞]>{1,2}
+// Example: BorderEndEndRadius(PX(1)) -> "border-end-end-radius: 1px;"
+func BorderEndEndRadius(values ...BorderEndEndRadiusValue) Property {
+	return Prop("border-end-end-radius", valueNodes(values)...)
+}
+
+// BorderEndStartRadiusValue is implemented by the values of the "border-end-start-radius" property.
+type BorderEndStartRadiusValue interface {
+	ValueNode
+	borderEndStartRadiusValue()
+}
+
+// BorderEndStartRadius creates a "border-end-start-radius" property.
+// Syntax: <length-percentage [0,∞]>{1,2}
+// Example: BorderEndStartRadius(PX(1)) -> "border-end-start-radius: 1px;"
+func BorderEndStartRadius(values ...BorderEndStartRadiusValue) Property {
+	return Prop("border-end-start-radius", valueNodes(values)...)
+}
+
+// BorderImageValue is implemented by the values of the "border-image" property.
+type BorderImageValue interface {
+	ValueNode
+	borderImageValue()
+}
+
+// BorderImage creates a "border-image" property.
+// Syntax: <'border-image-source'> || <'border-image-slice'> [ / <'border-image-width'> | / <'border-image-width'>? / <'border-image-outset'> ]? || <'border-image-repeat'>
+// Example: BorderImage(Url("a.png")) -> "border-image: url('a.png');"
+func BorderImage(values ...BorderImageValue) Property {
+	return Prop("border-image", valueNodes(values)...)
+}
+
+// BorderImageOutsetValue is implemented by the values of the "border-image-outset" property.
+type BorderImageOutsetValue interface {
+	ValueNode
+	borderImageOutsetValue()
+}
+
+// BorderImageOutset creates a "border-image-outset" property.
+// Syntax: [ <length [0,∞]> | <number [0,∞]> ]{1,4}
+// Example: BorderImageOutset(PX(1)) -> "border-image-outset: 1px;"
+func BorderImageOutset(values ...BorderImageOutsetValue) Property {
+	return Prop("border-image-outset", valueNodes(values)...)
+}
+
+// BorderImageRepeatValue is implemented by the values of the "border-image-repeat" property.
+type BorderImageRepeatValue interface {
+	ValueNode
+	borderImageRepeatValue()
+}
+
+// BorderImageRepeatKeyword represents a keyword of the "border-image-repeat" property.
+type BorderImageRepeatKeyword string
+
+func (k BorderImageRepeatKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BorderImageRepeatKeyword) valueNode()              {}
+func (k BorderImageRepeatKeyword) borderImageRepeatValue() {}
+
+// BorderImageRepeatKeyword also implements the value interfaces of the shorthands of "border-image-repeat".
+func (k BorderImageRepeatKeyword) borderImageValue() {}
+
+// BorderImageRepeatKeyword value constants.
+const (
+	BorderImageRepeatStretch BorderImageRepeatKeyword = "stretch"
+	BorderImageRepeatRepeat  BorderImageRepeatKeyword = "repeat"
+	BorderImageRepeatRound   BorderImageRepeatKeyword = "round"
+	BorderImageRepeatSpace   BorderImageRepeatKeyword = "space"
+)
+
+// BorderImageRepeat creates a "border-image-repeat" property.
+// Syntax: [ stretch | repeat | round | space ]{1,2}
+// Example: BorderImageRepeat(BorderImageRepeatStretch) -> "border-image-repeat: stretch;"
+func BorderImageRepeat(values ...BorderImageRepeatValue) Property {
+	return Prop("border-image-repeat", valueNodes(values)...)
+}
+
+// BorderImageSliceValue is implemented by the values of the "border-image-slice" property.
+type BorderImageSliceValue interface {
+	ValueNode
+	borderImageSliceValue()
+}
+
+// BorderImageSlice creates a "border-image-slice" property.
+// Syntax: <number-percentage>{1,4} && fill?
+// Example: BorderImageSlice(Num(1)) -> "border-image-slice: 1;"
+func BorderImageSlice(values ...BorderImageSliceValue) Property {
+	return Prop("border-image-slice", valueNodes(values)...)
+}
+
+// BorderImageSourceValue is implemented by the values of the "border-image-source" property.
+type BorderImageSourceValue interface {
+	ValueNode
+	borderImageSourceValue()
+}
+
+// BorderImageSourceKeyword represents a keyword of the "border-image-source" property.
+type BorderImageSourceKeyword string
+
+func (k BorderImageSourceKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BorderImageSourceKeyword) valueNode()              {}
+func (k BorderImageSourceKeyword) borderImageSourceValue() {}
+
+// BorderImageSourceKeyword also implements the value interfaces of the shorthands of "border-image-source".
+func (k BorderImageSourceKeyword) borderImageValue() {}
+
+// BorderImageSourceKeyword value constants.
+const (
+	BorderImageSourceNone BorderImageSourceKeyword = "none"
+)
+
+// BorderImageSource creates a "border-image-source" property.
+// Syntax: none | <image>
+// Example: BorderImageSource(BorderImageSourceNone) -> "border-image-source: none;"
+func BorderImageSource(value BorderImageSourceValue) Property {
+	return Prop("border-image-source", value)
+}
+
+// BorderImageWidthValue is implemented by the values of the "border-image-width" property.
+type BorderImageWidthValue interface {
+	ValueNode
+	borderImageWidthValue()
+}
+
+// BorderImageWidth creates a "border-image-width" property.
+// Syntax: [ <length-percentage [0,∞]> | <number [0,∞]> | auto ]{1,4}
+// Example: BorderImageWidth(PX(1)) -> "border-image-width: 1px;"
+func BorderImageWidth(values ...BorderImageWidthValue) Property {
+	return Prop("border-image-width", valueNodes(values)...)
+}
+
+// BorderInlineValue is implemented by the values of the "border-inline" property.
+type BorderInlineValue interface {
+	ValueNode
+	borderInlineValue()
+}
+
+// BorderInline creates a "border-inline" property.
+// Syntax: <'border-top-width'> || <'border-top-style'> || <color>
+// Example: BorderInline(PX(1)) -> "border-inline: 1px;"
+func BorderInline(values ...BorderInlineValue) Property {
+	return Prop("border-inline", valueNodes(values)...)
+}
+
+// BorderInlineColorValue is implemented by the values of the "border-inline-color" property.
+type BorderInlineColorValue interface {
+	ValueNode
+	borderInlineColorValue()
+}
+
+// BorderInlineColor creates a "border-inline-color" property.
+// Syntax: <'border-top-color'>{1,2}
+// Example: BorderInlineColor(Red) -> "border-inline-color: red;"
+func BorderInlineColor(values ...BorderInlineColorValue) Property {
+	return Prop("border-inline-color", valueNodes(values)...)
+}
+
+// BorderInlineEndValue is implemented by the values of the "border-inline-end" property.
+type BorderInlineEndValue interface {
+	ValueNode
+	borderInlineEndValue()
+}
+
+// BorderInlineEnd creates a "border-inline-end" property.
+// Syntax: <'border-top-width'> || <'border-top-style'> || <color>
+// Example: BorderInlineEnd(PX(1)) -> "border-inline-end: 1px;"
+func BorderInlineEnd(values ...BorderInlineEndValue) Property {
+	return Prop("border-inline-end", valueNodes(values)...)
+}
+
+// BorderInlineEndColorValue is implemented by the values of the "border-inline-end-color" property.
+type BorderInlineEndColorValue interface {
+	ValueNode
+	borderInlineEndColorValue()
+}
+
+// BorderInlineEndColor creates a "border-inline-end-color" property.
+// Syntax: <'border-top-color'>
+// Example: BorderInlineEndColor(Red) -> "border-inline-end-color: red;"
+func BorderInlineEndColor(value BorderInlineEndColorValue) Property {
+	return Prop("border-inline-end-color", value)
+}
+
+// BorderInlineEndStyleValue is implemented by the values of the "border-inline-end-style" property.
+type BorderInlineEndStyleValue interface {
+	ValueNode
+	borderInlineEndStyleValue()
+}
+
+// BorderInlineEndStyleKeyword represents a keyword of the "border-inline-end-style" property.
+type BorderInlineEndStyleKeyword string
+
+func (k BorderInlineEndStyleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BorderInlineEndStyleKeyword) valueNode()                 {}
+func (k BorderInlineEndStyleKeyword) borderInlineEndStyleValue() {}
+
+// BorderInlineEndStyleKeyword also implements the value interfaces of the shorthands of "border-inline-end-style".
+func (k BorderInlineEndStyleKeyword) borderInlineValue()      {}
+func (k BorderInlineEndStyleKeyword) borderInlineEndValue()   {}
+func (k BorderInlineEndStyleKeyword) borderInlineStyleValue() {}
+
+// BorderInlineEndStyleKeyword value constants.
+const (
+	BorderInlineEndStyleNone   BorderInlineEndStyleKeyword = "none"
+	BorderInlineEndStyleHidden BorderInlineEndStyleKeyword = "hidden"
+	BorderInlineEndStyleDotted BorderInlineEndStyleKeyword = "dotted"
+	BorderInlineEndStyleDashed BorderInlineEndStyleKeyword = "dashed"
+	BorderInlineEndStyleSolid  BorderInlineEndStyleKeyword = "solid"
+	BorderInlineEndStyleDouble BorderInlineEndStyleKeyword = "double"
+	BorderInlineEndStyleGroove BorderInlineEndStyleKeyword = "groove"
+	BorderInlineEndStyleRidge  BorderInlineEndStyleKeyword = "ridge"
+	BorderInlineEndStyleInset  BorderInlineEndStyleKeyword = "inset"
+	BorderInlineEndStyleOutset BorderInlineEndStyleKeyword = "outset"
+)
+
+// BorderInlineEndStyle creates a "border-inline-end-style" property.
+// Syntax: <'border-top-style'>
+// Example: BorderInlineEndStyle(BorderInlineEndStyleNone) -> "border-inline-end-style: none;"
+func BorderInlineEndStyle(value BorderInlineEndStyleValue) Property {
+	return Prop("border-inline-end-style", value)
+}
+
+// BorderInlineEndWidthValue is implemented by the values of the "border-inline-end-width" property.
+type BorderInlineEndWidthValue interface {
+	ValueNode
+	borderInlineEndWidthValue()
+}
+
+// BorderInlineEndWidthKeyword represents a keyword of the "border-inline-end-width" property.
+type BorderInlineEndWidthKeyword string
+
+func (k BorderInlineEndWidthKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BorderInlineEndWidthKeyword) valueNode()                 {}
+func (k BorderInlineEndWidthKeyword) borderInlineEndWidthValue() {}
+
+// BorderInlineEndWidthKeyword also implements the value interfaces of the shorthands of "border-inline-end-width".
+func (k BorderInlineEndWidthKeyword) borderInlineValue()      {}
+func (k BorderInlineEndWidthKeyword) borderInlineEndValue()   {}
+func (k BorderInlineEndWidthKeyword) borderInlineWidthValue() {}
+
+// BorderInlineEndWidthKeyword value constants.
+const (
+	BorderInlineEndWidthThin   BorderInlineEndWidthKeyword = "thin"
+	BorderInlineEndWidthMedium BorderInlineEndWidthKeyword = "medium"
+	BorderInlineEndWidthThick  BorderInlineEndWidthKeyword = "thick"
+)
+
+// BorderInlineEndWidth creates a "border-inline-end-width" property.
+// Syntax: <'border-top-width'>
+// Example: BorderInlineEndWidth(BorderInlineEndWidthThin) -> "border-inline-end-width: thin;"
+func BorderInlineEndWidth(value BorderInlineEndWidthValue) Property {
+	return Prop("border-inline-end-width", value)
+}
+
+// BorderInlineStartValue is implemented by the values of the "border-inline-start" property.
+type BorderInlineStartValue interface {
+	ValueNode
+	borderInlineStartValue()
+}
+
+// BorderInlineStart creates a "border-inline-start" property.
+// Syntax: <'border-top-width'> || <'border-top-style'> || <color>
+// Example: BorderInlineStart(PX(1)) -> "border-inline-start: 1px;"
+func BorderInlineStart(values ...BorderInlineStartValue) Property {
+	return Prop("border-inline-start", valueNodes(values)...)
+}
+
+// BorderInlineStartColorValue is implemented by the values of the "border-inline-start-color" property.
+type BorderInlineStartColorValue interface {
+	ValueNode
+	borderInlineStartColorValue()
+}
+
+// BorderInlineStartColor creates a "border-inline-start-color" property.
+// Syntax: <'border-top-color'>
+// Example: BorderInlineStartColor(Red) -> "border-inline-start-color: red;"
+func BorderInlineStartColor(value BorderInlineStartColorValue) Property {
+	return Prop("border-inline-start-color", value)
+}
+
+// BorderInlineStartStyleValue is implemented by the values of the "border-inline-start-style" property.
+type BorderInlineStartStyleValue interface {
+	ValueNode
+	borderInlineStartStyleValue()
+}
+
+// BorderInlineStartStyleKeyword represents a keyword of the "border-inline-start-style" property.
+type BorderInlineStartStyleKeyword string
+
+func (k BorderInlineStartStyleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BorderInlineStartStyleKeyword) valueNode()                   {}
+func (k BorderInlineStartStyleKeyword) borderInlineStartStyleValue() {}
+
+// BorderInlineStartStyleKeyword also implements the value interfaces of the shorthands of "border-inline-start-style".
+func (k BorderInlineStartStyleKeyword) borderInlineValue()      {}
+func (k BorderInlineStartStyleKeyword) borderInlineStartValue() {}
+func (k BorderInlineStartStyleKeyword) borderInlineStyleValue() {}
+
+// BorderInlineStartStyleKeyword value constants.
+const (
+	BorderInlineStartStyleNone   BorderInlineStartStyleKeyword = "none"
+	BorderInlineStartStyleHidden BorderInlineStartStyleKeyword = "hidden"
+	BorderInlineStartStyleDotted BorderInlineStartStyleKeyword = "dotted"
+	BorderInlineStartStyleDashed BorderInlineStartStyleKeyword = "dashed"
+	BorderInlineStartStyleSolid  BorderInlineStartStyleKeyword = "solid"
+	BorderInlineStartStyleDouble BorderInlineStartStyleKeyword = "double"
+	BorderInlineStartStyleGroove BorderInlineStartStyleKeyword = "groove"
+	BorderInlineStartStyleRidge  BorderInlineStartStyleKeyword = "ridge"
+	BorderInlineStartStyleInset  BorderInlineStartStyleKeyword = "inset"
+	BorderInlineStartStyleOutset BorderInlineStartStyleKeyword = "outset"
+)
+
+// BorderInlineStartStyle creates a "border-inline-start-style" property.
+// Syntax: <'border-top-style'>
+// Example: BorderInlineStartStyle(BorderInlineStartStyleNone) -> "border-inline-start-style: none;"
+func BorderInlineStartStyle(value BorderInlineStartStyleValue) Property {
+	return Prop("border-inline-start-style", value)
+}
+
+// BorderInlineStartWidthValue is implemented by the values of the "border-inline-start-width" property.
+type BorderInlineStartWidthValue interface {
+	ValueNode
+	borderInlineStartWidthValue()
+}
+
+// BorderInlineStartWidthKeyword represents a keyword of the "border-inline-start-width" property.
+type BorderInlineStartWidthKeyword string
+
+func (k BorderInlineStartWidthKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BorderInlineStartWidthKeyword) valueNode()                   {}
+func (k BorderInlineStartWidthKeyword) borderInlineStartWidthValue() {}
+
+// BorderInlineStartWidthKeyword also implements the value interfaces of the shorthands of "border-inline-start-width".
+func (k BorderInlineStartWidthKeyword) borderInlineValue()      {}
+func (k BorderInlineStartWidthKeyword) borderInlineStartValue() {}
+func (k BorderInlineStartWidthKeyword) borderInlineWidthValue() {}
+
+// BorderInlineStartWidthKeyword value constants.
+const (
+	BorderInlineStartWidthThin   BorderInlineStartWidthKeyword = "thin"
+	BorderInlineStartWidthMedium BorderInlineStartWidthKeyword = "medium"
+	BorderInlineStartWidthThick  BorderInlineStartWidthKeyword = "thick"
+)
+
+// BorderInlineStartWidth creates a "border-inline-start-width" property.
+// Syntax: <'border-top-width'>
+// Example: BorderInlineStartWidth(BorderInlineStartWidthThin) -> "border-inline-start-width: thin;"
+func BorderInlineStartWidth(value BorderInlineStartWidthValue) Property {
+	return Prop("border-inline-start-width", value)
+}
+
+// BorderInlineStyleValue is implemented by the values of the "border-inline-style" property.
+type BorderInlineStyleValue interface {
+	ValueNode
+	borderInlineStyleValue()
+}
+
+// BorderInlineStyle creates a "border-inline-style" property.
+// Syntax: <'border-top-style'>{1,2}
+// Example: BorderInlineStyle(Inherit) -> "border-inline-style: inherit;"
+func BorderInlineStyle(values ...BorderInlineStyleValue) Property {
+	return Prop("border-inline-style", valueNodes(values)...)
+}
+
+// BorderInlineWidthValue is implemented by the values of the "border-inline-width" property.
+type BorderInlineWidthValue interface {
+	ValueNode
+	borderInlineWidthValue()
+}
+
+// BorderInlineWidth creates a "border-inline-width" property.
+// Syntax: <'border-top-width'>{1,2}
+// Example: BorderInlineWidth(PX(1)) -> "border-inline-width: 1px;"
+func BorderInlineWidth(values ...BorderInlineWidthValue) Property {
+	return Prop("border-inline-width", valueNodes(values)...)
+}
+
+// BorderLeftValue is implemented by the values of the "border-left" property.
+type BorderLeftValue interface {
+	ValueNode
+	borderLeftValue()
+}
+
+// BorderLeftKeyword represents a keyword of the "border-left" property.
+type BorderLeftKeyword string
+
+func (k BorderLeftKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BorderLeftKeyword) valueNode()       {}
+func (k BorderLeftKeyword) borderLeftValue() {}
+
+// BorderLeftKeyword value constants.
+const (
+	BorderLeftThin   BorderLeftKeyword = "thin"
+	BorderLeftMedium BorderLeftKeyword = "medium"
+	BorderLeftThick  BorderLeftKeyword = "thick"
+)
+
+// BorderLeft creates a "border-left" property.
+// Syntax: <line-width> || <line-style> || <color>
+// Example: BorderLeft(BorderLeftThin) -> "border-left: thin;"
+func BorderLeft(values ...BorderLeftValue) Property {
+	return Prop("border-left", valueNodes(values)...)
+}
+
+// BorderLeftColorValue is implemented by the values of the "border-left-color" property.
+type BorderLeftColorValue interface {
+	ValueNode
+	borderLeftColorValue()
+}
+
+// BorderLeftColor creates a "border-left-color" property.
+// Syntax: <color>
+// Example: BorderLeftColor(Red) -> "border-left-color: red;"
+func BorderLeftColor(value BorderLeftColorValue) Property {
+	return Prop("border-left-color", value)
+}
+
+// BorderLeftStyleValue is implemented by the values of the "border-left-style" property.
+type BorderLeftStyleValue interface {
+	ValueNode
+	borderLeftStyleValue()
+}
+
+// BorderLeftStyleKeyword represents a keyword of the "border-left-style" property.
+type BorderLeftStyleKeyword string
+
+func (k BorderLeftStyleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BorderLeftStyleKeyword) valueNode()            {}
+func (k BorderLeftStyleKeyword) borderLeftStyleValue() {}
+
+// BorderLeftStyleKeyword also implements the value interfaces of the shorthands of "border-left-style".
+func (k BorderLeftStyleKeyword) borderLeftValue() {}
+
+// BorderLeftStyleKeyword value constants.
+const (
+	BorderLeftStyleNone   BorderLeftStyleKeyword = "none"
+	BorderLeftStyleHidden BorderLeftStyleKeyword = "hidden"
+	BorderLeftStyleDotted BorderLeftStyleKeyword = "dotted"
+	BorderLeftStyleDashed BorderLeftStyleKeyword = "dashed"
+	BorderLeftStyleSolid  BorderLeftStyleKeyword = "solid"
+	BorderLeftStyleDouble BorderLeftStyleKeyword = "double"
+	BorderLeftStyleGroove BorderLeftStyleKeyword = "groove"
+	BorderLeftStyleRidge  BorderLeftStyleKeyword = "ridge"
+	BorderLeftStyleInset  BorderLeftStyleKeyword = "inset"
+	BorderLeftStyleOutset BorderLeftStyleKeyword = "outset"
+)
+
+// BorderLeftStyle creates a "border-left-style" property.
+// Syntax: <line-style>
+// Example: BorderLeftStyle(BorderLeftStyleNone) -> "border-left-style: none;"
+func BorderLeftStyle(value BorderLeftStyleValue) Property {
+	return Prop("border-left-style", value)
+}
+
+// BorderRightValue is implemented by the values of the "border-right" property.
+type BorderRightValue interface {
+	ValueNode
+	borderRightValue()
+}
+
+// BorderRightKeyword represents a keyword of the "border-right" property.
+type BorderRightKeyword string
+
+func (k BorderRightKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BorderRightKeyword) valueNode()        {}
+func (k BorderRightKeyword) borderRightValue() {}
+
+// BorderRightKeyword value constants.
+const (
+	BorderRightThin   BorderRightKeyword = "thin"
+	BorderRightMedium BorderRightKeyword = "medium"
+	BorderRightThick  BorderRightKeyword = "thick"
+)
+
+// BorderRight creates a "border-right" property.
+// Syntax: <line-width> || <line-style> || <color>
+// Example: BorderRight(BorderRightThin) -> "border-right: thin;"
+func BorderRight(values ...BorderRightValue) Property {
+	return Prop("border-right", valueNodes(values)...)
+}
+
+// BorderRightColorValue is implemented by the values of the "border-right-color" property.
+type BorderRightColorValue interface {
+	ValueNode
+	borderRightColorValue()
+}
+
+// BorderRightColor creates a "border-right-color" property.
+// Syntax: <color>
+// Example: BorderRightColor(Red) -> "border-right-color: red;"
+func BorderRightColor(value BorderRightColorValue) Property {
+	return Prop("border-right-color", value)
+}
+
+// BorderRightStyleValue is implemented by the values of the "border-right-style" property.
+type BorderRightStyleValue interface {
+	ValueNode
+	borderRightStyleValue()
+}
+
+// BorderRightStyleKeyword represents a keyword of the "border-right-style" property.
+type BorderRightStyleKeyword string
+
+func (k BorderRightStyleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BorderRightStyleKeyword) valueNode()             {}
+func (k BorderRightStyleKeyword) borderRightStyleValue() {}
+
+// BorderRightStyleKeyword also implements the value interfaces of the shorthands of "border-right-style".
+func (k BorderRightStyleKeyword) borderRightValue() {}
+
+// BorderRightStyleKeyword value constants.
+const (
+	BorderRightStyleNone   BorderRightStyleKeyword = "none"
+	BorderRightStyleHidden BorderRightStyleKeyword = "hidden"
+	BorderRightStyleDotted BorderRightStyleKeyword = "dotted"
+	BorderRightStyleDashed BorderRightStyleKeyword = "dashed"
+	BorderRightStyleSolid  BorderRightStyleKeyword = "solid"
+	BorderRightStyleDouble BorderRightStyleKeyword = "double"
+	BorderRightStyleGroove BorderRightStyleKeyword = "groove"
+	BorderRightStyleRidge  BorderRightStyleKeyword = "ridge"
+	BorderRightStyleInset  BorderRightStyleKeyword = "inset"
+	BorderRightStyleOutset BorderRightStyleKeyword = "outset"
+)
+
+// BorderRightStyle creates a "border-right-style" property.
+// Syntax: <line-style>
+// Example: BorderRightStyle(BorderRightStyleNone) -> "border-right-style: none;"
+func BorderRightStyle(value BorderRightStyleValue) Property {
+	return Prop("border-right-style", value)
+}
+
+// BorderSpacingValue is implemented by the values of the "border-spacing" property.
+type BorderSpacingValue interface {
+	ValueNode
+	borderSpacingValue()
+}
+
+// BorderSpacing creates a "border-spacing" property.
+// Syntax: <length>{1,2}
+// Example: BorderSpacing(PX(1)) -> "border-spacing: 1px;"
+func BorderSpacing(values ...BorderSpacingValue) Property {
+	return Prop("border-spacing", valueNodes(values)...)
+}
+
+// BorderStartEndRadiusValue is implemented by the values of the "border-start-end-radius" property.
+type BorderStartEndRadiusValue interface {
+	ValueNode
+	borderStartEndRadiusValue()
+}
+
+// BorderStartEndRadius creates a "border-start-end-radius" property.
+// Syntax: <length-percentage [0,∞]>{1,2}
+// Example: BorderStartEndRadius(PX(1)) -> "border-start-end-radius: 1px;"
+func BorderStartEndRadius(values ...BorderStartEndRadiusValue) Property {
+	return Prop("border-start-end-radius", valueNodes(values)...)
+}
+
+// BorderStartStartRadiusValue is implemented by the values of the "border-start-start-radius" property.
+type BorderStartStartRadiusValue interface {
+	ValueNode
+	borderStartStartRadiusValue()
+}
+
+// BorderStartStartRadius creates a "border-start-start-radius" property.
+// Syntax: <length-percentage [0,∞]>{1,2}
+// Example: BorderStartStartRadius(PX(1)) -> "border-start-start-radius: 1px;"
+func BorderStartStartRadius(values ...BorderStartStartRadiusValue) Property {
+	return Prop("border-start-start-radius", valueNodes(values)...)
+}
+
+// BorderTopValue is implemented by the values of the "border-top" property.
+type BorderTopValue interface {
+	ValueNode
+	borderTopValue()
+}
+
+// BorderTopKeyword represents a keyword of the "border-top" property.
+type BorderTopKeyword string
+
+func (k BorderTopKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BorderTopKeyword) valueNode()      {}
+func (k BorderTopKeyword) borderTopValue() {}
+
+// BorderTopKeyword value constants.
+const (
+	BorderTopThin   BorderTopKeyword = "thin"
+	BorderTopMedium BorderTopKeyword = "medium"
+	BorderTopThick  BorderTopKeyword = "thick"
+)
+
+// BorderTop creates a "border-top" property.
+// Syntax: <line-width> || <line-style> || <color>
+// Example: BorderTop(BorderTopThin) -> "border-top: thin;"
+func BorderTop(values ...BorderTopValue) Property {
+	return Prop("border-top", valueNodes(values)...)
+}
+
+// BorderTopColorValue is implemented by the values of the "border-top-color" property.
+type BorderTopColorValue interface {
+	ValueNode
+	borderTopColorValue()
+}
+
+// BorderTopColor creates a "border-top-color" property.
+// Syntax: <color>
+// Example: BorderTopColor(Red) -> "border-top-color: red;"
+func BorderTopColor(value BorderTopColorValue) Property {
+	return Prop("border-top-color", value)
+}
+
+// BorderTopStyleValue is implemented by the values of the "border-top-style" property.
+type BorderTopStyleValue interface {
+	ValueNode
+	borderTopStyleValue()
+}
+
+// BorderTopStyleKeyword represents a keyword of the "border-top-style" property.
+type BorderTopStyleKeyword string
+
+func (k BorderTopStyleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BorderTopStyleKeyword) valueNode()           {}
+func (k BorderTopStyleKeyword) borderTopStyleValue() {}
+
+// BorderTopStyleKeyword also implements the value interfaces of the shorthands of "border-top-style".
+func (k BorderTopStyleKeyword) borderTopValue() {}
+
+// BorderTopStyleKeyword value constants.
+const (
+	BorderTopStyleNone   BorderTopStyleKeyword = "none"
+	BorderTopStyleHidden BorderTopStyleKeyword = "hidden"
+	BorderTopStyleDotted BorderTopStyleKeyword = "dotted"
+	BorderTopStyleDashed BorderTopStyleKeyword = "dashed"
+	BorderTopStyleSolid  BorderTopStyleKeyword = "solid"
+	BorderTopStyleDouble BorderTopStyleKeyword = "double"
+	BorderTopStyleGroove BorderTopStyleKeyword = "groove"
+	BorderTopStyleRidge  BorderTopStyleKeyword = "ridge"
+	BorderTopStyleInset  BorderTopStyleKeyword = "inset"
+	BorderTopStyleOutset BorderTopStyleKeyword = "outset"
+)
+
+// BorderTopStyle creates a "border-top-style" property.
+// Syntax: <line-style>
+// Example: BorderTopStyle(BorderTopStyleNone) -> "border-top-style: none;"
+func BorderTopStyle(value BorderTopStyleValue) Property {
+	return Prop("border-top-style", value)
+}
+
+// BottomValue is implemented by the values of the "bottom" property.
+type BottomValue interface {
+	ValueNode
+	bottomValue()
+}
+
+// Bottom creates a "bottom" property.
+// Syntax: <length-percentage> | auto
+// Example: Bottom(PX(1)) -> "bottom: 1px;"
+func Bottom(value BottomValue) Property {
+	return Prop("bottom", value)
+}
+
+// BoxDecorationBreakValue is implemented by the values of the "box-decoration-break" property.
+type BoxDecorationBreakValue interface {
+	ValueNode
+	boxDecorationBreakValue()
+}
+
+// BoxDecorationBreakKeyword represents a keyword of the "box-decoration-break" property.
+type BoxDecorationBreakKeyword string
+
+func (k BoxDecorationBreakKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BoxDecorationBreakKeyword) valueNode()               {}
+func (k BoxDecorationBreakKeyword) boxDecorationBreakValue() {}
+
+// BoxDecorationBreakKeyword value constants.
+const (
+	BoxDecorationBreakSlice BoxDecorationBreakKeyword = "slice"
+	BoxDecorationBreakClone BoxDecorationBreakKeyword = "clone"
+)
+
+// BoxDecorationBreak creates a "box-decoration-break" property.
+// Syntax: slice | clone
+// Example: BoxDecorationBreak(BoxDecorationBreakSlice) -> "box-decoration-break: slice;"
+func BoxDecorationBreak(value BoxDecorationBreakValue) Property {
+	return Prop("box-decoration-break", value)
+}
+
+// BoxShadowValue is implemented by the values of the "box-shadow" property.
+type BoxShadowValue interface {
+	ValueNode
+	boxShadowValue()
+}
+
+// BoxShadowKeyword represents a keyword of the "box-shadow" property.
+type BoxShadowKeyword string
+
+func (k BoxShadowKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BoxShadowKeyword) valueNode()      {}
+func (k BoxShadowKeyword) boxShadowValue() {}
+
+// BoxShadowKeyword value constants.
+const (
+	BoxShadowNone BoxShadowKeyword = "none"
+)
+
+// BoxShadow creates a "box-shadow" property.
+// Syntax: none | <shadow>#
+// Example: BoxShadow(PX(1), PX(2), Red) -> "box-shadow: 1px 2px red;"
+// It sets a single layer; use BoxShadowLayers for several.
+func BoxShadow(values ...BoxShadowValue) Property {
+	return Prop("box-shadow", valueNodes(values)...)
+}
+
+// BoxShadowLayers creates a "box-shadow" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: BoxShadowLayers([]BoxShadowValue{PX(1), PX(2), Red}, []BoxShadowValue{PX(1), PX(2), Red}) -> "box-shadow: 1px 2px red, 1px 2px red;"
+func BoxShadowLayers(layers ...[]BoxShadowValue) Property {
+	return commaProp("box-shadow", layerNodes(layers)...)
+}
+
+// BoxSizingValue is implemented by the values of the "box-sizing" property.
+type BoxSizingValue interface {
+	ValueNode
+	boxSizingValue()
+}
+
+// BoxSizingKeyword represents a keyword of the "box-sizing" property.
+type BoxSizingKeyword string
+
+func (k BoxSizingKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BoxSizingKeyword) valueNode()      {}
+func (k BoxSizingKeyword) boxSizingValue() {}
+
+// BoxSizingKeyword value constants.
+const (
+	BoxSizingContentBox BoxSizingKeyword = "content-box"
+	BoxSizingBorderBox  BoxSizingKeyword = "border-box"
+)
+
+// BoxSizing creates a "box-sizing" property.
+// Syntax: content-box | border-box
+// Example: BoxSizing(BoxSizingContentBox) -> "box-sizing: content-box;"
+func BoxSizing(value BoxSizingValue) Property {
+	return Prop("box-sizing", value)
+}
+
+// BreakAfterValue is implemented by the values of the "break-after" property.
+type BreakAfterValue interface {
+	ValueNode
+	breakAfterValue()
+}
+
+// BreakAfterKeyword represents a keyword of the "break-after" property.
+type BreakAfterKeyword string
+
+func (k BreakAfterKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BreakAfterKeyword) valueNode()       {}
+func (k BreakAfterKeyword) breakAfterValue() {}
+
+// BreakAfterKeyword value constants.
+const (
+	BreakAfterAvoid       BreakAfterKeyword = "avoid"
+	BreakAfterAlways      BreakAfterKeyword = "always"
+	BreakAfterAll         BreakAfterKeyword = "all"
+	BreakAfterAvoidPage   BreakAfterKeyword = "avoid-page"
+	BreakAfterPage        BreakAfterKeyword = "page"
+	BreakAfterLeft        BreakAfterKeyword = "left"
+	BreakAfterRight       BreakAfterKeyword = "right"
+	BreakAfterRecto       BreakAfterKeyword = "recto"
+	BreakAfterVerso       BreakAfterKeyword = "verso"
+	BreakAfterAvoidColumn BreakAfterKeyword = "avoid-column"
+	BreakAfterColumn      BreakAfterKeyword = "column"
+	BreakAfterAvoidRegion BreakAfterKeyword = "avoid-region"
+	BreakAfterRegion      BreakAfterKeyword = "region"
+)
+
+// BreakAfter creates a "break-after" property.
+// Syntax: auto | avoid | always | all | avoid-page | page | left | right | recto | verso | avoid-column | column | avoid-region | region
+// Example: BreakAfter(BreakAfterAvoid) -> "break-after: avoid;"
+func BreakAfter(value BreakAfterValue) Property {
+	return Prop("break-after", value)
+}
+
+// BreakBeforeValue is implemented by the values of the "break-before" property.
+type BreakBeforeValue interface {
+	ValueNode
+	breakBeforeValue()
+}
+
+// BreakBeforeKeyword represents a keyword of the "break-before" property.
+type BreakBeforeKeyword string
+
+func (k BreakBeforeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BreakBeforeKeyword) valueNode()        {}
+func (k BreakBeforeKeyword) breakBeforeValue() {}
+
+// BreakBeforeKeyword value constants.
+const (
+	BreakBeforeAvoid       BreakBeforeKeyword = "avoid"
+	BreakBeforeAlways      BreakBeforeKeyword = "always"
+	BreakBeforeAll         BreakBeforeKeyword = "all"
+	BreakBeforeAvoidPage   BreakBeforeKeyword = "avoid-page"
+	BreakBeforePage        BreakBeforeKeyword = "page"
+	BreakBeforeLeft        BreakBeforeKeyword = "left"
+	BreakBeforeRight       BreakBeforeKeyword = "right"
+	BreakBeforeRecto       BreakBeforeKeyword = "recto"
+	BreakBeforeVerso       BreakBeforeKeyword = "verso"
+	BreakBeforeAvoidColumn BreakBeforeKeyword = "avoid-column"
+	BreakBeforeColumn      BreakBeforeKeyword = "column"
+	BreakBeforeAvoidRegion BreakBeforeKeyword = "avoid-region"
+	BreakBeforeRegion      BreakBeforeKeyword = "region"
+)
+
+// BreakBefore creates a "break-before" property.
+// Syntax: auto | avoid | always | all | avoid-page | page | left | right | recto | verso | avoid-column | column | avoid-region | region
+// Example: BreakBefore(BreakBeforeAvoid) -> "break-before: avoid;"
+func BreakBefore(value BreakBeforeValue) Property {
+	return Prop("break-before", value)
+}
+
+// BreakInsideValue is implemented by the values of the "break-inside" property.
+type BreakInsideValue interface {
+	ValueNode
+	breakInsideValue()
+}
+
+// BreakInsideKeyword represents a keyword of the "break-inside" property.
+type BreakInsideKeyword string
+
+func (k BreakInsideKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k BreakInsideKeyword) valueNode()        {}
+func (k BreakInsideKeyword) breakInsideValue() {}
+
+// BreakInsideKeyword value constants.
+const (
+	BreakInsideAvoid       BreakInsideKeyword = "avoid"
+	BreakInsideAvoidPage   BreakInsideKeyword = "avoid-page"
+	BreakInsideAvoidColumn BreakInsideKeyword = "avoid-column"
+	BreakInsideAvoidRegion BreakInsideKeyword = "avoid-region"
+)
+
+// BreakInside creates a "break-inside" property.
+// Syntax: auto | avoid | avoid-page | avoid-column | avoid-region
+// Example: BreakInside(BreakInsideAvoid) -> "break-inside: avoid;"
+func BreakInside(value BreakInsideValue) Property {
+	return Prop("break-inside", value)
+}
+
+// CaptionSideValue is implemented by the values of the "caption-side" property.
+type CaptionSideValue interface {
+	ValueNode
+	captionSideValue()
+}
+
+// CaptionSideKeyword represents a keyword of the "caption-side" property.
+type CaptionSideKeyword string
+
+func (k CaptionSideKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k CaptionSideKeyword) valueNode()        {}
+func (k CaptionSideKeyword) captionSideValue() {}
+
+// CaptionSideKeyword value constants.
+const (
+	CaptionSideTop    CaptionSideKeyword = "top"
+	CaptionSideBottom CaptionSideKeyword = "bottom"
+)
+
+// CaptionSide creates a "caption-side" property.
+// Syntax: top | bottom
+// Example: CaptionSide(CaptionSideTop) -> "caption-side: top;"
+func CaptionSide(value CaptionSideValue) Property {
+	return Prop("caption-side", value)
+}
+
+// CaretValue is implemented by the values of the "caret" property.
+type CaretValue interface {
+	ValueNode
+	caretValue()
+}
+
+// Caret creates a "caret" property.
+// Syntax: <'caret-color'> || <'caret-shape'>
+// Example: Caret(Auto) -> "caret: auto;"
+func Caret(values ...CaretValue) Property {
+	return Prop("caret", valueNodes(values)...)
+}
+
+// CaretColorValue is implemented by the values of the "caret-color" property.
+type CaretColorValue interface {
+	ValueNode
+	caretColorValue()
+}
+
+// CaretColor creates a "caret-color" property.
+// Syntax: auto | <color>
+// Example: CaretColor(Auto) -> "caret-color: auto;"
+func CaretColor(value CaretColorValue) Property {
+	return Prop("caret-color", value)
+}
+
+// CaretShapeValue is implemented by the values of the "caret-shape" property.
+type CaretShapeValue interface {
+	ValueNode
+	caretShapeValue()
+}
+
+// CaretShapeKeyword represents a keyword of the "caret-shape" property.
+type CaretShapeKeyword string
+
+func (k CaretShapeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k CaretShapeKeyword) valueNode()       {}
+func (k CaretShapeKeyword) caretShapeValue() {}
+
+// CaretShapeKeyword also implements the value interfaces of the shorthands of "caret-shape".
+func (k CaretShapeKeyword) caretValue() {}
+
+// CaretShapeKeyword value constants.
+const (
+	CaretShapeBar        CaretShapeKeyword = "bar"
+	CaretShapeBlock      CaretShapeKeyword = "block"
+	CaretShapeUnderscore CaretShapeKeyword = "underscore"
+)
+
+// CaretShape creates a "caret-shape" property.
+// Syntax: auto | bar | block | underscore
+// Example: CaretShape(CaretShapeBar) -> "caret-shape: bar;"
+func CaretShape(value CaretShapeValue) Property {
+	return Prop("caret-shape", value)
+}
+
+// ClearValue is implemented by the values of the "clear" property.
+type ClearValue interface {
+	ValueNode
+	clearValue()
+}
+
+// ClearKeyword represents a keyword of the "clear" property.
+type ClearKeyword string
+
+func (k ClearKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ClearKeyword) valueNode()  {}
+func (k ClearKeyword) clearValue() {}
+
+// ClearKeyword value constants.
+const (
+	ClearNone        ClearKeyword = "none"
+	ClearLeft        ClearKeyword = "left"
+	ClearRight       ClearKeyword = "right"
+	ClearBoth        ClearKeyword = "both"
+	ClearInlineStart ClearKeyword = "inline-start"
+	ClearInlineEnd   ClearKeyword = "inline-end"
+)
+
+// Clear creates a "clear" property.
+// Syntax: none | left | right | both | inline-start | inline-end
+// Example: Clear(ClearNone) -> "clear: none;"
+func Clear(value ClearValue) Property {
+	return Prop("clear", value)
+}
+
+// ClipPathValue is implemented by the values of the "clip-path" property.
+type ClipPathValue interface {
+	ValueNode
+	clipPathValue()
+}
+
+// ClipPathKeyword represents a keyword of the "clip-path" property.
+type ClipPathKeyword string
+
+func (k ClipPathKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ClipPathKeyword) valueNode()     {}
+func (k ClipPathKeyword) clipPathValue() {}
+
+// ClipPathKeyword value constants.
+const (
+	ClipPathContentBox ClipPathKeyword = "content-box"
+	ClipPathPaddingBox ClipPathKeyword = "padding-box"
+	ClipPathBorderBox  ClipPathKeyword = "border-box"
+	ClipPathMarginBox  ClipPathKeyword = "margin-box"
+	ClipPathFillBox    ClipPathKeyword = "fill-box"
+	ClipPathStrokeBox  ClipPathKeyword = "stroke-box"
+	ClipPathViewBox    ClipPathKeyword = "view-box"
+	ClipPathNone       ClipPathKeyword = "none"
+)
+
+// ClipPath creates a "clip-path" property.
+// Syntax: <clip-source> | [ <basic-shape> || <geometry-box> ] | none
+// Example: ClipPath(ClipPathContentBox) -> "clip-path: content-box;"
+func ClipPath(values ...ClipPathValue) Property {
+	return Prop("clip-path", valueNodes(values)...)
+}
+
+// ClipRuleValue is implemented by the values of the "clip-rule" property.
+type ClipRuleValue interface {
+	ValueNode
+	clipRuleValue()
+}
+
+// ClipRuleKeyword represents a keyword of the "clip-rule" property.
+type ClipRuleKeyword string
+
+func (k ClipRuleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ClipRuleKeyword) valueNode()     {}
+func (k ClipRuleKeyword) clipRuleValue() {}
+
+// ClipRuleKeyword value constants.
+const (
+	ClipRuleNonzero ClipRuleKeyword = "nonzero"
+	ClipRuleEvenodd ClipRuleKeyword = "evenodd"
+)
+
+// ClipRule creates a "clip-rule" property.
+// Syntax: nonzero | evenodd
+// Example: ClipRule(ClipRuleNonzero) -> "clip-rule: nonzero;"
+func ClipRule(value ClipRuleValue) Property {
+	return Prop("clip-rule", value)
+}
+
+// ColorInterpolationValue is implemented by the values of the "color-interpolation" property.
+type ColorInterpolationValue interface {
+	ValueNode
+	colorInterpolationValue()
+}
+
+// ColorInterpolationKeyword represents a keyword of the "color-interpolation" property.
+type ColorInterpolationKeyword string
+
+func (k ColorInterpolationKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ColorInterpolationKeyword) valueNode()               {}
+func (k ColorInterpolationKeyword) colorInterpolationValue() {}
+
+// ColorInterpolationKeyword value constants.
+const (
+	ColorInterpolationSRGB      ColorInterpolationKeyword = "sRGB"
+	ColorInterpolationLinearRGB ColorInterpolationKeyword = "linearRGB"
+)
+
+// ColorInterpolation creates a "color-interpolation" property.
+// Syntax: auto | sRGB | linearRGB
+// Example: ColorInterpolation(ColorInterpolationSRGB) -> "color-interpolation: sRGB;"
+func ColorInterpolation(value ColorInterpolationValue) Property {
+	return Prop("color-interpolation", value)
+}
+
+// ColorInterpolationFiltersValue is implemented by the values of the "color-interpolation-filters" property.
+type ColorInterpolationFiltersValue interface {
+	ValueNode
+	colorInterpolationFiltersValue()
+}
+
+// ColorInterpolationFiltersKeyword represents a keyword of the "color-interpolation-filters" property.
+type ColorInterpolationFiltersKeyword string
+
+func (k ColorInterpolationFiltersKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ColorInterpolationFiltersKeyword) valueNode()                      {}
+func (k ColorInterpolationFiltersKeyword) colorInterpolationFiltersValue() {}
+
+// ColorInterpolationFiltersKeyword value constants.
+const (
+	ColorInterpolationFiltersSRGB      ColorInterpolationFiltersKeyword = "sRGB"
+	ColorInterpolationFiltersLinearRGB ColorInterpolationFiltersKeyword = "linearRGB"
+)
+
+// ColorInterpolationFilters creates a "color-interpolation-filters" property.
+// Syntax: auto | sRGB | linearRGB
+// Example: ColorInterpolationFilters(ColorInterpolationFiltersSRGB) -> "color-interpolation-filters: sRGB;"
+func ColorInterpolationFilters(value ColorInterpolationFiltersValue) Property {
+	return Prop("color-interpolation-filters", value)
+}
+
+// ColumnCountValue is implemented by the values of the "column-count" property.
+type ColumnCountValue interface {
+	ValueNode
+	columnCountValue()
+}
+
+// ColumnCount creates a "column-count" property.
+// Syntax: <integer [1,∞]> | auto
+// Example: ColumnCount(Int(1)) -> "column-count: 1;"
+func ColumnCount(value ColumnCountValue) Property {
+	return Prop("column-count", value)
+}
+
+// ColumnFillValue is implemented by the values of the "column-fill" property.
+type ColumnFillValue interface {
+	ValueNode
+	columnFillValue()
+}
+
+// ColumnFillKeyword represents a keyword of the "column-fill" property.
+type ColumnFillKeyword string
+
+func (k ColumnFillKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ColumnFillKeyword) valueNode()       {}
+func (k ColumnFillKeyword) columnFillValue() {}
+
+// ColumnFillKeyword value constants.
+const (
+	ColumnFillBalance ColumnFillKeyword = "balance"
+)
+
+// ColumnFill creates a "column-fill" property.
+// Syntax: auto | balance
+// Example: ColumnFill(ColumnFillBalance) -> "column-fill: balance;"
+func ColumnFill(value ColumnFillValue) Property {
+	return Prop("column-fill", value)
+}
+
+// ColumnGapValue is implemented by the values of the "column-gap" property.
+type ColumnGapValue interface {
+	ValueNode
+	columnGapValue()
+}
+
+// ColumnGapKeyword represents a keyword of the "column-gap" property.
+type ColumnGapKeyword string
+
+func (k ColumnGapKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ColumnGapKeyword) valueNode()      {}
+func (k ColumnGapKeyword) columnGapValue() {}
+
+// ColumnGapKeyword also implements the value interfaces of the shorthands of "column-gap".
+func (k ColumnGapKeyword) gapValue() {}
+
+// ColumnGapKeyword value constants.
+const (
+	ColumnGapNormal ColumnGapKeyword = "normal"
+)
+
+// ColumnGap creates a "column-gap" property.
+// Syntax: normal | <length-percentage [0,∞]>
+// Example: ColumnGap(ColumnGapNormal) -> "column-gap: normal;"
+func ColumnGap(value ColumnGapValue) Property {
+	return Prop("column-gap", value)
+}
+
+// ColumnRuleValue is implemented by the values of the "column-rule" property.
+type ColumnRuleValue interface {
+	ValueNode
+	columnRuleValue()
+}
+
+// ColumnRule creates a "column-rule" property.
+// Syntax: <'column-rule-width'> || <'column-rule-style'> || <'column-rule-color'>
+// Example: ColumnRule(PX(1)) -> "column-rule: 1px;"
+func ColumnRule(values ...ColumnRuleValue) Property {
+	return Prop("column-rule", valueNodes(values)...)
+}
+
+// ColumnRuleColorValue is implemented by the values of the "column-rule-color" property.
+type ColumnRuleColorValue interface {
+	ValueNode
+	columnRuleColorValue()
+}
+
+// ColumnRuleColor creates a "column-rule-color" property.
+// Syntax: <color>
+// Example: ColumnRuleColor(Red) -> "column-rule-color: red;"
+func ColumnRuleColor(value ColumnRuleColorValue) Property {
+	return Prop("column-rule-color", value)
+}
+
+// ColumnRuleStyleValue is implemented by the values of the "column-rule-style" property.
+type ColumnRuleStyleValue interface {
+	ValueNode
+	columnRuleStyleValue()
+}
+
+// ColumnRuleStyleKeyword represents a keyword of the "column-rule-style" property.
+type ColumnRuleStyleKeyword string
+
+func (k ColumnRuleStyleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ColumnRuleStyleKeyword) valueNode()            {}
+func (k ColumnRuleStyleKeyword) columnRuleStyleValue() {}
+
+// ColumnRuleStyleKeyword also implements the value interfaces of the shorthands of "column-rule-style".
+func (k ColumnRuleStyleKeyword) columnRuleValue() {}
+
+// ColumnRuleStyleKeyword value constants.
+const (
+	ColumnRuleStyleNone   ColumnRuleStyleKeyword = "none"
+	ColumnRuleStyleHidden ColumnRuleStyleKeyword = "hidden"
+	ColumnRuleStyleDotted ColumnRuleStyleKeyword = "dotted"
+	ColumnRuleStyleDashed ColumnRuleStyleKeyword = "dashed"
+	ColumnRuleStyleSolid  ColumnRuleStyleKeyword = "solid"
+	ColumnRuleStyleDouble ColumnRuleStyleKeyword = "double"
+	ColumnRuleStyleGroove ColumnRuleStyleKeyword = "groove"
+	ColumnRuleStyleRidge  ColumnRuleStyleKeyword = "ridge"
+	ColumnRuleStyleInset  ColumnRuleStyleKeyword = "inset"
+	ColumnRuleStyleOutset ColumnRuleStyleKeyword = "outset"
+)
+
+// ColumnRuleStyle creates a "column-rule-style" property.
+// Syntax: <'border-style'>
+// Example: ColumnRuleStyle(ColumnRuleStyleNone) -> "column-rule-style: none;"
+func ColumnRuleStyle(values ...ColumnRuleStyleValue) Property {
+	return Prop("column-rule-style", valueNodes(values)...)
+}
+
+// ColumnRuleWidthValue is implemented by the values of the "column-rule-width" property.
+type ColumnRuleWidthValue interface {
+	ValueNode
+	columnRuleWidthValue()
+}
+
+// ColumnRuleWidthKeyword represents a keyword of the "column-rule-width" property.
+type ColumnRuleWidthKeyword string
+
+func (k ColumnRuleWidthKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ColumnRuleWidthKeyword) valueNode()            {}
+func (k ColumnRuleWidthKeyword) columnRuleWidthValue() {}
+
+// ColumnRuleWidthKeyword also implements the value interfaces of the shorthands of "column-rule-width".
+func (k ColumnRuleWidthKeyword) columnRuleValue() {}
+
+// ColumnRuleWidthKeyword value constants.
+const (
+	ColumnRuleWidthThin   ColumnRuleWidthKeyword = "thin"
+	ColumnRuleWidthMedium ColumnRuleWidthKeyword = "medium"
+	ColumnRuleWidthThick  ColumnRuleWidthKeyword = "thick"
+)
+
+// ColumnRuleWidth creates a "column-rule-width" property.
+// Syntax: <'border-width'>
+// Example: ColumnRuleWidth(ColumnRuleWidthThin) -> "column-rule-width: thin;"
+func ColumnRuleWidth(values ...ColumnRuleWidthValue) Property {
+	return Prop("column-rule-width", valueNodes(values)...)
+}
+
+// ColumnSpanValue is implemented by the values of the "column-span" property.
+type ColumnSpanValue interface {
+	ValueNode
+	columnSpanValue()
+}
+
+// ColumnSpanKeyword represents a keyword of the "column-span" property.
+type ColumnSpanKeyword string
+
+func (k ColumnSpanKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ColumnSpanKeyword) valueNode()       {}
+func (k ColumnSpanKeyword) columnSpanValue() {}
+
+// ColumnSpanKeyword value constants.
+const (
+	ColumnSpanNone ColumnSpanKeyword = "none"
+	ColumnSpanAll  ColumnSpanKeyword = "all"
+)
+
+// ColumnSpan creates a "column-span" property.
+// Syntax: none | all
+// Example: ColumnSpan(ColumnSpanNone) -> "column-span: none;"
+func ColumnSpan(value ColumnSpanValue) Property {
+	return Prop("column-span", value)
+}
+
+// ColumnWidthValue is implemented by the values of the "column-width" property.
+type ColumnWidthValue interface {
+	ValueNode
+	columnWidthValue()
+}
+
+// ColumnWidth creates a "column-width" property.
+// Syntax: <length [0,∞]> | auto
+// Example: ColumnWidth(PX(1)) -> "column-width: 1px;"
+func ColumnWidth(value ColumnWidthValue) Property {
+	return Prop("column-width", value)
+}
+
+// ColumnsValue is implemented by the values of the "columns" property.
+type ColumnsValue interface {
+	ValueNode
+	columnsValue()
+}
+
+// Columns creates a "columns" property.
+// Syntax: <'column-width'> || <'column-count'>
+// Example: Columns(PX(1)) -> "columns: 1px;"
+func Columns(values ...ColumnsValue) Property {
+	return Prop("columns", valueNodes(values)...)
+}
+
+// ContainValue is implemented by the values of the "contain" property.
+type ContainValue interface {
+	ValueNode
+	containValue()
+}
+
+// ContainKeyword represents a keyword of the "contain" property.
+type ContainKeyword string
+
+func (k ContainKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ContainKeyword) valueNode()    {}
+func (k ContainKeyword) containValue() {}
+
+// ContainKeyword value constants.
+const (
+	ContainNone       ContainKeyword = "none"
+	ContainStrict     ContainKeyword = "strict"
+	ContainContent    ContainKeyword = "content"
+	ContainSize       ContainKeyword = "size"
+	ContainInlineSize ContainKeyword = "inline-size"
+	ContainLayout     ContainKeyword = "layout"
+	ContainStyle      ContainKeyword = "style"
+	ContainPaint      ContainKeyword = "paint"
+)
+
+// Contain creates a "contain" property.
+// Syntax: none | strict | content | [ [ size || inline-size ] || layout || style || paint ]
+// Example: Contain(ContainNone) -> "contain: none;"
+func Contain(values ...ContainValue) Property {
+	return Prop("contain", valueNodes(values)...)
+}
+
+// ContainIntrinsicBlockSizeValue is implemented by the values of the "contain-intrinsic-block-size" property.
+type ContainIntrinsicBlockSizeValue interface {
+	ValueNode
+	containIntrinsicBlockSizeValue()
+}
+
+// ContainIntrinsicBlockSizeKeyword represents a keyword of the "contain-intrinsic-block-size" property.
+type ContainIntrinsicBlockSizeKeyword string
+
+func (k ContainIntrinsicBlockSizeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ContainIntrinsicBlockSizeKeyword) valueNode()                      {}
+func (k ContainIntrinsicBlockSizeKeyword) containIntrinsicBlockSizeValue() {}
+
+// ContainIntrinsicBlockSizeKeyword value constants.
+const (
+	ContainIntrinsicBlockSizeNone ContainIntrinsicBlockSizeKeyword = "none"
+)
+
+// ContainIntrinsicBlockSize creates a "contain-intrinsic-block-size" property.
+// Syntax: auto? [ none | <length> ]
+// Example: ContainIntrinsicBlockSize(ContainIntrinsicBlockSizeNone) -> "contain-intrinsic-block-size: none;"
+func ContainIntrinsicBlockSize(values ...ContainIntrinsicBlockSizeValue) Property {
+	return Prop("contain-intrinsic-block-size", valueNodes(values)...)
+}
+
+// ContainIntrinsicHeightValue is implemented by the values of the "contain-intrinsic-height" property.
+type ContainIntrinsicHeightValue interface {
+	ValueNode
+	containIntrinsicHeightValue()
+}
+
+// ContainIntrinsicHeightKeyword represents a keyword of the "contain-intrinsic-height" property.
+type ContainIntrinsicHeightKeyword string
+
+func (k ContainIntrinsicHeightKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ContainIntrinsicHeightKeyword) valueNode()                   {}
+func (k ContainIntrinsicHeightKeyword) containIntrinsicHeightValue() {}
+
+// ContainIntrinsicHeightKeyword also implements the value interfaces of the shorthands of "contain-intrinsic-height".
+func (k ContainIntrinsicHeightKeyword) containIntrinsicSizeValue() {}
+
+// ContainIntrinsicHeightKeyword value constants.
+const (
+	ContainIntrinsicHeightNone ContainIntrinsicHeightKeyword = "none"
+)
+
+// ContainIntrinsicHeight creates a "contain-intrinsic-height" property.
+// Syntax: auto? [ none | <length> ]
+// Example: ContainIntrinsicHeight(ContainIntrinsicHeightNone) -> "contain-intrinsic-height: none;"
+func ContainIntrinsicHeight(values ...ContainIntrinsicHeightValue) Property {
+	return Prop("contain-intrinsic-height", valueNodes(values)...)
+}
+
+// ContainIntrinsicInlineSizeValue is implemented by the values of the "contain-intrinsic-inline-size" property.
+type ContainIntrinsicInlineSizeValue interface {
+	ValueNode
+	containIntrinsicInlineSizeValue()
+}
+
+// ContainIntrinsicInlineSizeKeyword represents a keyword of the "contain-intrinsic-inline-size" property.
+type ContainIntrinsicInlineSizeKeyword string
+
+func (k ContainIntrinsicInlineSizeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ContainIntrinsicInlineSizeKeyword) valueNode()                       {}
+func (k ContainIntrinsicInlineSizeKeyword) containIntrinsicInlineSizeValue() {}
+
+// ContainIntrinsicInlineSizeKeyword value constants.
+const (
+	ContainIntrinsicInlineSizeNone ContainIntrinsicInlineSizeKeyword = "none"
+)
+
+// ContainIntrinsicInlineSize creates a "contain-intrinsic-inline-size" property.
+// Syntax: auto? [ none | <length> ]
+// Example: ContainIntrinsicInlineSize(ContainIntrinsicInlineSizeNone) -> "contain-intrinsic-inline-size: none;"
+func ContainIntrinsicInlineSize(values ...ContainIntrinsicInlineSizeValue) Property {
+	return Prop("contain-intrinsic-inline-size", valueNodes(values)...)
+}
+
+// ContainIntrinsicSizeValue is implemented by the values of the "contain-intrinsic-size" property.
+type ContainIntrinsicSizeValue interface {
+	ValueNode
+	containIntrinsicSizeValue()
+}
+
+// ContainIntrinsicSize creates a "contain-intrinsic-size" property.
+// Syntax: [ auto? [ none | <length> ] ]{1,2}
+// Example: ContainIntrinsicSize(Auto, PX(100)) -> "contain-intrinsic-size: auto 100px;"
+func ContainIntrinsicSize(values ...ContainIntrinsicSizeValue) Property {
+	return Prop("contain-intrinsic-size", valueNodes(values)...)
+}
+
+// ContainIntrinsicWidthValue is implemented by the values of the "contain-intrinsic-width" property.
+type ContainIntrinsicWidthValue interface {
+	ValueNode
+	containIntrinsicWidthValue()
+}
+
+// ContainIntrinsicWidthKeyword represents a keyword of the "contain-intrinsic-width" property.
+type ContainIntrinsicWidthKeyword string
+
+func (k ContainIntrinsicWidthKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ContainIntrinsicWidthKeyword) valueNode()                  {}
+func (k ContainIntrinsicWidthKeyword) containIntrinsicWidthValue() {}
+
+// ContainIntrinsicWidthKeyword also implements the value interfaces of the shorthands of "contain-intrinsic-width".
+func (k ContainIntrinsicWidthKeyword) containIntrinsicSizeValue() {}
+
+// ContainIntrinsicWidthKeyword value constants.
+const (
+	ContainIntrinsicWidthNone ContainIntrinsicWidthKeyword = "none"
+)
+
+// ContainIntrinsicWidth creates a "contain-intrinsic-width" property.
+// Syntax: auto? [ none | <length> ]
+// Example: ContainIntrinsicWidth(ContainIntrinsicWidthNone) -> "contain-intrinsic-width: none;"
+func ContainIntrinsicWidth(values ...ContainIntrinsicWidthValue) Property {
+	return Prop("contain-intrinsic-width", valueNodes(values)...)
+}
+
+// ContainerValue is implemented by the values of the "container" property.
+type ContainerValue interface {
+	ValueNode
+	containerValue()
+}
+
+// Container creates a "container" property.
+// Syntax: <'container-name'> [ / <'container-type'> ]?
+// Example: Container(Ident("a")) -> "container: a;"
+func Container(values ...ContainerValue) Property {
+	return Prop("container", valueNodes(values)...)
+}
+
+// ContainerNameValue is implemented by the values of the "container-name" property.
+type ContainerNameValue interface {
+	ValueNode
+	containerNameValue()
+}
+
+// ContainerNameKeyword represents a keyword of the "container-name" property.
+type ContainerNameKeyword string
+
+func (k ContainerNameKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ContainerNameKeyword) valueNode()          {}
+func (k ContainerNameKeyword) containerNameValue() {}
+
+// ContainerNameKeyword also implements the value interfaces of the shorthands of "container-name".
+func (k ContainerNameKeyword) containerValue() {}
+
+// ContainerNameKeyword value constants.
+const (
+	ContainerNameNone ContainerNameKeyword = "none"
+)
+
+// ContainerName creates a "container-name" property.
+// Syntax: none | <custom-ident>+
+// Example: ContainerName(ContainerNameNone) -> "container-name: none;"
+func ContainerName(values ...ContainerNameValue) Property {
+	return Prop("container-name", valueNodes(values)...)
+}
+
+// ContainerTypeValue is implemented by the values of the "container-type" property.
+type ContainerTypeValue interface {
+	ValueNode
+	containerTypeValue()
+}
+
+// ContainerTypeKeyword represents a keyword of the "container-type" property.
+type ContainerTypeKeyword string
+
+func (k ContainerTypeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ContainerTypeKeyword) valueNode()          {}
+func (k ContainerTypeKeyword) containerTypeValue() {}
+
+// ContainerTypeKeyword also implements the value interfaces of the shorthands of "container-type".
+func (k ContainerTypeKeyword) containerValue() {}
+
+// ContainerTypeKeyword value constants.
+const (
+	ContainerTypeNormal     ContainerTypeKeyword = "normal"
+	ContainerTypeSize       ContainerTypeKeyword = "size"
+	ContainerTypeInlineSize ContainerTypeKeyword = "inline-size"
+)
+
+// ContainerType creates a "container-type" property.
+// Syntax: normal | size | inline-size
+// Example: ContainerType(ContainerTypeNormal) -> "container-type: normal;"
+func ContainerType(value ContainerTypeValue) Property {
+	return Prop("container-type", value)
+}
+
+// ContentVisibilityValue is implemented by the values of the "content-visibility" property.
+type ContentVisibilityValue interface {
+	ValueNode
+	contentVisibilityValue()
+}
+
+// ContentVisibilityKeyword represents a keyword of the "content-visibility" property.
+type ContentVisibilityKeyword string
+
+func (k ContentVisibilityKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ContentVisibilityKeyword) valueNode()              {}
+func (k ContentVisibilityKeyword) contentVisibilityValue() {}
+
+// ContentVisibilityKeyword value constants.
+const (
+	ContentVisibilityVisible ContentVisibilityKeyword = "visible"
+	ContentVisibilityHidden  ContentVisibilityKeyword = "hidden"
+)
+
+// ContentVisibility creates a "content-visibility" property.
+// Syntax: visible | auto | hidden
+// Example: ContentVisibility(ContentVisibilityVisible) -> "content-visibility: visible;"
+func ContentVisibility(value ContentVisibilityValue) Property {
+	return Prop("content-visibility", value)
+}
+
+// CounterIncrementValue is implemented by the values of the "counter-increment" property.
+type CounterIncrementValue interface {
+	ValueNode
+	counterIncrementValue()
+}
+
+// CounterIncrementKeyword represents a keyword of the "counter-increment" property.
+type CounterIncrementKeyword string
+
+func (k CounterIncrementKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k CounterIncrementKeyword) valueNode()             {}
+func (k CounterIncrementKeyword) counterIncrementValue() {}
+
+// CounterIncrementKeyword value constants.
+const (
+	CounterIncrementNone CounterIncrementKeyword = "none"
+)
+
+// CounterIncrement creates a "counter-increment" property.
+// Syntax: [ <counter-name> <integer>? ]+ | none
+// Example: CounterIncrement(CounterIncrementNone) -> "counter-increment: none;"
+func CounterIncrement(values ...CounterIncrementValue) Property {
+	return Prop("counter-increment", valueNodes(values)...)
+}
+
+// CounterResetValue is implemented by the values of the "counter-reset" property.
+type CounterResetValue interface {
+	ValueNode
+	counterResetValue()
+}
+
+// CounterResetKeyword represents a keyword of the "counter-reset" property.
+type CounterResetKeyword string
+
+func (k CounterResetKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k CounterResetKeyword) valueNode()         {}
+func (k CounterResetKeyword) counterResetValue() {}
+
+// CounterResetKeyword value constants.
+const (
+	CounterResetNone CounterResetKeyword = "none"
+)
+
+// CounterReset creates a "counter-reset" property.
+// Syntax: [ <counter-name> <integer>? | <reset> ]+ | none
+// Example: CounterReset(CounterResetNone) -> "counter-reset: none;"
+func CounterReset(values ...CounterResetValue) Property {
+	return Prop("counter-reset", valueNodes(values)...)
+}
+
+// CounterSetValue is implemented by the values of the "counter-set" property.
+type CounterSetValue interface {
+	ValueNode
+	counterSetValue()
+}
+
+// CounterSetKeyword represents a keyword of the "counter-set" property.
+type CounterSetKeyword string
+
+func (k CounterSetKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k CounterSetKeyword) valueNode()       {}
+func (k CounterSetKeyword) counterSetValue() {}
+
+// CounterSetKeyword value constants.
+const (
+	CounterSetNone CounterSetKeyword = "none"
+)
+
+// CounterSet creates a "counter-set" property.
+// Syntax: [ <counter-name> <integer>? ]+ | none
+// Example: CounterSet(CounterSetNone) -> "counter-set: none;"
+func CounterSet(values ...CounterSetValue) Property {
+	return Prop("counter-set", valueNodes(values)...)
+}
+
+// CursorValue is implemented by the values of the "cursor" property.
+type CursorValue interface {
+	ValueNode
+	cursorValue()
+}
+
+// CursorKeyword represents a keyword of the "cursor" property.
+type CursorKeyword string
+
+func (k CursorKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k CursorKeyword) valueNode()   {}
+func (k CursorKeyword) cursorValue() {}
+
+// CursorKeyword value constants.
+const (
+	CursorDefault      CursorKeyword = "default"
+	CursorNone         CursorKeyword = "none"
+	CursorContextMenu  CursorKeyword = "context-menu"
+	CursorHelp         CursorKeyword = "help"
+	CursorPointer      CursorKeyword = "pointer"
+	CursorProgress     CursorKeyword = "progress"
+	CursorWait         CursorKeyword = "wait"
+	CursorCell         CursorKeyword = "cell"
+	CursorCrosshair    CursorKeyword = "crosshair"
+	CursorText         CursorKeyword = "text"
+	CursorVerticalText CursorKeyword = "vertical-text"
+	CursorAlias        CursorKeyword = "alias"
+	CursorCopy         CursorKeyword = "copy"
+	CursorMove         CursorKeyword = "move"
+	CursorNoDrop       CursorKeyword = "no-drop"
+	CursorNotAllowed   CursorKeyword = "not-allowed"
+	CursorEResize      CursorKeyword = "e-resize"
+	CursorNResize      CursorKeyword = "n-resize"
+	CursorNeResize     CursorKeyword = "ne-resize"
+	CursorNwResize     CursorKeyword = "nw-resize"
+	CursorSResize      CursorKeyword = "s-resize"
+	CursorSeResize     CursorKeyword = "se-resize"
+	CursorSwResize     CursorKeyword = "sw-resize"
+	CursorWResize      CursorKeyword = "w-resize"
+	CursorEwResize     CursorKeyword = "ew-resize"
+	CursorNsResize     CursorKeyword = "ns-resize"
+	CursorNeswResize   CursorKeyword = "nesw-resize"
+	CursorNwseResize   CursorKeyword = "nwse-resize"
+	CursorColResize    CursorKeyword = "col-resize"
+	CursorRowResize    CursorKeyword = "row-resize"
+	CursorAllScroll    CursorKeyword = "all-scroll"
+	CursorZoomIn       CursorKeyword = "zoom-in"
+	CursorZoomOut      CursorKeyword = "zoom-out"
+	CursorGrab         CursorKeyword = "grab"
+	CursorGrabbing     CursorKeyword = "grabbing"
+)
+
+// Cursor creates a "cursor" property.
+// Syntax: [ [ <url> [ <x> <y> ]? , ]* [ auto | default | none | context-menu | help | pointer | progress | wait | cell | crosshair | text | vertical-text | alias | copy | move | no-drop | not-allowed | e-resize | n-resize | ne-resize | nw-resize | s-resize | se-resize | sw-resize | w-resize | ew-resize | ns-resize | nesw-resize | nwse-resize | col-resize | row-resize | all-scroll | zoom-in | zoom-out | grab | grabbing ] ]
+// Example: Cursor(CursorDefault) -> "cursor: default;"
+func Cursor(values ...CursorValue) Property {
+	return Prop("cursor", valueNodes(values)...)
+}
+
+// CxValue is implemented by the values of the "cx" property.
+type CxValue interface {
+	ValueNode
+	cxValue()
+}
+
+// Cx creates a "cx" property.
+// Syntax: <length> | <percentage>
+// Example: Cx(PX(1)) -> "cx: 1px;"
+func Cx(value CxValue) Property {
+	return Prop("cx", value)
+}
+
+// CyValue is implemented by the values of the "cy" property.
+type CyValue interface {
+	ValueNode
+	cyValue()
+}
+
+// Cy creates a "cy" property.
+// Syntax: <length> | <percentage>
+// Example: Cy(PX(1)) -> "cy: 1px;"
+func Cy(value CyValue) Property {
+	return Prop("cy", value)
+}
+
+// DValue is implemented by the values of the "d" property.
+type DValue interface {
+	ValueNode
+	dValue()
+}
+
+// DKeyword represents a keyword of the "d" property.
+type DKeyword string
+
+func (k DKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k DKeyword) valueNode() {}
+func (k DKeyword) dValue()    {}
+
+// DKeyword value constants.
+const (
+	DNone DKeyword = "none"
+)
+
+// D creates a "d" property.
+// Syntax: none | <path()>
+// Example: D(DNone) -> "d: none;"
+func D(value DValue) Property {
+	return Prop("d", value)
+}
+
+// DirectionValue is implemented by the values of the "direction" property.
+type DirectionValue interface {
+	ValueNode
+	directionValue()
+}
+
+// DirectionKeyword represents a keyword of the "direction" property.
+type DirectionKeyword string
+
+func (k DirectionKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k DirectionKeyword) valueNode()      {}
+func (k DirectionKeyword) directionValue() {}
+
+// DirectionKeyword value constants.
+const (
+	DirectionLtr DirectionKeyword = "ltr"
+	DirectionRtl DirectionKeyword = "rtl"
+)
+
+// DirectionProp creates a "direction" property.
+// Syntax: ltr | rtl
+// Example: DirectionProp(DirectionLtr) -> "direction: ltr;"
+func DirectionProp(value DirectionValue) Property {
+	return Prop("direction", value)
+}
+
+// DominantBaselineValue is implemented by the values of the "dominant-baseline" property.
+type DominantBaselineValue interface {
+	ValueNode
+	dominantBaselineValue()
+}
+
+// DominantBaselineKeyword represents a keyword of the "dominant-baseline" property.
+type DominantBaselineKeyword string
+
+func (k DominantBaselineKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k DominantBaselineKeyword) valueNode()             {}
+func (k DominantBaselineKeyword) dominantBaselineValue() {}
+
+// DominantBaselineKeyword value constants.
+const (
+	DominantBaselineTextBottom   DominantBaselineKeyword = "text-bottom"
+	DominantBaselineAlphabetic   DominantBaselineKeyword = "alphabetic"
+	DominantBaselineIdeographic  DominantBaselineKeyword = "ideographic"
+	DominantBaselineMiddle       DominantBaselineKeyword = "middle"
+	DominantBaselineCentral      DominantBaselineKeyword = "central"
+	DominantBaselineMathematical DominantBaselineKeyword = "mathematical"
+	DominantBaselineHanging      DominantBaselineKeyword = "hanging"
+	DominantBaselineTextTop      DominantBaselineKeyword = "text-top"
+)
+
+// DominantBaseline creates a "dominant-baseline" property.
+// Syntax: auto | text-bottom | alphabetic | ideographic | middle | central | mathematical | hanging | text-top
+// Example: DominantBaseline(DominantBaselineTextBottom) -> "dominant-baseline: text-bottom;"
+func DominantBaseline(value DominantBaselineValue) Property {
+	return Prop("dominant-baseline", value)
+}
+
+// DynamicRangeLimitValue is implemented by the values of the "dynamic-range-limit" property.
+type DynamicRangeLimitValue interface {
+	ValueNode
+	dynamicRangeLimitValue()
+}
+
+// DynamicRangeLimitKeyword represents a keyword of the "dynamic-range-limit" property.
+type DynamicRangeLimitKeyword string
+
+func (k DynamicRangeLimitKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k DynamicRangeLimitKeyword) valueNode()              {}
+func (k DynamicRangeLimitKeyword) dynamicRangeLimitValue() {}
+
+// DynamicRangeLimitKeyword value constants.
+const (
+	DynamicRangeLimitStandard    DynamicRangeLimitKeyword = "standard"
+	DynamicRangeLimitNoLimit     DynamicRangeLimitKeyword = "no-limit"
+	DynamicRangeLimitConstrained DynamicRangeLimitKeyword = "constrained"
+)
+
+// DynamicRangeLimit creates a "dynamic-range-limit" property.
+// Syntax: standard | no-limit | constrained
+// Example: DynamicRangeLimit(DynamicRangeLimitStandard) -> "dynamic-range-limit: standard;"
+func DynamicRangeLimit(value DynamicRangeLimitValue) Property {
+	return Prop("dynamic-range-limit", value)
+}
+
+// EmptyCellsValue is implemented by the values of the "empty-cells" property.
+type EmptyCellsValue interface {
+	ValueNode
+	emptyCellsValue()
+}
+
+// EmptyCellsKeyword represents a keyword of the "empty-cells" property.
+type EmptyCellsKeyword string
+
+func (k EmptyCellsKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k EmptyCellsKeyword) valueNode()       {}
+func (k EmptyCellsKeyword) emptyCellsValue() {}
+
+// EmptyCellsKeyword value constants.
+const (
+	EmptyCellsShow EmptyCellsKeyword = "show"
+	EmptyCellsHide EmptyCellsKeyword = "hide"
+)
+
+// EmptyCells creates an "empty-cells" property.
+// Syntax: show | hide
+// Example: EmptyCells(EmptyCellsShow) -> "empty-cells: show;"
+func EmptyCells(value EmptyCellsValue) Property {
+	return Prop("empty-cells", value)
+}
+
+// FieldSizingValue is implemented by the values of the "field-sizing" property.
+type FieldSizingValue interface {
+	ValueNode
+	fieldSizingValue()
+}
+
+// FieldSizingKeyword represents a keyword of the "field-sizing" property.
+type FieldSizingKeyword string
+
+func (k FieldSizingKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FieldSizingKeyword) valueNode()        {}
+func (k FieldSizingKeyword) fieldSizingValue() {}
+
+// FieldSizingKeyword value constants.
+const (
+	FieldSizingContent FieldSizingKeyword = "content"
+	FieldSizingFixed   FieldSizingKeyword = "fixed"
+)
+
+// FieldSizing creates a "field-sizing" property.
+// Syntax: content | fixed
+// Example: FieldSizing(FieldSizingContent) -> "field-sizing: content;"
+func FieldSizing(value FieldSizingValue) Property {
+	return Prop("field-sizing", value)
+}
+
+// FillValue is implemented by the values of the "fill" property.
+type FillValue interface {
+	ValueNode
+	fillValue()
+}
+
+// FillKeyword represents a keyword of the "fill" property.
+type FillKeyword string
+
+func (k FillKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FillKeyword) valueNode() {}
+func (k FillKeyword) fillValue() {}
+
+// FillKeyword value constants.
+const (
+	FillNone          FillKeyword = "none"
+	FillContextFill   FillKeyword = "context-fill"
+	FillContextStroke FillKeyword = "context-stroke"
+)
+
+// Fill creates a "fill" property.
+// Syntax: <paint>
+// Example: Fill(FillNone) -> "fill: none;"
+func Fill(values ...FillValue) Property {
+	return Prop("fill", valueNodes(values)...)
+}
+
+// FillOpacityValue is implemented by the values of the "fill-opacity" property.
+type FillOpacityValue interface {
+	ValueNode
+	fillOpacityValue()
+}
+
+// FillOpacity creates a "fill-opacity" property.
+// Syntax: <alpha-value>
+// Example: FillOpacity(Num(0.5)) -> "fill-opacity: 0.5;"
+func FillOpacity(value FillOpacityValue) Property {
+	return Prop("fill-opacity", value)
+}
+
+// FillRuleValue is implemented by the values of the "fill-rule" property.
+type FillRuleValue interface {
+	ValueNode
+	fillRuleValue()
+}
+
+// FillRuleKeyword represents a keyword of the "fill-rule" property.
+type FillRuleKeyword string
+
+func (k FillRuleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FillRuleKeyword) valueNode()     {}
+func (k FillRuleKeyword) fillRuleValue() {}
+
+// FillRuleKeyword value constants.
+const (
+	FillRuleNonzero FillRuleKeyword = "nonzero"
+	FillRuleEvenodd FillRuleKeyword = "evenodd"
+)
+
+// FillRule creates a "fill-rule" property.
+// Syntax: nonzero | evenodd
+// Example: FillRule(FillRuleNonzero) -> "fill-rule: nonzero;"
+func FillRule(value FillRuleValue) Property {
+	return Prop("fill-rule", value)
+}
+
+// FilterValue is implemented by the values of the "filter" property.
+type FilterValue interface {
+	ValueNode
+	filterValue()
+}
+
+// FilterKeyword represents a keyword of the "filter" property.
+type FilterKeyword string
+
+func (k FilterKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FilterKeyword) valueNode()   {}
+func (k FilterKeyword) filterValue() {}
+
+// FilterKeyword value constants.
+const (
+	FilterNone FilterKeyword = "none"
+)
+
+// Filter creates a "filter" property.
+// Syntax: none | <filter-function-list>
+// Example: Filter(FilterNone) -> "filter: none;"
+func Filter(values ...FilterValue) Property {
+	return Prop("filter", valueNodes(values)...)
+}
+
+// FlexValue is implemented by the values of the "flex" property.
+type FlexValue interface {
+	ValueNode
+	flexValue()
+}
+
+// FlexKeyword represents a keyword of the "flex" property.
+type FlexKeyword string
+
+func (k FlexKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FlexKeyword) valueNode() {}
+func (k FlexKeyword) flexValue() {}
+
+// FlexKeyword value constants.
+const (
+	FlexNone FlexKeyword = "none"
+)
+
+// FlexProp creates a "flex" property.
+// Syntax: none | [ <'flex-grow'> <'flex-shrink'>? || <'flex-basis'> ]
+// Example: FlexProp(FlexNone) -> "flex: none;"
+func FlexProp(values ...FlexValue) Property {
+	return Prop("flex", valueNodes(values)...)
+}
+
+// FlexBasisValue is implemented by the values of the "flex-basis" property.
+type FlexBasisValue interface {
+	ValueNode
+	flexBasisValue()
+}
+
+// FlexBasisKeyword represents a keyword of the "flex-basis" property.
+type FlexBasisKeyword string
+
+func (k FlexBasisKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FlexBasisKeyword) valueNode()      {}
+func (k FlexBasisKeyword) flexBasisValue() {}
+
+// FlexBasisKeyword also implements the value interfaces of the shorthands of "flex-basis".
+func (k FlexBasisKeyword) flexValue() {}
+
+// FlexBasisKeyword value constants.
+const (
+	FlexBasisContent    FlexBasisKeyword = "content"
+	FlexBasisMinContent FlexBasisKeyword = "min-content"
+	FlexBasisMaxContent FlexBasisKeyword = "max-content"
+	FlexBasisFitContent FlexBasisKeyword = "fit-content"
+)
+
+// FlexBasis creates a "flex-basis" property.
+// Syntax: content | <'width'>
+// Example: FlexBasis(FlexBasisContent) -> "flex-basis: content;"
+func FlexBasis(value FlexBasisValue) Property {
+	return Prop("flex-basis", value)
+}
+
+// FlexFlowValue is implemented by the values of the "flex-flow" property.
+type FlexFlowValue interface {
+	ValueNode
+	flexFlowValue()
+}
+
+// FlexFlowKeyword represents a keyword of the "flex-flow" property.
+type FlexFlowKeyword string
+
+func (k FlexFlowKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FlexFlowKeyword) valueNode()     {}
+func (k FlexFlowKeyword) flexFlowValue() {}
+
+// FlexFlowKeyword value constants.
+const (
+	FlexFlowRow           FlexFlowKeyword = "row"
+	FlexFlowRowReverse    FlexFlowKeyword = "row-reverse"
+	FlexFlowColumn        FlexFlowKeyword = "column"
+	FlexFlowColumnReverse FlexFlowKeyword = "column-reverse"
+)
+
+// FlexFlow creates a "flex-flow" property.
+// Syntax: <'flex-direction'> || <'flex-wrap'>
+// Example: FlexFlow(FlexFlowRow) -> "flex-flow: row;"
+func FlexFlow(values ...FlexFlowValue) Property {
+	return Prop("flex-flow", valueNodes(values)...)
+}
+
+// FlexGrowValue is implemented by the values of the "flex-grow" property.
+type FlexGrowValue interface {
+	ValueNode
+	flexGrowValue()
+}
+
+// FlexGrow creates a "flex-grow" property.
+// Syntax: <number [0,∞]>
+// Example: FlexGrow(Num(0.5)) -> "flex-grow: 0.5;"
+func FlexGrow(value FlexGrowValue) Property {
+	return Prop("flex-grow", value)
+}
+
+// FlexShrinkValue is implemented by the values of the "flex-shrink" property.
+type FlexShrinkValue interface {
+	ValueNode
+	flexShrinkValue()
+}
+
+// FlexShrink creates a "flex-shrink" property.
+// Syntax: <number [0,∞]>
+// Example: FlexShrink(Num(0.5)) -> "flex-shrink: 0.5;"
+func FlexShrink(value FlexShrinkValue) Property {
+	return Prop("flex-shrink", value)
+}
+
+// FlexWrapValue is implemented by the values of the "flex-wrap" property.
+type FlexWrapValue interface {
+	ValueNode
+	flexWrapValue()
+}
+
+// FlexWrapKeyword represents a keyword of the "flex-wrap" property.
+type FlexWrapKeyword string
+
+func (k FlexWrapKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FlexWrapKeyword) valueNode()     {}
+func (k FlexWrapKeyword) flexWrapValue() {}
+
+// FlexWrapKeyword also implements the value interfaces of the shorthands of "flex-wrap".
+func (k FlexWrapKeyword) flexFlowValue() {}
+
+// FlexWrapKeyword value constants.
+const (
+	FlexWrapNowrap      FlexWrapKeyword = "nowrap"
+	FlexWrapWrap        FlexWrapKeyword = "wrap"
+	FlexWrapWrapReverse FlexWrapKeyword = "wrap-reverse"
+)
+
+// FlexWrap creates a "flex-wrap" property.
+// Syntax: nowrap | wrap | wrap-reverse
+// Example: FlexWrap(FlexWrapNowrap) -> "flex-wrap: nowrap;"
+func FlexWrap(value FlexWrapValue) Property {
+	return Prop("flex-wrap", value)
+}
+
+// FloatValue is implemented by the values of the "float" property.
+type FloatValue interface {
+	ValueNode
+	floatValue()
+}
+
+// FloatKeyword represents a keyword of the "float" property.
+type FloatKeyword string
+
+func (k FloatKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FloatKeyword) valueNode()  {}
+func (k FloatKeyword) floatValue() {}
+
+// FloatKeyword value constants.
+const (
+	FloatLeft        FloatKeyword = "left"
+	FloatRight       FloatKeyword = "right"
+	FloatNone        FloatKeyword = "none"
+	FloatInlineStart FloatKeyword = "inline-start"
+	FloatInlineEnd   FloatKeyword = "inline-end"
+)
+
+// Float creates a "float" property.
+// Syntax: left | right | none | inline-start | inline-end
+// Example: Float(FloatLeft) -> "float: left;"
+func Float(value FloatValue) Property {
+	return Prop("float", value)
+}
+
+// FloodColorValue is implemented by the values of the "flood-color" property.
+type FloodColorValue interface {
+	ValueNode
+	floodColorValue()
+}
+
+// FloodColor creates a "flood-color" property.
+// Syntax: <color>
+// Example: FloodColor(Red) -> "flood-color: red;"
+func FloodColor(value FloodColorValue) Property {
+	return Prop("flood-color", value)
+}
+
+// FloodOpacityValue is implemented by the values of the "flood-opacity" property.
+type FloodOpacityValue interface {
+	ValueNode
+	floodOpacityValue()
+}
+
+// FloodOpacity creates a "flood-opacity" property.
+// Syntax: <alpha-value>
+// Example: FloodOpacity(Num(0.5)) -> "flood-opacity: 0.5;"
+func FloodOpacity(value FloodOpacityValue) Property {
+	return Prop("flood-opacity", value)
+}
+
+// FontValue is implemented by the values of the "font" property.
+type FontValue interface {
+	ValueNode
+	fontValue()
+}
+
+// FontKeyword represents a keyword of the "font" property.
+type FontKeyword string
+
+func (k FontKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontKeyword) valueNode() {}
+func (k FontKeyword) fontValue() {}
+
+// FontKeyword value constants.
+const (
+	FontCaption      FontKeyword = "caption"
+	FontIcon         FontKeyword = "icon"
+	FontMenu         FontKeyword = "menu"
+	FontMessageBox   FontKeyword = "message-box"
+	FontSmallCaption FontKeyword = "small-caption"
+	FontStatusBar    FontKeyword = "status-bar"
+)
+
+// Font creates a "font" property.
+// Syntax: [ [ <'font-style'> || <font-variant-css2> || <'font-weight'> || <font-width-css3> ]? <'font-size'> [ / <'line-height'> ]? <'font-family'> ] | caption | icon | menu | message-box | small-caption | status-bar
+// Example: Font(FontCaption) -> "font: caption;"
+func Font(values ...FontValue) Property {
+	return Prop("font", valueNodes(values)...)
+}
+
+// FontFeatureSettingsValue is implemented by the values of the "font-feature-settings" property.
+type FontFeatureSettingsValue interface {
+	ValueNode
+	fontFeatureSettingsValue()
+}
+
+// FontFeatureSettingsKeyword represents a keyword of the "font-feature-settings" property.
+type FontFeatureSettingsKeyword string
+
+func (k FontFeatureSettingsKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontFeatureSettingsKeyword) valueNode()                {}
+func (k FontFeatureSettingsKeyword) fontFeatureSettingsValue() {}
+
+// FontFeatureSettingsKeyword value constants.
+const (
+	FontFeatureSettingsNormal FontFeatureSettingsKeyword = "normal"
+)
+
+// FontFeatureSettings creates a "font-feature-settings" property.
+// Syntax: normal | <feature-tag-value>#
+// Example: FontFeatureSettings(Str("liga"), Int(1)) -> "font-feature-settings: "liga" 1;"
+// It sets a single layer; use FontFeatureSettingsLayers for several.
+func FontFeatureSettings(values ...FontFeatureSettingsValue) Property {
+	return Prop("font-feature-settings", valueNodes(values)...)
+}
+
+// FontFeatureSettingsLayers creates a "font-feature-settings" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: FontFeatureSettingsLayers([]FontFeatureSettingsValue{Str("liga"), Int(1)}, []FontFeatureSettingsValue{Str("liga"), Int(1)}) -> "font-feature-settings: "liga" 1, "liga" 1;"
+func FontFeatureSettingsLayers(layers ...[]FontFeatureSettingsValue) Property {
+	return commaProp("font-feature-settings", layerNodes(layers)...)
+}
+
+// FontKerningValue is implemented by the values of the "font-kerning" property.
+type FontKerningValue interface {
+	ValueNode
+	fontKerningValue()
+}
+
+// FontKerningKeyword represents a keyword of the "font-kerning" property.
+type FontKerningKeyword string
+
+func (k FontKerningKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontKerningKeyword) valueNode()        {}
+func (k FontKerningKeyword) fontKerningValue() {}
+
+// FontKerningKeyword value constants.
+const (
+	FontKerningNormal FontKerningKeyword = "normal"
+	FontKerningNone   FontKerningKeyword = "none"
+)
+
+// FontKerning creates a "font-kerning" property.
+// Syntax: auto | normal | none
+// Example: FontKerning(FontKerningNormal) -> "font-kerning: normal;"
+func FontKerning(value FontKerningValue) Property {
+	return Prop("font-kerning", value)
+}
+
+// FontLanguageOverrideValue is implemented by the values of the "font-language-override" property.
+type FontLanguageOverrideValue interface {
+	ValueNode
+	fontLanguageOverrideValue()
+}
+
+// FontLanguageOverrideKeyword represents a keyword of the "font-language-override" property.
+type FontLanguageOverrideKeyword string
+
+func (k FontLanguageOverrideKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontLanguageOverrideKeyword) valueNode()                 {}
+func (k FontLanguageOverrideKeyword) fontLanguageOverrideValue() {}
+
+// FontLanguageOverrideKeyword value constants.
+const (
+	FontLanguageOverrideNormal FontLanguageOverrideKeyword = "normal"
+)
+
+// FontLanguageOverride creates a "font-language-override" property.
+// Syntax: normal | <string>
+// Example: FontLanguageOverride(FontLanguageOverrideNormal) -> "font-language-override: normal;"
+func FontLanguageOverride(value FontLanguageOverrideValue) Property {
+	return Prop("font-language-override", value)
+}
+
+// FontOpticalSizingValue is implemented by the values of the "font-optical-sizing" property.
+type FontOpticalSizingValue interface {
+	ValueNode
+	fontOpticalSizingValue()
+}
+
+// FontOpticalSizingKeyword represents a keyword of the "font-optical-sizing" property.
+type FontOpticalSizingKeyword string
+
+func (k FontOpticalSizingKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontOpticalSizingKeyword) valueNode()              {}
+func (k FontOpticalSizingKeyword) fontOpticalSizingValue() {}
+
+// FontOpticalSizingKeyword value constants.
+const (
+	FontOpticalSizingNone FontOpticalSizingKeyword = "none"
+)
+
+// FontOpticalSizing creates a "font-optical-sizing" property.
+// Syntax: auto | none
+// Example: FontOpticalSizing(FontOpticalSizingNone) -> "font-optical-sizing: none;"
+func FontOpticalSizing(value FontOpticalSizingValue) Property {
+	return Prop("font-optical-sizing", value)
+}
+
+// FontPaletteValue is implemented by the values of the "font-palette" property.
+type FontPaletteValue interface {
+	ValueNode
+	fontPaletteValue()
+}
+
+// FontPaletteKeyword represents a keyword of the "font-palette" property.
+type FontPaletteKeyword string
+
+func (k FontPaletteKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontPaletteKeyword) valueNode()        {}
+func (k FontPaletteKeyword) fontPaletteValue() {}
+
+// FontPaletteKeyword value constants.
+const (
+	FontPaletteNormal FontPaletteKeyword = "normal"
+	FontPaletteLight  FontPaletteKeyword = "light"
+	FontPaletteDark   FontPaletteKeyword = "dark"
+)
+
+// FontPalette creates a "font-palette" property.
+// Syntax: normal | light | dark | <palette-identifier>
+// Example: FontPalette(FontPaletteNormal) -> "font-palette: normal;"
+func FontPalette(value FontPaletteValue) Property {
+	return Prop("font-palette", value)
+}
+
+// FontSizeAdjustValue is implemented by the values of the "font-size-adjust" property.
+type FontSizeAdjustValue interface {
+	ValueNode
+	fontSizeAdjustValue()
+}
+
+// FontSizeAdjustKeyword represents a keyword of the "font-size-adjust" property.
+type FontSizeAdjustKeyword string
+
+func (k FontSizeAdjustKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontSizeAdjustKeyword) valueNode()           {}
+func (k FontSizeAdjustKeyword) fontSizeAdjustValue() {}
+
+// FontSizeAdjustKeyword value constants.
+const (
+	FontSizeAdjustNone     FontSizeAdjustKeyword = "none"
+	FontSizeAdjustFromFont FontSizeAdjustKeyword = "from-font"
+)
+
+// FontSizeAdjust creates a "font-size-adjust" property.
+// Syntax: none | [ ex-height | cap-height | ch-width | ic-width | ic-height ]? [ from-font | <number> ]
+// Example: FontSizeAdjust(FontSizeAdjustNone) -> "font-size-adjust: none;"
+func FontSizeAdjust(values ...FontSizeAdjustValue) Property {
+	return Prop("font-size-adjust", valueNodes(values)...)
+}
+
+// FontStretchValue is implemented by the values of the "font-stretch" property.
+type FontStretchValue interface {
+	ValueNode
+	fontStretchValue()
+}
+
+// FontStretchKeyword represents a keyword of the "font-stretch" property.
+type FontStretchKeyword string
+
+func (k FontStretchKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontStretchKeyword) valueNode()        {}
+func (k FontStretchKeyword) fontStretchValue() {}
+
+// FontStretchKeyword also implements the value interfaces of the shorthands of "font-stretch".
+func (k FontStretchKeyword) fontValue() {}
+
+// FontStretchKeyword value constants.
+const (
+	FontStretchNormal         FontStretchKeyword = "normal"
+	FontStretchUltraCondensed FontStretchKeyword = "ultra-condensed"
+	FontStretchExtraCondensed FontStretchKeyword = "extra-condensed"
+	FontStretchCondensed      FontStretchKeyword = "condensed"
+	FontStretchSemiCondensed  FontStretchKeyword = "semi-condensed"
+	FontStretchSemiExpanded   FontStretchKeyword = "semi-expanded"
+	FontStretchExpanded       FontStretchKeyword = "expanded"
+	FontStretchExtraExpanded  FontStretchKeyword = "extra-expanded"
+	FontStretchUltraExpanded  FontStretchKeyword = "ultra-expanded"
+)
+
+// FontStretch creates a "font-stretch" property.
+// Syntax: <font-stretch-absolute>
+// Example: FontStretch(FontStretchNormal) -> "font-stretch: normal;"
+func FontStretch(value FontStretchValue) Property {
+	return Prop("font-stretch", value)
+}
+
+// FontStyleValue is implemented by the values of the "font-style" property.
+type FontStyleValue interface {
+	ValueNode
+	fontStyleValue()
+}
+
+// FontStyleKeyword represents a keyword of the "font-style" property.
+type FontStyleKeyword string
+
+func (k FontStyleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontStyleKeyword) valueNode()      {}
+func (k FontStyleKeyword) fontStyleValue() {}
+
+// FontStyleKeyword also implements the value interfaces of the shorthands of "font-style".
+func (k FontStyleKeyword) fontValue() {}
+
+// FontStyleKeyword value constants.
+const (
+	FontStyleNormal  FontStyleKeyword = "normal"
+	FontStyleItalic  FontStyleKeyword = "italic"
+	FontStyleOblique FontStyleKeyword = "oblique"
+)
+
+// FontStyle creates a "font-style" property.
+// Syntax: normal | italic | oblique <angle [-90deg,90deg]>?
+// Example: FontStyle(FontStyleNormal) -> "font-style: normal;"
+func FontStyle(values ...FontStyleValue) Property {
+	return Prop("font-style", valueNodes(values)...)
+}
+
+// FontSynthesisValue is implemented by the values of the "font-synthesis" property.
+type FontSynthesisValue interface {
+	ValueNode
+	fontSynthesisValue()
+}
+
+// FontSynthesisKeyword represents a keyword of the "font-synthesis" property.
+type FontSynthesisKeyword string
+
+func (k FontSynthesisKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontSynthesisKeyword) valueNode()          {}
+func (k FontSynthesisKeyword) fontSynthesisValue() {}
+
+// FontSynthesisKeyword value constants.
+const (
+	FontSynthesisNone             FontSynthesisKeyword = "none"
+	FontSynthesisKeywordWeight    FontSynthesisKeyword = "weight"
+	FontSynthesisKeywordStyle     FontSynthesisKeyword = "style"
+	FontSynthesisKeywordSmallCaps FontSynthesisKeyword = "small-caps"
+	FontSynthesisKeywordPosition  FontSynthesisKeyword = "position"
+)
+
+// FontSynthesis creates a "font-synthesis" property.
+// Syntax: none | [ weight || style || small-caps || position ]
+// Example: FontSynthesis(FontSynthesisNone) -> "font-synthesis: none;"
+func FontSynthesis(values ...FontSynthesisValue) Property {
+	return Prop("font-synthesis", valueNodes(values)...)
+}
+
+// FontSynthesisPositionValue is implemented by the values of the "font-synthesis-position" property.
+type FontSynthesisPositionValue interface {
+	ValueNode
+	fontSynthesisPositionValue()
+}
+
+// FontSynthesisPositionKeyword represents a keyword of the "font-synthesis-position" property.
+type FontSynthesisPositionKeyword string
+
+func (k FontSynthesisPositionKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontSynthesisPositionKeyword) valueNode()                  {}
+func (k FontSynthesisPositionKeyword) fontSynthesisPositionValue() {}
+
+// FontSynthesisPositionKeyword value constants.
+const (
+	FontSynthesisPositionNone FontSynthesisPositionKeyword = "none"
+)
+
+// FontSynthesisPosition creates a "font-synthesis-position" property.
+// Syntax: auto | none
+// Example: FontSynthesisPosition(FontSynthesisPositionNone) -> "font-synthesis-position: none;"
+func FontSynthesisPosition(value FontSynthesisPositionValue) Property {
+	return Prop("font-synthesis-position", value)
+}
+
+// FontSynthesisSmallCapsValue is implemented by the values of the "font-synthesis-small-caps" property.
+type FontSynthesisSmallCapsValue interface {
+	ValueNode
+	fontSynthesisSmallCapsValue()
+}
+
+// FontSynthesisSmallCapsKeyword represents a keyword of the "font-synthesis-small-caps" property.
+type FontSynthesisSmallCapsKeyword string
+
+func (k FontSynthesisSmallCapsKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontSynthesisSmallCapsKeyword) valueNode()                   {}
+func (k FontSynthesisSmallCapsKeyword) fontSynthesisSmallCapsValue() {}
+
+// FontSynthesisSmallCapsKeyword value constants.
+const (
+	FontSynthesisSmallCapsNone FontSynthesisSmallCapsKeyword = "none"
+)
+
+// FontSynthesisSmallCaps creates a "font-synthesis-small-caps" property.
+// Syntax: auto | none
+// Example: FontSynthesisSmallCaps(FontSynthesisSmallCapsNone) -> "font-synthesis-small-caps: none;"
+func FontSynthesisSmallCaps(value FontSynthesisSmallCapsValue) Property {
+	return Prop("font-synthesis-small-caps", value)
+}
+
+// FontSynthesisStyleValue is implemented by the values of the "font-synthesis-style" property.
+type FontSynthesisStyleValue interface {
+	ValueNode
+	fontSynthesisStyleValue()
+}
+
+// FontSynthesisStyleKeyword represents a keyword of the "font-synthesis-style" property.
+type FontSynthesisStyleKeyword string
+
+func (k FontSynthesisStyleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontSynthesisStyleKeyword) valueNode()               {}
+func (k FontSynthesisStyleKeyword) fontSynthesisStyleValue() {}
+
+// FontSynthesisStyleKeyword value constants.
+const (
+	FontSynthesisStyleNone FontSynthesisStyleKeyword = "none"
+)
+
+// FontSynthesisStyle creates a "font-synthesis-style" property.
+// Syntax: auto | none
+// Example: FontSynthesisStyle(FontSynthesisStyleNone) -> "font-synthesis-style: none;"
+func FontSynthesisStyle(value FontSynthesisStyleValue) Property {
+	return Prop("font-synthesis-style", value)
+}
+
+// FontSynthesisWeightValue is implemented by the values of the "font-synthesis-weight" property.
+type FontSynthesisWeightValue interface {
+	ValueNode
+	fontSynthesisWeightValue()
+}
+
+// FontSynthesisWeightKeyword represents a keyword of the "font-synthesis-weight" property.
+type FontSynthesisWeightKeyword string
+
+func (k FontSynthesisWeightKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontSynthesisWeightKeyword) valueNode()                {}
+func (k FontSynthesisWeightKeyword) fontSynthesisWeightValue() {}
+
+// FontSynthesisWeightKeyword value constants.
+const (
+	FontSynthesisWeightNone FontSynthesisWeightKeyword = "none"
+)
+
+// FontSynthesisWeight creates a "font-synthesis-weight" property.
+// Syntax: auto | none
+// Example: FontSynthesisWeight(FontSynthesisWeightNone) -> "font-synthesis-weight: none;"
+func FontSynthesisWeight(value FontSynthesisWeightValue) Property {
+	return Prop("font-synthesis-weight", value)
+}
+
+// FontVariantValue is implemented by the values of the "font-variant" property.
+type FontVariantValue interface {
+	ValueNode
+	fontVariantValue()
+}
+
+// FontVariant creates a "font-variant" property.
+// Syntax: normal | none | [ <common-lig-values> || <discretionary-lig-values> || <historical-lig-values> || <contextual-alt-values> || small-caps | all-small-caps | petite-caps | all-petite-caps | unicase | titling-caps || <numeric-figure-values> || <numeric-spacing-values> || <numeric-fraction-values> || ordinal || slashed-zero || <east-asian-variant-values> || <east-asian-width-values> || ruby ]
+// Example: FontVariant(Inherit) -> "font-variant: inherit;"
+func FontVariant(values ...FontVariantValue) Property {
+	return Prop("font-variant", valueNodes(values)...)
+}
+
+// FontVariantAlternatesValue is implemented by the values of the "font-variant-alternates" property.
+type FontVariantAlternatesValue interface {
+	ValueNode
+	fontVariantAlternatesValue()
+}
+
+// FontVariantAlternatesKeyword represents a keyword of the "font-variant-alternates" property.
+type FontVariantAlternatesKeyword string
+
+func (k FontVariantAlternatesKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontVariantAlternatesKeyword) valueNode()                  {}
+func (k FontVariantAlternatesKeyword) fontVariantAlternatesValue() {}
+
+// FontVariantAlternatesKeyword value constants.
+const (
+	FontVariantAlternatesNormal          FontVariantAlternatesKeyword = "normal"
+	FontVariantAlternatesHistoricalForms FontVariantAlternatesKeyword = "historical-forms"
+)
+
+// FontVariantAlternates creates a "font-variant-alternates" property.
+// Syntax: normal | [ stylistic( <feature-value-name> ) || historical-forms || styleset( <feature-value-name># ) || character-variant( <feature-value-name># ) || swash( <feature-value-name> ) || ornaments( <feature-value-name> ) || annotation( <feature-value-name> ) ]
+// Example: FontVariantAlternates(FontVariantAlternatesNormal) -> "font-variant-alternates: normal;"
+func FontVariantAlternates(values ...FontVariantAlternatesValue) Property {
+	return Prop("font-variant-alternates", valueNodes(values)...)
+}
+
+// FontVariantCapsValue is implemented by the values of the "font-variant-caps" property.
+type FontVariantCapsValue interface {
+	ValueNode
+	fontVariantCapsValue()
+}
+
+// FontVariantCapsKeyword represents a keyword of the "font-variant-caps" property.
+type FontVariantCapsKeyword string
+
+func (k FontVariantCapsKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontVariantCapsKeyword) valueNode()            {}
+func (k FontVariantCapsKeyword) fontVariantCapsValue() {}
+
+// FontVariantCapsKeyword also implements the value interfaces of the shorthands of "font-variant-caps".
+func (k FontVariantCapsKeyword) fontValue()        {}
+func (k FontVariantCapsKeyword) fontVariantValue() {}
+
+// FontVariantCapsKeyword value constants.
+const (
+	FontVariantCapsNormal        FontVariantCapsKeyword = "normal"
+	FontVariantCapsSmallCaps     FontVariantCapsKeyword = "small-caps"
+	FontVariantCapsAllSmallCaps  FontVariantCapsKeyword = "all-small-caps"
+	FontVariantCapsPetiteCaps    FontVariantCapsKeyword = "petite-caps"
+	FontVariantCapsAllPetiteCaps FontVariantCapsKeyword = "all-petite-caps"
+	FontVariantCapsUnicase       FontVariantCapsKeyword = "unicase"
+	FontVariantCapsTitlingCaps   FontVariantCapsKeyword = "titling-caps"
+)
+
+// FontVariantCaps creates a "font-variant-caps" property.
+// Syntax: normal | small-caps | all-small-caps | petite-caps | all-petite-caps | unicase | titling-caps
+// Example: FontVariantCaps(FontVariantCapsNormal) -> "font-variant-caps: normal;"
+func FontVariantCaps(value FontVariantCapsValue) Property {
+	return Prop("font-variant-caps", value)
+}
+
+// FontVariantEastAsianValue is implemented by the values of the "font-variant-east-asian" property.
+type FontVariantEastAsianValue interface {
+	ValueNode
+	fontVariantEastAsianValue()
+}
+
+// FontVariantEastAsianKeyword represents a keyword of the "font-variant-east-asian" property.
+type FontVariantEastAsianKeyword string
+
+func (k FontVariantEastAsianKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontVariantEastAsianKeyword) valueNode()                 {}
+func (k FontVariantEastAsianKeyword) fontVariantEastAsianValue() {}
+
+// FontVariantEastAsianKeyword also implements the value interfaces of the shorthands of "font-variant-east-asian".
+func (k FontVariantEastAsianKeyword) fontValue()        {}
+func (k FontVariantEastAsianKeyword) fontVariantValue() {}
+
+// FontVariantEastAsianKeyword value constants.
+const (
+	FontVariantEastAsianNormal            FontVariantEastAsianKeyword = "normal"
+	FontVariantEastAsianJis78             FontVariantEastAsianKeyword = "jis78"
+	FontVariantEastAsianJis83             FontVariantEastAsianKeyword = "jis83"
+	FontVariantEastAsianJis90             FontVariantEastAsianKeyword = "jis90"
+	FontVariantEastAsianJis04             FontVariantEastAsianKeyword = "jis04"
+	FontVariantEastAsianSimplified        FontVariantEastAsianKeyword = "simplified"
+	FontVariantEastAsianTraditional       FontVariantEastAsianKeyword = "traditional"
+	FontVariantEastAsianFullWidth         FontVariantEastAsianKeyword = "full-width"
+	FontVariantEastAsianProportionalWidth FontVariantEastAsianKeyword = "proportional-width"
+	FontVariantEastAsianRuby              FontVariantEastAsianKeyword = "ruby"
+)
+
+// FontVariantEastAsian creates a "font-variant-east-asian" property.
+// Syntax: normal | [ <east-asian-variant-values> || <east-asian-width-values> || ruby ]
+// Example: FontVariantEastAsian(FontVariantEastAsianNormal) -> "font-variant-east-asian: normal;"
+func FontVariantEastAsian(values ...FontVariantEastAsianValue) Property {
+	return Prop("font-variant-east-asian", valueNodes(values)...)
+}
+
+// FontVariantEmojiValue is implemented by the values of the "font-variant-emoji" property.
+type FontVariantEmojiValue interface {
+	ValueNode
+	fontVariantEmojiValue()
+}
+
+// FontVariantEmojiKeyword represents a keyword of the "font-variant-emoji" property.
+type FontVariantEmojiKeyword string
+
+func (k FontVariantEmojiKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontVariantEmojiKeyword) valueNode()             {}
+func (k FontVariantEmojiKeyword) fontVariantEmojiValue() {}
+
+// FontVariantEmojiKeyword value constants.
+const (
+	FontVariantEmojiNormal  FontVariantEmojiKeyword = "normal"
+	FontVariantEmojiText    FontVariantEmojiKeyword = "text"
+	FontVariantEmojiEmoji   FontVariantEmojiKeyword = "emoji"
+	FontVariantEmojiUnicode FontVariantEmojiKeyword = "unicode"
+)
+
+// FontVariantEmoji creates a "font-variant-emoji" property.
+// Syntax: normal | text | emoji | unicode
+// Example: FontVariantEmoji(FontVariantEmojiNormal) -> "font-variant-emoji: normal;"
+func FontVariantEmoji(value FontVariantEmojiValue) Property {
+	return Prop("font-variant-emoji", value)
+}
+
+// FontVariantLigaturesValue is implemented by the values of the "font-variant-ligatures" property.
+type FontVariantLigaturesValue interface {
+	ValueNode
+	fontVariantLigaturesValue()
+}
+
+// FontVariantLigaturesKeyword represents a keyword of the "font-variant-ligatures" property.
+type FontVariantLigaturesKeyword string
+
+func (k FontVariantLigaturesKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontVariantLigaturesKeyword) valueNode()                 {}
+func (k FontVariantLigaturesKeyword) fontVariantLigaturesValue() {}
+
+// FontVariantLigaturesKeyword also implements the value interfaces of the shorthands of "font-variant-ligatures".
+func (k FontVariantLigaturesKeyword) fontValue()        {}
+func (k FontVariantLigaturesKeyword) fontVariantValue() {}
+
+// FontVariantLigaturesKeyword value constants.
+const (
+	FontVariantLigaturesNormal                   FontVariantLigaturesKeyword = "normal"
+	FontVariantLigaturesNone                     FontVariantLigaturesKeyword = "none"
+	FontVariantLigaturesCommonLigatures          FontVariantLigaturesKeyword = "common-ligatures"
+	FontVariantLigaturesNoCommonLigatures        FontVariantLigaturesKeyword = "no-common-ligatures"
+	FontVariantLigaturesDiscretionaryLigatures   FontVariantLigaturesKeyword = "discretionary-ligatures"
+	FontVariantLigaturesNoDiscretionaryLigatures FontVariantLigaturesKeyword = "no-discretionary-ligatures"
+	FontVariantLigaturesHistoricalLigatures      FontVariantLigaturesKeyword = "historical-ligatures"
+	FontVariantLigaturesNoHistoricalLigatures    FontVariantLigaturesKeyword = "no-historical-ligatures"
+	FontVariantLigaturesContextual               FontVariantLigaturesKeyword = "contextual"
+	FontVariantLigaturesNoContextual             FontVariantLigaturesKeyword = "no-contextual"
+)
+
+// FontVariantLigatures creates a "font-variant-ligatures" property.
+// Syntax: normal | none | [ <common-lig-values> || <discretionary-lig-values> || <historical-lig-values> || <contextual-alt-values> ]
+// Example: FontVariantLigatures(FontVariantLigaturesNormal) -> "font-variant-ligatures: normal;"
+func FontVariantLigatures(values ...FontVariantLigaturesValue) Property {
+	return Prop("font-variant-ligatures", valueNodes(values)...)
+}
+
+// FontVariantNumericValue is implemented by the values of the "font-variant-numeric" property.
+type FontVariantNumericValue interface {
+	ValueNode
+	fontVariantNumericValue()
+}
+
+// FontVariantNumericKeyword represents a keyword of the "font-variant-numeric" property.
+type FontVariantNumericKeyword string
+
+func (k FontVariantNumericKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontVariantNumericKeyword) valueNode()               {}
+func (k FontVariantNumericKeyword) fontVariantNumericValue() {}
+
+// FontVariantNumericKeyword also implements the value interfaces of the shorthands of "font-variant-numeric".
+func (k FontVariantNumericKeyword) fontValue()        {}
+func (k FontVariantNumericKeyword) fontVariantValue() {}
+
+// FontVariantNumericKeyword value constants.
+const (
+	FontVariantNumericNormal            FontVariantNumericKeyword = "normal"
+	FontVariantNumericLiningNums        FontVariantNumericKeyword = "lining-nums"
+	FontVariantNumericOldstyleNums      FontVariantNumericKeyword = "oldstyle-nums"
+	FontVariantNumericProportionalNums  FontVariantNumericKeyword = "proportional-nums"
+	FontVariantNumericTabularNums       FontVariantNumericKeyword = "tabular-nums"
+	FontVariantNumericDiagonalFractions FontVariantNumericKeyword = "diagonal-fractions"
+	FontVariantNumericStackedFractions  FontVariantNumericKeyword = "stacked-fractions"
+	FontVariantNumericOrdinal           FontVariantNumericKeyword = "ordinal"
+	FontVariantNumericSlashedZero       FontVariantNumericKeyword = "slashed-zero"
+)
+
+// FontVariantNumeric creates a "font-variant-numeric" property.
+// Syntax: normal | [ <numeric-figure-values> || <numeric-spacing-values> || <numeric-fraction-values> || ordinal || slashed-zero ]
+// Example: FontVariantNumeric(FontVariantNumericNormal) -> "font-variant-numeric: normal;"
+func FontVariantNumeric(values ...FontVariantNumericValue) Property {
+	return Prop("font-variant-numeric", valueNodes(values)...)
+}
+
+// FontVariantPositionValue is implemented by the values of the "font-variant-position" property.
+type FontVariantPositionValue interface {
+	ValueNode
+	fontVariantPositionValue()
+}
+
+// FontVariantPositionKeyword represents a keyword of the "font-variant-position" property.
+type FontVariantPositionKeyword string
+
+func (k FontVariantPositionKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontVariantPositionKeyword) valueNode()                {}
+func (k FontVariantPositionKeyword) fontVariantPositionValue() {}
+
+// FontVariantPositionKeyword value constants.
+const (
+	FontVariantPositionNormal FontVariantPositionKeyword = "normal"
+	FontVariantPositionSub    FontVariantPositionKeyword = "sub"
+	FontVariantPositionSuper  FontVariantPositionKeyword = "super"
+)
+
+// FontVariantPosition creates a "font-variant-position" property.
+// Syntax: normal | sub | super
+// Example: FontVariantPosition(FontVariantPositionNormal) -> "font-variant-position: normal;"
+func FontVariantPosition(value FontVariantPositionValue) Property {
+	return Prop("font-variant-position", value)
+}
+
+// FontVariationSettingsValue is implemented by the values of the "font-variation-settings" property.
+type FontVariationSettingsValue interface {
+	ValueNode
+	fontVariationSettingsValue()
+}
+
+// FontVariationSettingsKeyword represents a keyword of the "font-variation-settings" property.
+type FontVariationSettingsKeyword string
+
+func (k FontVariationSettingsKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontVariationSettingsKeyword) valueNode()                  {}
+func (k FontVariationSettingsKeyword) fontVariationSettingsValue() {}
+
+// FontVariationSettingsKeyword value constants.
+const (
+	FontVariationSettingsNormal FontVariationSettingsKeyword = "normal"
+)
+
+// FontVariationSettings creates a "font-variation-settings" property.
+// Syntax: normal | [ <string> <number> ]#
+// Example: FontVariationSettings(Str("wght"), Num(400)) -> "font-variation-settings: "wght" 400;"
+// It sets a single layer; use FontVariationSettingsLayers for several.
+func FontVariationSettings(values ...FontVariationSettingsValue) Property {
+	return Prop("font-variation-settings", valueNodes(values)...)
+}
+
+// FontVariationSettingsLayers creates a "font-variation-settings" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: FontVariationSettingsLayers([]FontVariationSettingsValue{Str("wght"), Num(400)}, []FontVariationSettingsValue{Str("wght"), Num(400)}) -> "font-variation-settings: "wght" 400, "wght" 400;"
+func FontVariationSettingsLayers(layers ...[]FontVariationSettingsValue) Property {
+	return commaProp("font-variation-settings", layerNodes(layers)...)
+}
+
+// FontWeightValue is implemented by the values of the "font-weight" property.
+type FontWeightValue interface {
+	ValueNode
+	fontWeightValue()
+}
+
+// FontWeightKeyword represents a keyword of the "font-weight" property.
+type FontWeightKeyword string
+
+func (k FontWeightKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k FontWeightKeyword) valueNode()       {}
+func (k FontWeightKeyword) fontWeightValue() {}
+
+// FontWeightKeyword also implements the value interfaces of the shorthands of "font-weight".
+func (k FontWeightKeyword) fontValue() {}
+
+// FontWeightKeyword value constants.
+const (
+	FontWeightNormal  FontWeightKeyword = "normal"
+	FontWeightBold    FontWeightKeyword = "bold"
+	FontWeightBolder  FontWeightKeyword = "bolder"
+	FontWeightLighter FontWeightKeyword = "lighter"
+)
+
+// FontWeight creates a "font-weight" property.
+// Syntax: <font-weight-absolute> | bolder | lighter
+// Example: FontWeight(FontWeightNormal) -> "font-weight: normal;"
+func FontWeight(value FontWeightValue) Property {
+	return Prop("font-weight", value)
+}
+
+// ForcedColorAdjustValue is implemented by the values of the "forced-color-adjust" property.
+type ForcedColorAdjustValue interface {
+	ValueNode
+	forcedColorAdjustValue()
+}
+
+// ForcedColorAdjustKeyword represents a keyword of the "forced-color-adjust" property.
+type ForcedColorAdjustKeyword string
+
+func (k ForcedColorAdjustKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ForcedColorAdjustKeyword) valueNode()              {}
+func (k ForcedColorAdjustKeyword) forcedColorAdjustValue() {}
+
+// ForcedColorAdjustKeyword value constants.
+const (
+	ForcedColorAdjustNone ForcedColorAdjustKeyword = "none"
+)
+
+// ForcedColorAdjust creates a "forced-color-adjust" property.
+// Syntax: auto | none
+// Example: ForcedColorAdjust(ForcedColorAdjustNone) -> "forced-color-adjust: none;"
+func ForcedColorAdjust(value ForcedColorAdjustValue) Property {
+	return Prop("forced-color-adjust", value)
+}
+
+// GapValue is implemented by the values of the "gap" property.
+type GapValue interface {
+	ValueNode
+	gapValue()
+}
+
+// Gap creates a "gap" property.
+// Syntax: <'row-gap'> <'column-gap'>?
+// Example: Gap(PX(1)) -> "gap: 1px;"
+func Gap(values ...GapValue) Property {
+	return Prop("gap", valueNodes(values)...)
+}
+
+// GridValue is implemented by the values of the "grid" property.
+type GridValue interface {
+	ValueNode
+	gridValue()
+}
+
+// GridProp creates a "grid" property.
+// Syntax: <'grid-template'> | <'grid-template-rows'> / [ auto-flow && dense? ] <'grid-auto-columns'>? | [ auto-flow && dense? ] <'grid-auto-rows'>? / <'grid-template-columns'>
+// Example: GridProp(GridTemplateRowsNone) -> "grid: none;"
+func GridProp(values ...GridValue) Property {
+	return Prop("grid", valueNodes(values)...)
+}
+
+// GridAreaValue is implemented by the values of the "grid-area" property.
+type GridAreaValue interface {
+	ValueNode
+	gridAreaValue()
+}
+
+// GridArea creates a "grid-area" property.
+// Syntax: <grid-line> [ / <grid-line> ]{0,3}
+// Example: GridArea(Auto) -> "grid-area: auto;"
+func GridArea(values ...GridAreaValue) Property {
+	return Prop("grid-area", valueNodes(values)...)
+}
+
+// GridAutoColumnsValue is implemented by the values of the "grid-auto-columns" property.
+type GridAutoColumnsValue interface {
+	ValueNode
+	gridAutoColumnsValue()
+}
+
+// GridAutoColumnsKeyword represents a keyword of the "grid-auto-columns" property.
+type GridAutoColumnsKeyword string
+
+func (k GridAutoColumnsKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k GridAutoColumnsKeyword) valueNode()            {}
+func (k GridAutoColumnsKeyword) gridAutoColumnsValue() {}
+
+// GridAutoColumnsKeyword also implements the value interfaces of the shorthands of "grid-auto-columns".
+func (k GridAutoColumnsKeyword) gridValue() {}
+
+// GridAutoColumnsKeyword value constants.
+const (
+	GridAutoColumnsMinContent GridAutoColumnsKeyword = "min-content"
+	GridAutoColumnsMaxContent GridAutoColumnsKeyword = "max-content"
+)
+
+// GridAutoColumns creates a "grid-auto-columns" property.
+// Syntax: <track-size>+
+// Example: GridAutoColumns(GridAutoColumnsMinContent) -> "grid-auto-columns: min-content;"
+func GridAutoColumns(values ...GridAutoColumnsValue) Property {
+	return Prop("grid-auto-columns", valueNodes(values)...)
+}
+
+// GridAutoFlowValue is implemented by the values of the "grid-auto-flow" property.
+type GridAutoFlowValue interface {
+	ValueNode
+	gridAutoFlowValue()
+}
+
+// GridAutoFlowKeyword represents a keyword of the "grid-auto-flow" property.
+type GridAutoFlowKeyword string
+
+func (k GridAutoFlowKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k GridAutoFlowKeyword) valueNode()         {}
+func (k GridAutoFlowKeyword) gridAutoFlowValue() {}
+
+// GridAutoFlowKeyword also implements the value interfaces of the shorthands of "grid-auto-flow".
+func (k GridAutoFlowKeyword) gridValue() {}
+
+// GridAutoFlowKeyword value constants.
+const (
+	GridAutoFlowRow    GridAutoFlowKeyword = "row"
+	GridAutoFlowColumn GridAutoFlowKeyword = "column"
+	GridAutoFlowDense  GridAutoFlowKeyword = "dense"
+)
+
+// GridAutoFlow creates a "grid-auto-flow" property.
+// Syntax: [ row | column ] || dense
+// Example: GridAutoFlow(GridAutoFlowRow) -> "grid-auto-flow: row;"
+func GridAutoFlow(values ...GridAutoFlowValue) Property {
+	return Prop("grid-auto-flow", valueNodes(values)...)
+}
+
+// GridAutoRowsValue is implemented by the values of the "grid-auto-rows" property.
+type GridAutoRowsValue interface {
+	ValueNode
+	gridAutoRowsValue()
+}
+
+// GridAutoRowsKeyword represents a keyword of the "grid-auto-rows" property.
+type GridAutoRowsKeyword string
+
+func (k GridAutoRowsKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k GridAutoRowsKeyword) valueNode()         {}
+func (k GridAutoRowsKeyword) gridAutoRowsValue() {}
+
+// GridAutoRowsKeyword also implements the value interfaces of the shorthands of "grid-auto-rows".
+func (k GridAutoRowsKeyword) gridValue() {}
+
+// GridAutoRowsKeyword value constants.
+const (
+	GridAutoRowsMinContent GridAutoRowsKeyword = "min-content"
+	GridAutoRowsMaxContent GridAutoRowsKeyword = "max-content"
+)
+
+// GridAutoRows creates a "grid-auto-rows" property.
+// Syntax: <track-size>+
+// Example: GridAutoRows(GridAutoRowsMinContent) -> "grid-auto-rows: min-content;"
+func GridAutoRows(values ...GridAutoRowsValue) Property {
+	return Prop("grid-auto-rows", valueNodes(values)...)
+}
+
+// GridColumnValue is implemented by the values of the "grid-column" property.
+type GridColumnValue interface {
+	ValueNode
+	gridColumnValue()
+}
+
+// GridColumn creates a "grid-column" property.
+// Syntax: <grid-line> [ / <grid-line> ]?
+// Example: GridColumn(Auto) -> "grid-column: auto;"
+func GridColumn(values ...GridColumnValue) Property {
+	return Prop("grid-column", valueNodes(values)...)
+}
+
+// GridColumnEndValue is implemented by the values of the "grid-column-end" property.
+type GridColumnEndValue interface {
+	ValueNode
+	gridColumnEndValue()
+}
+
+// GridColumnEnd creates a "grid-column-end" property.
+// Syntax: <grid-line>
+// Example: GridColumnEnd(Auto) -> "grid-column-end: auto;"
+func GridColumnEnd(values ...GridColumnEndValue) Property {
+	return Prop("grid-column-end", valueNodes(values)...)
+}
+
+// GridColumnStartValue is implemented by the values of the "grid-column-start" property.
+type GridColumnStartValue interface {
+	ValueNode
+	gridColumnStartValue()
+}
+
+// GridColumnStart creates a "grid-column-start" property.
+// Syntax: <grid-line>
+// Example: GridColumnStart(Auto) -> "grid-column-start: auto;"
+func GridColumnStart(values ...GridColumnStartValue) Property {
+	return Prop("grid-column-start", valueNodes(values)...)
+}
+
+// GridRowValue is implemented by the values of the "grid-row" property.
+type GridRowValue interface {
+	ValueNode
+	gridRowValue()
+}
+
+// GridRow creates a "grid-row" property.
+// Syntax: <grid-line> [ / <grid-line> ]?
+// Example: GridRow(Auto) -> "grid-row: auto;"
+func GridRow(values ...GridRowValue) Property {
+	return Prop("grid-row", valueNodes(values)...)
+}
+
+// GridRowEndValue is implemented by the values of the "grid-row-end" property.
+type GridRowEndValue interface {
+	ValueNode
+	gridRowEndValue()
+}
+
+// GridRowEnd creates a "grid-row-end" property.
+// Syntax: <grid-line>
+// Example: GridRowEnd(Auto) -> "grid-row-end: auto;"
+func GridRowEnd(values ...GridRowEndValue) Property {
+	return Prop("grid-row-end", valueNodes(values)...)
+}
+
+// GridRowStartValue is implemented by the values of the "grid-row-start" property.
+type GridRowStartValue interface {
+	ValueNode
+	gridRowStartValue()
+}
+
+// GridRowStart creates a "grid-row-start" property.
+// Syntax: <grid-line>
+// Example: GridRowStart(Auto) -> "grid-row-start: auto;"
+func GridRowStart(values ...GridRowStartValue) Property {
+	return Prop("grid-row-start", valueNodes(values)...)
+}
+
+// GridTemplateValue is implemented by the values of the "grid-template" property.
+type GridTemplateValue interface {
+	ValueNode
+	gridTemplateValue()
+}
+
+// GridTemplate creates a "grid-template" property.
+// Syntax: none | [ <'grid-template-rows'> / <'grid-template-columns'> ] | [ <line-names>? <string> <track-size>? <line-names>? ]+ [ / <explicit-track-list> ]?
+// Example: GridTemplate(GridTemplateRowsNone) -> "grid-template: none;"
+func GridTemplate(values ...GridTemplateValue) Property {
+	return Prop("grid-template", valueNodes(values)...)
+}
+
+// GridTemplateAreasValue is implemented by the values of the "grid-template-areas" property.
+type GridTemplateAreasValue interface {
+	ValueNode
+	gridTemplateAreasValue()
+}
+
+// GridTemplateAreasKeyword represents a keyword of the "grid-template-areas" property.
+type GridTemplateAreasKeyword string
+
+func (k GridTemplateAreasKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k GridTemplateAreasKeyword) valueNode()              {}
+func (k GridTemplateAreasKeyword) gridTemplateAreasValue() {}
+
+// GridTemplateAreasKeyword also implements the value interfaces of the shorthands of "grid-template-areas".
+func (k GridTemplateAreasKeyword) gridValue()         {}
+func (k GridTemplateAreasKeyword) gridTemplateValue() {}
+
+// GridTemplateAreasKeyword value constants.
+const (
+	GridTemplateAreasNone GridTemplateAreasKeyword = "none"
+)
+
+// GridTemplateAreas creates a "grid-template-areas" property.
+// Syntax: none | <string>+
+// Example: GridTemplateAreas(GridTemplateAreasNone) -> "grid-template-areas: none;"
+func GridTemplateAreas(values ...GridTemplateAreasValue) Property {
+	return Prop("grid-template-areas", valueNodes(values)...)
+}
+
+// GridTemplateColumnsValue is implemented by the values of the "grid-template-columns" property.
+type GridTemplateColumnsValue interface {
+	ValueNode
+	gridTemplateColumnsValue()
+}
+
+// GridTemplateColumnsKeyword represents a keyword of the "grid-template-columns" property.
+type GridTemplateColumnsKeyword string
+
+func (k GridTemplateColumnsKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k GridTemplateColumnsKeyword) valueNode()                {}
+func (k GridTemplateColumnsKeyword) gridTemplateColumnsValue() {}
+
+// GridTemplateColumnsKeyword also implements the value interfaces of the shorthands of "grid-template-columns".
+func (k GridTemplateColumnsKeyword) gridValue()         {}
+func (k GridTemplateColumnsKeyword) gridTemplateValue() {}
+
+// GridTemplateColumnsKeyword value constants.
+const (
+	GridTemplateColumnsNone       GridTemplateColumnsKeyword = "none"
+	GridTemplateColumnsMinContent GridTemplateColumnsKeyword = "min-content"
+	GridTemplateColumnsMaxContent GridTemplateColumnsKeyword = "max-content"
+	GridTemplateColumnsSubgrid    GridTemplateColumnsKeyword = "subgrid"
+)
+
+// GridTemplateColumns creates a "grid-template-columns" property.
+// Syntax: none | <track-list> | <auto-track-list> | subgrid <line-name-list>?
+// Example: GridTemplateColumns(GridTemplateColumnsNone) -> "grid-template-columns: none;"
+func GridTemplateColumns(values ...GridTemplateColumnsValue) Property {
+	return Prop("grid-template-columns", valueNodes(values)...)
+}
+
+// GridTemplateRowsValue is implemented by the values of the "grid-template-rows" property.
+type GridTemplateRowsValue interface {
+	ValueNode
+	gridTemplateRowsValue()
+}
+
+// GridTemplateRowsKeyword represents a keyword of the "grid-template-rows" property.
+type GridTemplateRowsKeyword string
+
+func (k GridTemplateRowsKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k GridTemplateRowsKeyword) valueNode()             {}
+func (k GridTemplateRowsKeyword) gridTemplateRowsValue() {}
+
+// GridTemplateRowsKeyword also implements the value interfaces of the shorthands of "grid-template-rows".
+func (k GridTemplateRowsKeyword) gridValue()         {}
+func (k GridTemplateRowsKeyword) gridTemplateValue() {}
+
+// GridTemplateRowsKeyword value constants.
+const (
+	GridTemplateRowsNone       GridTemplateRowsKeyword = "none"
+	GridTemplateRowsMinContent GridTemplateRowsKeyword = "min-content"
+	GridTemplateRowsMaxContent GridTemplateRowsKeyword = "max-content"
+	GridTemplateRowsSubgrid    GridTemplateRowsKeyword = "subgrid"
+)
+
+// GridTemplateRows creates a "grid-template-rows" property.
+// Syntax: none | <track-list> | <auto-track-list> | subgrid <line-name-list>?
+// Example: GridTemplateRows(GridTemplateRowsNone) -> "grid-template-rows: none;"
+func GridTemplateRows(values ...GridTemplateRowsValue) Property {
+	return Prop("grid-template-rows", valueNodes(values)...)
+}
+
+// HangingPunctuationValue is implemented by the values of the "hanging-punctuation" property.
+type HangingPunctuationValue interface {
+	ValueNode
+	hangingPunctuationValue()
+}
+
+// HangingPunctuationKeyword represents a keyword of the "hanging-punctuation" property.
+type HangingPunctuationKeyword string
+
+func (k HangingPunctuationKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k HangingPunctuationKeyword) valueNode()               {}
+func (k HangingPunctuationKeyword) hangingPunctuationValue() {}
+
+// HangingPunctuationKeyword value constants.
+const (
+	HangingPunctuationNone     HangingPunctuationKeyword = "none"
+	HangingPunctuationFirst    HangingPunctuationKeyword = "first"
+	HangingPunctuationForceEnd HangingPunctuationKeyword = "force-end"
+	HangingPunctuationAllowEnd HangingPunctuationKeyword = "allow-end"
+	HangingPunctuationLast     HangingPunctuationKeyword = "last"
+)
+
+// HangingPunctuation creates a "hanging-punctuation" property.
+// Syntax: none | [ first || [ force-end | allow-end ] || last ]
+// Example: HangingPunctuation(HangingPunctuationNone) -> "hanging-punctuation: none;"
+func HangingPunctuation(values ...HangingPunctuationValue) Property {
+	return Prop("hanging-punctuation", valueNodes(values)...)
+}
+
+// HyphenateCharacterValue is implemented by the values of the "hyphenate-character" property.
+type HyphenateCharacterValue interface {
+	ValueNode
+	hyphenateCharacterValue()
+}
+
+// HyphenateCharacter creates a "hyphenate-character" property.
+// Syntax: auto | <string>
+// Example: HyphenateCharacter(Auto) -> "hyphenate-character: auto;"
+func HyphenateCharacter(value HyphenateCharacterValue) Property {
+	return Prop("hyphenate-character", value)
+}
+
+// HyphenateLimitCharsValue is implemented by the values of the "hyphenate-limit-chars" property.
+type HyphenateLimitCharsValue interface {
+	ValueNode
+	hyphenateLimitCharsValue()
+}
+
+// HyphenateLimitChars creates a "hyphenate-limit-chars" property.
+// Syntax: [ auto | <integer> ]{1,3}
+// Example: HyphenateLimitChars(Auto) -> "hyphenate-limit-chars: auto;"
+func HyphenateLimitChars(values ...HyphenateLimitCharsValue) Property {
+	return Prop("hyphenate-limit-chars", valueNodes(values)...)
+}
+
+// HyphensValue is implemented by the values of the "hyphens" property.
+type HyphensValue interface {
+	ValueNode
+	hyphensValue()
+}
+
+// HyphensKeyword represents a keyword of the "hyphens" property.
+type HyphensKeyword string
+
+func (k HyphensKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k HyphensKeyword) valueNode()    {}
+func (k HyphensKeyword) hyphensValue() {}
+
+// HyphensKeyword value constants.
+const (
+	HyphensNone   HyphensKeyword = "none"
+	HyphensManual HyphensKeyword = "manual"
+)
+
+// Hyphens creates a "hyphens" property.
+// Syntax: none | manual | auto
+// Example: Hyphens(HyphensNone) -> "hyphens: none;"
+func Hyphens(value HyphensValue) Property {
+	return Prop("hyphens", value)
+}
+
+// ImageOrientationValue is implemented by the values of the "image-orientation" property.
+type ImageOrientationValue interface {
+	ValueNode
+	imageOrientationValue()
+}
+
+// ImageOrientationKeyword represents a keyword of the "image-orientation" property.
+type ImageOrientationKeyword string
+
+func (k ImageOrientationKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ImageOrientationKeyword) valueNode()             {}
+func (k ImageOrientationKeyword) imageOrientationValue() {}
+
+// ImageOrientationKeyword value constants.
+const (
+	ImageOrientationFromImage ImageOrientationKeyword = "from-image"
+	ImageOrientationNone      ImageOrientationKeyword = "none"
+	ImageOrientationFlip      ImageOrientationKeyword = "flip"
+)
+
+// ImageOrientation creates an "image-orientation" property.
+// Syntax: from-image | none | [ <angle> || flip ]
+// Example: ImageOrientation(ImageOrientationFromImage) -> "image-orientation: from-image;"
+func ImageOrientation(values ...ImageOrientationValue) Property {
+	return Prop("image-orientation", valueNodes(values)...)
+}
+
+// ImageRenderingValue is implemented by the values of the "image-rendering" property.
+type ImageRenderingValue interface {
+	ValueNode
+	imageRenderingValue()
+}
+
+// ImageRenderingKeyword represents a keyword of the "image-rendering" property.
+type ImageRenderingKeyword string
+
+func (k ImageRenderingKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ImageRenderingKeyword) valueNode()           {}
+func (k ImageRenderingKeyword) imageRenderingValue() {}
+
+// ImageRenderingKeyword value constants.
+const (
+	ImageRenderingCrispEdges  ImageRenderingKeyword = "crisp-edges"
+	ImageRenderingPixelated   ImageRenderingKeyword = "pixelated"
+	ImageRenderingSmooth      ImageRenderingKeyword = "smooth"
+	ImageRenderingHighQuality ImageRenderingKeyword = "high-quality"
+)
+
+// ImageRendering creates an "image-rendering" property.
+// Syntax: auto | crisp-edges | pixelated | smooth | high-quality
+// Example: ImageRendering(ImageRenderingCrispEdges) -> "image-rendering: crisp-edges;"
+func ImageRendering(value ImageRenderingValue) Property {
+	return Prop("image-rendering", value)
+}
+
+// InitialLetterValue is implemented by the values of the "initial-letter" property.
+type InitialLetterValue interface {
+	ValueNode
+	initialLetterValue()
+}
+
+// InitialLetterKeyword represents a keyword of the "initial-letter" property.
+type InitialLetterKeyword string
+
+func (k InitialLetterKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k InitialLetterKeyword) valueNode()          {}
+func (k InitialLetterKeyword) initialLetterValue() {}
+
+// InitialLetterKeyword value constants.
+const (
+	InitialLetterNormal InitialLetterKeyword = "normal"
+)
+
+// InitialLetter creates an "initial-letter" property.
+// Syntax: normal | [ <number> <integer>? ]
+// Example: InitialLetter(InitialLetterNormal) -> "initial-letter: normal;"
+func InitialLetter(values ...InitialLetterValue) Property {
+	return Prop("initial-letter", valueNodes(values)...)
+}
+
+// InlineSizeValue is implemented by the values of the "inline-size" property.
+type InlineSizeValue interface {
+	ValueNode
+	inlineSizeValue()
+}
+
+// InlineSizeKeyword represents a keyword of the "inline-size" property.
+type InlineSizeKeyword string
+
+func (k InlineSizeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k InlineSizeKeyword) valueNode()       {}
+func (k InlineSizeKeyword) inlineSizeValue() {}
+
+// InlineSizeKeyword value constants.
+const (
+	InlineSizeMinContent InlineSizeKeyword = "min-content"
+	InlineSizeMaxContent InlineSizeKeyword = "max-content"
+	InlineSizeFitContent InlineSizeKeyword = "fit-content"
+)
+
+// InlineSize creates an "inline-size" property.
+// Syntax: <'width'>
+// Example: InlineSize(InlineSizeMinContent) -> "inline-size: min-content;"
+func InlineSize(value InlineSizeValue) Property {
+	return Prop("inline-size", value)
+}
+
+// InsetValue is implemented by the values of the "inset" property.
+type InsetValue interface {
+	ValueNode
+	insetValue()
+}
+
+// InsetProp creates an "inset" property.
+// Syntax: <'top'>{1,4}
+// Example: InsetProp(PX(1)) -> "inset: 1px;"
+func InsetProp(values ...InsetValue) Property {
+	return Prop("inset", valueNodes(values)...)
+}
+
+// InsetBlockValue is implemented by the values of the "inset-block" property.
+type InsetBlockValue interface {
+	ValueNode
+	insetBlockValue()
+}
+
+// InsetBlock creates an "inset-block" property.
+// Syntax: <'top'>{1,2}
+// Example: InsetBlock(PX(1)) -> "inset-block: 1px;"
+func InsetBlock(values ...InsetBlockValue) Property {
+	return Prop("inset-block", valueNodes(values)...)
+}
+
+// InsetBlockEndValue is implemented by the values of the "inset-block-end" property.
+type InsetBlockEndValue interface {
+	ValueNode
+	insetBlockEndValue()
+}
+
+// InsetBlockEnd creates an "inset-block-end" property.
+// Syntax: <'top'>
+// Example: InsetBlockEnd(PX(1)) -> "inset-block-end: 1px;"
+func InsetBlockEnd(value InsetBlockEndValue) Property {
+	return Prop("inset-block-end", value)
+}
+
+// InsetBlockStartValue is implemented by the values of the "inset-block-start" property.
+type InsetBlockStartValue interface {
+	ValueNode
+	insetBlockStartValue()
+}
+
+// InsetBlockStart creates an "inset-block-start" property.
+// Syntax: <'top'>
+// Example: InsetBlockStart(PX(1)) -> "inset-block-start: 1px;"
+func InsetBlockStart(value InsetBlockStartValue) Property {
+	return Prop("inset-block-start", value)
+}
+
+// InsetInlineValue is implemented by the values of the "inset-inline" property.
+type InsetInlineValue interface {
+	ValueNode
+	insetInlineValue()
+}
+
+// InsetInline creates an "inset-inline" property.
+// Syntax: <'top'>{1,2}
+// Example: InsetInline(PX(1)) -> "inset-inline: 1px;"
+func InsetInline(values ...InsetInlineValue) Property {
+	return Prop("inset-inline", valueNodes(values)...)
+}
+
+// InsetInlineEndValue is implemented by the values of the "inset-inline-end" property.
+type InsetInlineEndValue interface {
+	ValueNode
+	insetInlineEndValue()
+}
+
+// InsetInlineEnd creates an "inset-inline-end" property.
+// Syntax: <'top'>
+// Example: InsetInlineEnd(PX(1)) -> "inset-inline-end: 1px;"
+func InsetInlineEnd(value InsetInlineEndValue) Property {
+	return Prop("inset-inline-end", value)
+}
+
+// InsetInlineStartValue is implemented by the values of the "inset-inline-start" property.
+type InsetInlineStartValue interface {
+	ValueNode
+	insetInlineStartValue()
+}
+
+// InsetInlineStart creates an "inset-inline-start" property.
+// Syntax: <'top'>
+// Example: InsetInlineStart(PX(1)) -> "inset-inline-start: 1px;"
+func InsetInlineStart(value InsetInlineStartValue) Property {
+	return Prop("inset-inline-start", value)
+}
+
+// InterpolateSizeValue is implemented by the values of the "interpolate-size" property.
+type InterpolateSizeValue interface {
+	ValueNode
+	interpolateSizeValue()
+}
+
+// InterpolateSizeKeyword represents a keyword of the "interpolate-size" property.
+type InterpolateSizeKeyword string
+
+func (k InterpolateSizeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k InterpolateSizeKeyword) valueNode()            {}
+func (k InterpolateSizeKeyword) interpolateSizeValue() {}
+
+// InterpolateSizeKeyword value constants.
+const (
+	InterpolateSizeNumericOnly   InterpolateSizeKeyword = "numeric-only"
+	InterpolateSizeAllowKeywords InterpolateSizeKeyword = "allow-keywords"
+)
+
+// InterpolateSize creates an "interpolate-size" property.
+// Syntax: numeric-only | allow-keywords
+// Example: InterpolateSize(InterpolateSizeNumericOnly) -> "interpolate-size: numeric-only;"
+func InterpolateSize(value InterpolateSizeValue) Property {
+	return Prop("interpolate-size", value)
+}
+
+// IsolationValue is implemented by the values of the "isolation" property.
+type IsolationValue interface {
+	ValueNode
+	isolationValue()
+}
+
+// IsolationKeyword represents a keyword of the "isolation" property.
+type IsolationKeyword string
+
+func (k IsolationKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k IsolationKeyword) valueNode()      {}
+func (k IsolationKeyword) isolationValue() {}
+
+// IsolationKeyword value constants.
+const (
+	IsolationIsolate IsolationKeyword = "isolate"
+)
+
+// Isolation creates an "isolation" property.
+// Syntax: auto | isolate
+// Example: Isolation(IsolationIsolate) -> "isolation: isolate;"
+func Isolation(value IsolationValue) Property {
+	return Prop("isolation", value)
+}
+
+// JustifyContentValue is implemented by the values of the "justify-content" property.
+type JustifyContentValue interface {
+	ValueNode
+	justifyContentValue()
+}
+
+// JustifyContentKeyword represents a keyword of the "justify-content" property.
+type JustifyContentKeyword string
+
+func (k JustifyContentKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k JustifyContentKeyword) valueNode()           {}
+func (k JustifyContentKeyword) justifyContentValue() {}
+
+// JustifyContentKeyword also implements the value interfaces of the shorthands of "justify-content".
+func (k JustifyContentKeyword) placeContentValue() {}
+
+// JustifyContentKeyword value constants.
+const (
+	JustifyContentNormal       JustifyContentKeyword = "normal"
+	JustifyContentSpaceBetween JustifyContentKeyword = "space-between"
+	JustifyContentSpaceAround  JustifyContentKeyword = "space-around"
+	JustifyContentSpaceEvenly  JustifyContentKeyword = "space-evenly"
+	JustifyContentStretch      JustifyContentKeyword = "stretch"
+	JustifyContentCenter       JustifyContentKeyword = "center"
+	JustifyContentStart        JustifyContentKeyword = "start"
+	JustifyContentEnd          JustifyContentKeyword = "end"
+	JustifyContentFlexStart    JustifyContentKeyword = "flex-start"
+	JustifyContentFlexEnd      JustifyContentKeyword = "flex-end"
+	JustifyContentLeft         JustifyContentKeyword = "left"
+	JustifyContentRight        JustifyContentKeyword = "right"
+)
+
+// JustifyContent creates a "justify-content" property.
+// Syntax: normal | <content-distribution> | <overflow-position>? [ <content-position> | left | right ]
+// Example: JustifyContent(JustifyContentNormal) -> "justify-content: normal;"
+func JustifyContent(values ...JustifyContentValue) Property {
+	return Prop("justify-content", valueNodes(values)...)
+}
+
+// JustifyItemsValue is implemented by the values of the "justify-items" property.
+type JustifyItemsValue interface {
+	ValueNode
+	justifyItemsValue()
+}
+
+// JustifyItemsKeyword represents a keyword of the "justify-items" property.
+type JustifyItemsKeyword string
+
+func (k JustifyItemsKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k JustifyItemsKeyword) valueNode()         {}
+func (k JustifyItemsKeyword) justifyItemsValue() {}
+
+// JustifyItemsKeyword also implements the value interfaces of the shorthands of "justify-items".
+func (k JustifyItemsKeyword) placeItemsValue() {}
+
+// JustifyItemsKeyword value constants.
+const (
+	JustifyItemsNormal    JustifyItemsKeyword = "normal"
+	JustifyItemsStretch   JustifyItemsKeyword = "stretch"
+	JustifyItemsBaseline  JustifyItemsKeyword = "baseline"
+	JustifyItemsCenter    JustifyItemsKeyword = "center"
+	JustifyItemsStart     JustifyItemsKeyword = "start"
+	JustifyItemsEnd       JustifyItemsKeyword = "end"
+	JustifyItemsSelfStart JustifyItemsKeyword = "self-start"
+	JustifyItemsSelfEnd   JustifyItemsKeyword = "self-end"
+	JustifyItemsFlexStart JustifyItemsKeyword = "flex-start"
+	JustifyItemsFlexEnd   JustifyItemsKeyword = "flex-end"
+	JustifyItemsLeft      JustifyItemsKeyword = "left"
+	JustifyItemsRight     JustifyItemsKeyword = "right"
+	JustifyItemsLegacy    JustifyItemsKeyword = "legacy"
+)
+
+// JustifyItems creates a "justify-items" property.
+// Syntax: normal | stretch | <baseline-position> | <overflow-position>? [ <self-position> | left | right ] | legacy | legacy && [ left | right | center ]
+// Example: JustifyItems(JustifyItemsNormal) -> "justify-items: normal;"
+func JustifyItems(values ...JustifyItemsValue) Property {
+	return Prop("justify-items", valueNodes(values)...)
+}
+
+// JustifySelfValue is implemented by the values of the "justify-self" property.
+type JustifySelfValue interface {
+	ValueNode
+	justifySelfValue()
+}
+
+// JustifySelfKeyword represents a keyword of the "justify-self" property.
+type JustifySelfKeyword string
+
+func (k JustifySelfKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k JustifySelfKeyword) valueNode()        {}
+func (k JustifySelfKeyword) justifySelfValue() {}
+
+// JustifySelfKeyword also implements the value interfaces of the shorthands of "justify-self".
+func (k JustifySelfKeyword) placeSelfValue() {}
+
+// JustifySelfKeyword value constants.
+const (
+	JustifySelfNormal    JustifySelfKeyword = "normal"
+	JustifySelfStretch   JustifySelfKeyword = "stretch"
+	JustifySelfBaseline  JustifySelfKeyword = "baseline"
+	JustifySelfCenter    JustifySelfKeyword = "center"
+	JustifySelfStart     JustifySelfKeyword = "start"
+	JustifySelfEnd       JustifySelfKeyword = "end"
+	JustifySelfSelfStart JustifySelfKeyword = "self-start"
+	JustifySelfSelfEnd   JustifySelfKeyword = "self-end"
+	JustifySelfFlexStart JustifySelfKeyword = "flex-start"
+	JustifySelfFlexEnd   JustifySelfKeyword = "flex-end"
+	JustifySelfLeft      JustifySelfKeyword = "left"
+	JustifySelfRight     JustifySelfKeyword = "right"
+)
+
+// JustifySelf creates a "justify-self" property.
+// Syntax: auto | normal | stretch | <baseline-position> | <overflow-position>? [ <self-position> | left | right ]
+// Example: JustifySelf(JustifySelfNormal) -> "justify-self: normal;"
+func JustifySelf(values ...JustifySelfValue) Property {
+	return Prop("justify-self", valueNodes(values)...)
+}
+
+// LeftValue is implemented by the values of the "left" property.
+type LeftValue interface {
+	ValueNode
+	leftValue()
+}
+
+// Left creates a "left" property.
+// Syntax: <length-percentage> | auto
+// Example: Left(PX(1)) -> "left: 1px;"
+func Left(value LeftValue) Property {
+	return Prop("left", value)
+}
+
+// LightingColorValue is implemented by the values of the "lighting-color" property.
+type LightingColorValue interface {
+	ValueNode
+	lightingColorValue()
+}
+
+// LightingColor creates a "lighting-color" property.
+// Syntax: <color>
+// Example: LightingColor(Red) -> "lighting-color: red;"
+func LightingColor(value LightingColorValue) Property {
+	return Prop("lighting-color", value)
+}
+
+// LineBreakValue is implemented by the values of the "line-break" property.
+type LineBreakValue interface {
+	ValueNode
+	lineBreakValue()
+}
+
+// LineBreakKeyword represents a keyword of the "line-break" property.
+type LineBreakKeyword string
+
+func (k LineBreakKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k LineBreakKeyword) valueNode()      {}
+func (k LineBreakKeyword) lineBreakValue() {}
+
+// LineBreakKeyword value constants.
+const (
+	LineBreakLoose    LineBreakKeyword = "loose"
+	LineBreakNormal   LineBreakKeyword = "normal"
+	LineBreakStrict   LineBreakKeyword = "strict"
+	LineBreakAnywhere LineBreakKeyword = "anywhere"
+)
+
+// LineBreak creates a "line-break" property.
+// Syntax: auto | loose | normal | strict | anywhere
+// Example: LineBreak(LineBreakLoose) -> "line-break: loose;"
+func LineBreak(value LineBreakValue) Property {
+	return Prop("line-break", value)
+}
+
+// LineClampValue is implemented by the values of the "line-clamp" property.
+type LineClampValue interface {
+	ValueNode
+	lineClampValue()
+}
+
+// LineClampKeyword represents a keyword of the "line-clamp" property.
+type LineClampKeyword string
+
+func (k LineClampKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k LineClampKeyword) valueNode()      {}
+func (k LineClampKeyword) lineClampValue() {}
+
+// LineClampKeyword value constants.
+const (
+	LineClampNone LineClampKeyword = "none"
+)
+
+// LineClamp creates a "line-clamp" property.
+// Syntax: none | <integer>
+// Example: LineClamp(LineClampNone) -> "line-clamp: none;"
+func LineClamp(value LineClampValue) Property {
+	return Prop("line-clamp", value)
+}
+
+// ListStyleValue is implemented by the values of the "list-style" property.
+type ListStyleValue interface {
+	ValueNode
+	listStyleValue()
+}
+
+// ListStyle creates a "list-style" property.
+// Syntax: <'list-style-type'> || <'list-style-position'> || <'list-style-image'>
+// Example: ListStyle(Ident("a")) -> "list-style: a;"
+func ListStyle(values ...ListStyleValue) Property {
+	return Prop("list-style", valueNodes(values)...)
+}
+
+// ListStyleImageValue is implemented by the values of the "list-style-image" property.
+type ListStyleImageValue interface {
+	ValueNode
+	listStyleImageValue()
+}
+
+// ListStyleImageKeyword represents a keyword of the "list-style-image" property.
+type ListStyleImageKeyword string
+
+func (k ListStyleImageKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ListStyleImageKeyword) valueNode()           {}
+func (k ListStyleImageKeyword) listStyleImageValue() {}
+
+// ListStyleImageKeyword also implements the value interfaces of the shorthands of "list-style-image".
+func (k ListStyleImageKeyword) listStyleValue() {}
+
+// ListStyleImageKeyword value constants.
+const (
+	ListStyleImageNone ListStyleImageKeyword = "none"
+)
+
+// ListStyleImage creates a "list-style-image" property.
+// Syntax: <image> | none
+// Example: ListStyleImage(ListStyleImageNone) -> "list-style-image: none;"
+func ListStyleImage(value ListStyleImageValue) Property {
+	return Prop("list-style-image", value)
+}
+
+// ListStylePositionValue is implemented by the values of the "list-style-position" property.
+type ListStylePositionValue interface {
+	ValueNode
+	listStylePositionValue()
+}
+
+// ListStylePositionKeyword represents a keyword of the "list-style-position" property.
+type ListStylePositionKeyword string
+
+func (k ListStylePositionKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ListStylePositionKeyword) valueNode()              {}
+func (k ListStylePositionKeyword) listStylePositionValue() {}
+
+// ListStylePositionKeyword also implements the value interfaces of the shorthands of "list-style-position".
+func (k ListStylePositionKeyword) listStyleValue() {}
+
+// ListStylePositionKeyword value constants.
+const (
+	ListStylePositionInside  ListStylePositionKeyword = "inside"
+	ListStylePositionOutside ListStylePositionKeyword = "outside"
+)
+
+// ListStylePosition creates a "list-style-position" property.
+// Syntax: inside | outside
+// Example: ListStylePosition(ListStylePositionInside) -> "list-style-position: inside;"
+func ListStylePosition(value ListStylePositionValue) Property {
+	return Prop("list-style-position", value)
+}
+
+// ListStyleTypeValue is implemented by the values of the "list-style-type" property.
+type ListStyleTypeValue interface {
+	ValueNode
+	listStyleTypeValue()
+}
+
+// ListStyleTypeKeyword represents a keyword of the "list-style-type" property.
+type ListStyleTypeKeyword string
+
+func (k ListStyleTypeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ListStyleTypeKeyword) valueNode()          {}
+func (k ListStyleTypeKeyword) listStyleTypeValue() {}
+
+// ListStyleTypeKeyword also implements the value interfaces of the shorthands of "list-style-type".
+func (k ListStyleTypeKeyword) listStyleValue() {}
+
+// ListStyleTypeKeyword value constants.
+const (
+	ListStyleTypeNone ListStyleTypeKeyword = "none"
+)
+
+// ListStyleType creates a "list-style-type" property.
+// Syntax: <counter-style> | <string> | none
+// Example: ListStyleType(ListStyleTypeNone) -> "list-style-type: none;"
+func ListStyleType(value ListStyleTypeValue) Property {
+	return Prop("list-style-type", value)
+}
+
+// MarginBlockValue is implemented by the values of the "margin-block" property.
+type MarginBlockValue interface {
+	ValueNode
+	marginBlockValue()
+}
+
+// MarginBlock creates a "margin-block" property.
+// Syntax: <'margin-top'>{1,2}
+// Example: MarginBlock(PX(1)) -> "margin-block: 1px;"
+func MarginBlock(values ...MarginBlockValue) Property {
+	return Prop("margin-block", valueNodes(values)...)
+}
+
+// MarginBlockEndValue is implemented by the values of the "margin-block-end" property.
+type MarginBlockEndValue interface {
+	ValueNode
+	marginBlockEndValue()
+}
+
+// MarginBlockEnd creates a "margin-block-end" property.
+// Syntax: <'margin-top'>
+// Example: MarginBlockEnd(PX(1)) -> "margin-block-end: 1px;"
+func MarginBlockEnd(value MarginBlockEndValue) Property {
+	return Prop("margin-block-end", value)
+}
+
+// MarginBlockStartValue is implemented by the values of the "margin-block-start" property.
+type MarginBlockStartValue interface {
+	ValueNode
+	marginBlockStartValue()
+}
+
+// MarginBlockStart creates a "margin-block-start" property.
+// Syntax: <'margin-top'>
+// Example: MarginBlockStart(PX(1)) -> "margin-block-start: 1px;"
+func MarginBlockStart(value MarginBlockStartValue) Property {
+	return Prop("margin-block-start", value)
+}
+
+// MarginBottomValue is implemented by the values of the "margin-bottom" property.
+type MarginBottomValue interface {
+	ValueNode
+	marginBottomValue()
+}
+
+// MarginBottom creates a "margin-bottom" property.
+// Syntax: <length-percentage> | auto
+// Example: MarginBottom(PX(1)) -> "margin-bottom: 1px;"
+func MarginBottom(value MarginBottomValue) Property {
+	return Prop("margin-bottom", value)
+}
+
+// MarginInlineValue is implemented by the values of the "margin-inline" property.
+type MarginInlineValue interface {
+	ValueNode
+	marginInlineValue()
+}
+
+// MarginInline creates a "margin-inline" property.
+// Syntax: <'margin-top'>{1,2}
+// Example: MarginInline(PX(1)) -> "margin-inline: 1px;"
+func MarginInline(values ...MarginInlineValue) Property {
+	return Prop("margin-inline", valueNodes(values)...)
+}
+
+// MarginInlineEndValue is implemented by the values of the "margin-inline-end" property.
+type MarginInlineEndValue interface {
+	ValueNode
+	marginInlineEndValue()
+}
+
+// MarginInlineEnd creates a "margin-inline-end" property.
+// Syntax: <'margin-top'>
+// Example: MarginInlineEnd(PX(1)) -> "margin-inline-end: 1px;"
+func MarginInlineEnd(value MarginInlineEndValue) Property {
+	return Prop("margin-inline-end", value)
+}
+
+// MarginInlineStartValue is implemented by the values of the "margin-inline-start" property.
+type MarginInlineStartValue interface {
+	ValueNode
+	marginInlineStartValue()
+}
+
+// MarginInlineStart creates a "margin-inline-start" property.
+// Syntax: <'margin-top'>
+// Example: MarginInlineStart(PX(1)) -> "margin-inline-start: 1px;"
+func MarginInlineStart(value MarginInlineStartValue) Property {
+	return Prop("margin-inline-start", value)
+}
+
+// MarginLeftValue is implemented by the values of the "margin-left" property.
+type MarginLeftValue interface {
+	ValueNode
+	marginLeftValue()
+}
+
+// MarginLeft creates a "margin-left" property.
+// Syntax: <length-percentage> | auto
+// Example: MarginLeft(PX(1)) -> "margin-left: 1px;"
+func MarginLeft(value MarginLeftValue) Property {
+	return Prop("margin-left", value)
+}
+
+// MarginRightValue is implemented by the values of the "margin-right" property.
+type MarginRightValue interface {
+	ValueNode
+	marginRightValue()
+}
+
+// MarginRight creates a "margin-right" property.
+// Syntax: <length-percentage> | auto
+// Example: MarginRight(PX(1)) -> "margin-right: 1px;"
+func MarginRight(value MarginRightValue) Property {
+	return Prop("margin-right", value)
+}
+
+// MarginTopValue is implemented by the values of the "margin-top" property.
+type MarginTopValue interface {
+	ValueNode
+	marginTopValue()
+}
+
+// MarginTop creates a "margin-top" property.
+// Syntax: <length-percentage> | auto
+// Example: MarginTop(PX(1)) -> "margin-top: 1px;"
+func MarginTop(value MarginTopValue) Property {
+	return Prop("margin-top", value)
+}
+
+// MarginTrimValue is implemented by the values of the "margin-trim" property.
+type MarginTrimValue interface {
+	ValueNode
+	marginTrimValue()
+}
+
+// MarginTrimKeyword represents a keyword of the "margin-trim" property.
+type MarginTrimKeyword string
+
+func (k MarginTrimKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MarginTrimKeyword) valueNode()       {}
+func (k MarginTrimKeyword) marginTrimValue() {}
+
+// MarginTrimKeyword value constants.
+const (
+	MarginTrimNone        MarginTrimKeyword = "none"
+	MarginTrimBlock       MarginTrimKeyword = "block"
+	MarginTrimInline      MarginTrimKeyword = "inline"
+	MarginTrimBlockStart  MarginTrimKeyword = "block-start"
+	MarginTrimInlineStart MarginTrimKeyword = "inline-start"
+	MarginTrimBlockEnd    MarginTrimKeyword = "block-end"
+	MarginTrimInlineEnd   MarginTrimKeyword = "inline-end"
+)
+
+// MarginTrim creates a "margin-trim" property.
+// Syntax: none | [ block || inline ] | [ block-start || inline-start || block-end || inline-end ]
+// Example: MarginTrim(MarginTrimNone) -> "margin-trim: none;"
+func MarginTrim(values ...MarginTrimValue) Property {
+	return Prop("margin-trim", valueNodes(values)...)
+}
+
+// MarkerValue is implemented by the values of the "marker" property.
+type MarkerValue interface {
+	ValueNode
+	markerValue()
+}
+
+// Marker creates a "marker" property.
+// Syntax: none | <url>
+// Example: Marker(Url("a.png")) -> "marker: url('a.png');"
+func Marker(value MarkerValue) Property {
+	return Prop("marker", value)
+}
+
+// MarkerEndValue is implemented by the values of the "marker-end" property.
+type MarkerEndValue interface {
+	ValueNode
+	markerEndValue()
+}
+
+// MarkerEndKeyword represents a keyword of the "marker-end" property.
+type MarkerEndKeyword string
+
+func (k MarkerEndKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MarkerEndKeyword) valueNode()      {}
+func (k MarkerEndKeyword) markerEndValue() {}
+
+// MarkerEndKeyword also implements the value interfaces of the shorthands of "marker-end".
+func (k MarkerEndKeyword) markerValue() {}
+
+// MarkerEndKeyword value constants.
+const (
+	MarkerEndNone MarkerEndKeyword = "none"
+)
+
+// MarkerEnd creates a "marker-end" property.
+// Syntax: none | <url>
+// Example: MarkerEnd(MarkerEndNone) -> "marker-end: none;"
+func MarkerEnd(value MarkerEndValue) Property {
+	return Prop("marker-end", value)
+}
+
+// MarkerMidValue is implemented by the values of the "marker-mid" property.
+type MarkerMidValue interface {
+	ValueNode
+	markerMidValue()
+}
+
+// MarkerMidKeyword represents a keyword of the "marker-mid" property.
+type MarkerMidKeyword string
+
+func (k MarkerMidKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MarkerMidKeyword) valueNode()      {}
+func (k MarkerMidKeyword) markerMidValue() {}
+
+// MarkerMidKeyword also implements the value interfaces of the shorthands of "marker-mid".
+func (k MarkerMidKeyword) markerValue() {}
+
+// MarkerMidKeyword value constants.
+const (
+	MarkerMidNone MarkerMidKeyword = "none"
+)
+
+// MarkerMid creates a "marker-mid" property.
+// Syntax: none | <url>
+// Example: MarkerMid(MarkerMidNone) -> "marker-mid: none;"
+func MarkerMid(value MarkerMidValue) Property {
+	return Prop("marker-mid", value)
+}
+
+// MarkerStartValue is implemented by the values of the "marker-start" property.
+type MarkerStartValue interface {
+	ValueNode
+	markerStartValue()
+}
+
+// MarkerStartKeyword represents a keyword of the "marker-start" property.
+type MarkerStartKeyword string
+
+func (k MarkerStartKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MarkerStartKeyword) valueNode()        {}
+func (k MarkerStartKeyword) markerStartValue() {}
+
+// MarkerStartKeyword also implements the value interfaces of the shorthands of "marker-start".
+func (k MarkerStartKeyword) markerValue() {}
+
+// MarkerStartKeyword value constants.
+const (
+	MarkerStartNone MarkerStartKeyword = "none"
+)
+
+// MarkerStart creates a "marker-start" property.
+// Syntax: none | <url>
+// Example: MarkerStart(MarkerStartNone) -> "marker-start: none;"
+func MarkerStart(value MarkerStartValue) Property {
+	return Prop("marker-start", value)
+}
+
+// MaskValue is implemented by the values of the "mask" property.
+type MaskValue interface {
+	ValueNode
+	maskValue()
+}
+
+// Mask creates a "mask" property.
+// Syntax: <mask-layer>#
+// Example: Mask(Url("a.png"), MaskRepeatNoRepeat) -> "mask: url('a.png') no-repeat;"
+// It sets a single layer; use MaskLayers for several.
+func Mask(values ...MaskValue) Property {
+	return Prop("mask", valueNodes(values)...)
+}
+
+// MaskLayers creates a "mask" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: MaskLayers([]MaskValue{Url("a.png"), MaskRepeatNoRepeat}, []MaskValue{Url("a.png"), MaskRepeatNoRepeat}) -> "mask: url('a.png') no-repeat, url('a.png') no-repeat;"
+func MaskLayers(layers ...[]MaskValue) Property {
+	return commaProp("mask", layerNodes(layers)...)
+}
+
+// MaskBorderValue is implemented by the values of the "mask-border" property.
+type MaskBorderValue interface {
+	ValueNode
+	maskBorderValue()
+}
+
+// MaskBorder creates a "mask-border" property.
+// Syntax: <'mask-border-source'> || <'mask-border-slice'> [ / <'mask-border-width'>? [ / <'mask-border-outset'> ]? ]? || <'mask-border-repeat'> || <'mask-border-mode'>
+// Example: MaskBorder(Url("a.png")) -> "mask-border: url('a.png');"
+func MaskBorder(values ...MaskBorderValue) Property {
+	return Prop("mask-border", valueNodes(values)...)
+}
+
+// MaskBorderModeValue is implemented by the values of the "mask-border-mode" property.
+type MaskBorderModeValue interface {
+	ValueNode
+	maskBorderModeValue()
+}
+
+// MaskBorderModeKeyword represents a keyword of the "mask-border-mode" property.
+type MaskBorderModeKeyword string
+
+func (k MaskBorderModeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MaskBorderModeKeyword) valueNode()           {}
+func (k MaskBorderModeKeyword) maskBorderModeValue() {}
+
+// MaskBorderModeKeyword also implements the value interfaces of the shorthands of "mask-border-mode".
+func (k MaskBorderModeKeyword) maskBorderValue() {}
+
+// MaskBorderModeKeyword value constants.
+const (
+	MaskBorderModeLuminance MaskBorderModeKeyword = "luminance"
+	MaskBorderModeAlpha     MaskBorderModeKeyword = "alpha"
+)
+
+// MaskBorderMode creates a "mask-border-mode" property.
+// Syntax: luminance | alpha
+// Example: MaskBorderMode(MaskBorderModeLuminance) -> "mask-border-mode: luminance;"
+func MaskBorderMode(value MaskBorderModeValue) Property {
+	return Prop("mask-border-mode", value)
+}
+
+// MaskBorderOutsetValue is implemented by the values of the "mask-border-outset" property.
+type MaskBorderOutsetValue interface {
+	ValueNode
+	maskBorderOutsetValue()
+}
+
+// MaskBorderOutset creates a "mask-border-outset" property.
+// Syntax: [ <length> | <number> ]{1,4}
+// Example: MaskBorderOutset(PX(1)) -> "mask-border-outset: 1px;"
+func MaskBorderOutset(values ...MaskBorderOutsetValue) Property {
+	return Prop("mask-border-outset", valueNodes(values)...)
+}
+
+// MaskBorderRepeatValue is implemented by the values of the "mask-border-repeat" property.
+type MaskBorderRepeatValue interface {
+	ValueNode
+	maskBorderRepeatValue()
+}
+
+// MaskBorderRepeatKeyword represents a keyword of the "mask-border-repeat" property.
+type MaskBorderRepeatKeyword string
+
+func (k MaskBorderRepeatKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MaskBorderRepeatKeyword) valueNode()             {}
+func (k MaskBorderRepeatKeyword) maskBorderRepeatValue() {}
+
+// MaskBorderRepeatKeyword also implements the value interfaces of the shorthands of "mask-border-repeat".
+func (k MaskBorderRepeatKeyword) maskBorderValue() {}
+
+// MaskBorderRepeatKeyword value constants.
+const (
+	MaskBorderRepeatStretch MaskBorderRepeatKeyword = "stretch"
+	MaskBorderRepeatRepeat  MaskBorderRepeatKeyword = "repeat"
+	MaskBorderRepeatRound   MaskBorderRepeatKeyword = "round"
+	MaskBorderRepeatSpace   MaskBorderRepeatKeyword = "space"
+)
+
+// MaskBorderRepeat creates a "mask-border-repeat" property.
+// Syntax: [ stretch | repeat | round | space ]{1,2}
+// Example: MaskBorderRepeat(MaskBorderRepeatStretch) -> "mask-border-repeat: stretch;"
+func MaskBorderRepeat(values ...MaskBorderRepeatValue) Property {
+	return Prop("mask-border-repeat", valueNodes(values)...)
+}
+
+// MaskBorderSliceValue is implemented by the values of the "mask-border-slice" property.
+type MaskBorderSliceValue interface {
+	ValueNode
+	maskBorderSliceValue()
+}
+
+// MaskBorderSlice creates a "mask-border-slice" property.
+// Syntax: <number-percentage>{1,4} fill?
+// Example: MaskBorderSlice(Num(0.5)) -> "mask-border-slice: 0.5;"
+func MaskBorderSlice(values ...MaskBorderSliceValue) Property {
+	return Prop("mask-border-slice", valueNodes(values)...)
+}
+
+// MaskBorderSourceValue is implemented by the values of the "mask-border-source" property.
+type MaskBorderSourceValue interface {
+	ValueNode
+	maskBorderSourceValue()
+}
+
+// MaskBorderSourceKeyword represents a keyword of the "mask-border-source" property.
+type MaskBorderSourceKeyword string
+
+func (k MaskBorderSourceKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MaskBorderSourceKeyword) valueNode()             {}
+func (k MaskBorderSourceKeyword) maskBorderSourceValue() {}
+
+// MaskBorderSourceKeyword also implements the value interfaces of the shorthands of "mask-border-source".
+func (k MaskBorderSourceKeyword) maskBorderValue() {}
+
+// MaskBorderSourceKeyword value constants.
+const (
+	MaskBorderSourceNone MaskBorderSourceKeyword = "none"
+)
+
+// MaskBorderSource creates a "mask-border-source" property.
+// Syntax: none | <image>
+// Example: MaskBorderSource(MaskBorderSourceNone) -> "mask-border-source: none;"
+func MaskBorderSource(value MaskBorderSourceValue) Property {
+	return Prop("mask-border-source", value)
+}
+
+// MaskBorderWidthValue is implemented by the values of the "mask-border-width" property.
+type MaskBorderWidthValue interface {
+	ValueNode
+	maskBorderWidthValue()
+}
+
+// MaskBorderWidth creates a "mask-border-width" property.
+// Syntax: [ <length-percentage> | <number> | auto ]{1,4}
+// Example: MaskBorderWidth(PX(1)) -> "mask-border-width: 1px;"
+func MaskBorderWidth(values ...MaskBorderWidthValue) Property {
+	return Prop("mask-border-width", valueNodes(values)...)
+}
+
+// MaskClipValue is implemented by the values of the "mask-clip" property.
+type MaskClipValue interface {
+	ValueNode
+	maskClipValue()
+}
+
+// MaskClipKeyword represents a keyword of the "mask-clip" property.
+type MaskClipKeyword string
+
+func (k MaskClipKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MaskClipKeyword) valueNode()     {}
+func (k MaskClipKeyword) maskClipValue() {}
+
+// MaskClipKeyword also implements the value interfaces of the shorthands of "mask-clip".
+func (k MaskClipKeyword) maskValue() {}
+
+// MaskClipKeyword value constants.
+const (
+	MaskClipContentBox MaskClipKeyword = "content-box"
+	MaskClipPaddingBox MaskClipKeyword = "padding-box"
+	MaskClipBorderBox  MaskClipKeyword = "border-box"
+	MaskClipMarginBox  MaskClipKeyword = "margin-box"
+	MaskClipFillBox    MaskClipKeyword = "fill-box"
+	MaskClipStrokeBox  MaskClipKeyword = "stroke-box"
+	MaskClipViewBox    MaskClipKeyword = "view-box"
+	MaskClipNoClip     MaskClipKeyword = "no-clip"
+)
+
+// MaskClip creates a "mask-clip" property.
+// Syntax: [ <geometry-box> | no-clip ]#
+// Example: MaskClip(MaskClipContentBox) -> "mask-clip: content-box;"
+func MaskClip(values ...MaskClipValue) Property {
+	return commaProp("mask-clip", valueNodes(values)...)
+}
+
+// MaskCompositeValue is implemented by the values of the "mask-composite" property.
+type MaskCompositeValue interface {
+	ValueNode
+	maskCompositeValue()
+}
+
+// MaskCompositeKeyword represents a keyword of the "mask-composite" property.
+type MaskCompositeKeyword string
+
+func (k MaskCompositeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MaskCompositeKeyword) valueNode()          {}
+func (k MaskCompositeKeyword) maskCompositeValue() {}
+
+// MaskCompositeKeyword also implements the value interfaces of the shorthands of "mask-composite".
+func (k MaskCompositeKeyword) maskValue() {}
+
+// MaskCompositeKeyword value constants.
+const (
+	MaskCompositeAdd       MaskCompositeKeyword = "add"
+	MaskCompositeSubtract  MaskCompositeKeyword = "subtract"
+	MaskCompositeIntersect MaskCompositeKeyword = "intersect"
+	MaskCompositeExclude   MaskCompositeKeyword = "exclude"
+)
+
+// MaskComposite creates a "mask-composite" property.
+// Syntax: <compositing-operator>#
+// Example: MaskComposite(MaskCompositeAdd) -> "mask-composite: add;"
+func MaskComposite(values ...MaskCompositeValue) Property {
+	return commaProp("mask-composite", valueNodes(values)...)
+}
+
+// MaskImageValue is implemented by the values of the "mask-image" property.
+type MaskImageValue interface {
+	ValueNode
+	maskImageValue()
+}
+
+// MaskImageKeyword represents a keyword of the "mask-image" property.
+type MaskImageKeyword string
+
+func (k MaskImageKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MaskImageKeyword) valueNode()      {}
+func (k MaskImageKeyword) maskImageValue() {}
+
+// MaskImageKeyword also implements the value interfaces of the shorthands of "mask-image".
+func (k MaskImageKeyword) maskValue() {}
+
+// MaskImageKeyword value constants.
+const (
+	MaskImageNone MaskImageKeyword = "none"
+)
+
+// MaskImage creates a "mask-image" property.
+// Syntax: <mask-reference>#
+// Example: MaskImage(MaskImageNone) -> "mask-image: none;"
+func MaskImage(values ...MaskImageValue) Property {
+	return commaProp("mask-image", valueNodes(values)...)
+}
+
+// MaskModeValue is implemented by the values of the "mask-mode" property.
+type MaskModeValue interface {
+	ValueNode
+	maskModeValue()
+}
+
+// MaskModeKeyword represents a keyword of the "mask-mode" property.
+type MaskModeKeyword string
+
+func (k MaskModeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MaskModeKeyword) valueNode()     {}
+func (k MaskModeKeyword) maskModeValue() {}
+
+// MaskModeKeyword also implements the value interfaces of the shorthands of "mask-mode".
+func (k MaskModeKeyword) maskValue() {}
+
+// MaskModeKeyword value constants.
+const (
+	MaskModeAlpha       MaskModeKeyword = "alpha"
+	MaskModeLuminance   MaskModeKeyword = "luminance"
+	MaskModeMatchSource MaskModeKeyword = "match-source"
+)
+
+// MaskMode creates a "mask-mode" property.
+// Syntax: <masking-mode>#
+// Example: MaskMode(MaskModeAlpha) -> "mask-mode: alpha;"
+func MaskMode(values ...MaskModeValue) Property {
+	return commaProp("mask-mode", valueNodes(values)...)
+}
+
+// MaskOriginValue is implemented by the values of the "mask-origin" property.
+type MaskOriginValue interface {
+	ValueNode
+	maskOriginValue()
+}
+
+// MaskOriginKeyword represents a keyword of the "mask-origin" property.
+type MaskOriginKeyword string
+
+func (k MaskOriginKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MaskOriginKeyword) valueNode()       {}
+func (k MaskOriginKeyword) maskOriginValue() {}
+
+// MaskOriginKeyword also implements the value interfaces of the shorthands of "mask-origin".
+func (k MaskOriginKeyword) maskValue() {}
+
+// MaskOriginKeyword value constants.
+const (
+	MaskOriginContentBox MaskOriginKeyword = "content-box"
+	MaskOriginPaddingBox MaskOriginKeyword = "padding-box"
+	MaskOriginBorderBox  MaskOriginKeyword = "border-box"
+	MaskOriginMarginBox  MaskOriginKeyword = "margin-box"
+	MaskOriginFillBox    MaskOriginKeyword = "fill-box"
+	MaskOriginStrokeBox  MaskOriginKeyword = "stroke-box"
+	MaskOriginViewBox    MaskOriginKeyword = "view-box"
+)
+
+// MaskOrigin creates a "mask-origin" property.
+// Syntax: <geometry-box>#
+// Example: MaskOrigin(MaskOriginContentBox) -> "mask-origin: content-box;"
+func MaskOrigin(values ...MaskOriginValue) Property {
+	return commaProp("mask-origin", valueNodes(values)...)
+}
+
+// MaskPositionValue is implemented by the values of the "mask-position" property.
+type MaskPositionValue interface {
+	ValueNode
+	maskPositionValue()
+}
+
+// MaskPositionKeyword represents a keyword of the "mask-position" property.
+type MaskPositionKeyword string
+
+func (k MaskPositionKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MaskPositionKeyword) valueNode()         {}
+func (k MaskPositionKeyword) maskPositionValue() {}
+
+// MaskPositionKeyword also implements the value interfaces of the shorthands of "mask-position".
+func (k MaskPositionKeyword) maskValue() {}
+
+// MaskPositionKeyword value constants.
+const (
+	MaskPositionLeft   MaskPositionKeyword = "left"
+	MaskPositionCenter MaskPositionKeyword = "center"
+	MaskPositionRight  MaskPositionKeyword = "right"
+	MaskPositionTop    MaskPositionKeyword = "top"
+	MaskPositionBottom MaskPositionKeyword = "bottom"
+)
+
+// MaskPosition creates a "mask-position" property.
+// Syntax: <position>#
+// Example: MaskPosition(MaskPositionRight, PX(8)) -> "mask-position: right 8px;"
+// It sets a single layer; use MaskPositionLayers for several.
+func MaskPosition(values ...MaskPositionValue) Property {
+	return Prop("mask-position", valueNodes(values)...)
+}
+
+// MaskPositionLayers creates a "mask-position" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: MaskPositionLayers([]MaskPositionValue{MaskPositionRight, PX(8)}, []MaskPositionValue{MaskPositionRight, PX(8)}) -> "mask-position: right 8px, right 8px;"
+func MaskPositionLayers(layers ...[]MaskPositionValue) Property {
+	return commaProp("mask-position", layerNodes(layers)...)
+}
+
+// MaskRepeatValue is implemented by the values of the "mask-repeat" property.
+type MaskRepeatValue interface {
+	ValueNode
+	maskRepeatValue()
+}
+
+// MaskRepeatKeyword represents a keyword of the "mask-repeat" property.
+type MaskRepeatKeyword string
+
+func (k MaskRepeatKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MaskRepeatKeyword) valueNode()       {}
+func (k MaskRepeatKeyword) maskRepeatValue() {}
+
+// MaskRepeatKeyword also implements the value interfaces of the shorthands of "mask-repeat".
+func (k MaskRepeatKeyword) maskValue() {}
+
+// MaskRepeatKeyword value constants.
+const (
+	MaskRepeatRepeatX  MaskRepeatKeyword = "repeat-x"
+	MaskRepeatRepeatY  MaskRepeatKeyword = "repeat-y"
+	MaskRepeatRepeat   MaskRepeatKeyword = "repeat"
+	MaskRepeatSpace    MaskRepeatKeyword = "space"
+	MaskRepeatRound    MaskRepeatKeyword = "round"
+	MaskRepeatNoRepeat MaskRepeatKeyword = "no-repeat"
+)
+
+// MaskRepeat creates a "mask-repeat" property.
+// Syntax: <repeat-style>#
+// Example: MaskRepeat(MaskRepeatRepeat, MaskRepeatNoRepeat) -> "mask-repeat: repeat no-repeat;"
+// It sets a single layer; use MaskRepeatLayers for several.
+func MaskRepeat(values ...MaskRepeatValue) Property {
+	return Prop("mask-repeat", valueNodes(values)...)
+}
+
+// MaskRepeatLayers creates a "mask-repeat" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: MaskRepeatLayers([]MaskRepeatValue{MaskRepeatRepeat, MaskRepeatNoRepeat}, []MaskRepeatValue{MaskRepeatRepeat, MaskRepeatNoRepeat}) -> "mask-repeat: repeat no-repeat, repeat no-repeat;"
+func MaskRepeatLayers(layers ...[]MaskRepeatValue) Property {
+	return commaProp("mask-repeat", layerNodes(layers)...)
+}
+
+// MaskSizeValue is implemented by the values of the "mask-size" property.
+type MaskSizeValue interface {
+	ValueNode
+	maskSizeValue()
+}
+
+// MaskSizeKeyword represents a keyword of the "mask-size" property.
+type MaskSizeKeyword string
+
+func (k MaskSizeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MaskSizeKeyword) valueNode()     {}
+func (k MaskSizeKeyword) maskSizeValue() {}
+
+// MaskSizeKeyword also implements the value interfaces of the shorthands of "mask-size".
+func (k MaskSizeKeyword) maskValue() {}
+
+// MaskSizeKeyword value constants.
+const (
+	MaskSizeCover   MaskSizeKeyword = "cover"
+	MaskSizeContain MaskSizeKeyword = "contain"
+)
+
+// MaskSize creates a "mask-size" property.
+// Syntax: <bg-size>#
+// Example: MaskSize(PCT(50), Auto) -> "mask-size: 50% auto;"
+// It sets a single layer; use MaskSizeLayers for several.
+func MaskSize(values ...MaskSizeValue) Property {
+	return Prop("mask-size", valueNodes(values)...)
+}
+
+// MaskSizeLayers creates a "mask-size" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: MaskSizeLayers([]MaskSizeValue{PCT(50), Auto}, []MaskSizeValue{PCT(50), Auto}) -> "mask-size: 50% auto, 50% auto;"
+func MaskSizeLayers(layers ...[]MaskSizeValue) Property {
+	return commaProp("mask-size", layerNodes(layers)...)
+}
+
+// MaskTypeValue is implemented by the values of the "mask-type" property.
+type MaskTypeValue interface {
+	ValueNode
+	maskTypeValue()
+}
+
+// MaskTypeKeyword represents a keyword of the "mask-type" property.
+type MaskTypeKeyword string
+
+func (k MaskTypeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MaskTypeKeyword) valueNode()     {}
+func (k MaskTypeKeyword) maskTypeValue() {}
+
+// MaskTypeKeyword value constants.
+const (
+	MaskTypeLuminance MaskTypeKeyword = "luminance"
+	MaskTypeAlpha     MaskTypeKeyword = "alpha"
+)
+
+// MaskType creates a "mask-type" property.
+// Syntax: luminance | alpha
+// Example: MaskType(MaskTypeLuminance) -> "mask-type: luminance;"
+func MaskType(value MaskTypeValue) Property {
+	return Prop("mask-type", value)
+}
+
+// MathDepthValue is implemented by the values of the "math-depth" property.
+type MathDepthValue interface {
+	ValueNode
+	mathDepthValue()
+}
+
+// MathDepthKeyword represents a keyword of the "math-depth" property.
+type MathDepthKeyword string
+
+func (k MathDepthKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MathDepthKeyword) valueNode()      {}
+func (k MathDepthKeyword) mathDepthValue() {}
+
+// MathDepthKeyword value constants.
+const (
+	MathDepthAutoAdd MathDepthKeyword = "auto-add"
+)
+
+// MathDepth creates a "math-depth" property.
+// Syntax: auto-add | add( <integer> ) | <integer>
+// Example: MathDepth(MathDepthAutoAdd) -> "math-depth: auto-add;"
+func MathDepth(value MathDepthValue) Property {
+	return Prop("math-depth", value)
+}
+
+// MathShiftValue is implemented by the values of the "math-shift" property.
+type MathShiftValue interface {
+	ValueNode
+	mathShiftValue()
+}
+
+// MathShiftKeyword represents a keyword of the "math-shift" property.
+type MathShiftKeyword string
+
+func (k MathShiftKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MathShiftKeyword) valueNode()      {}
+func (k MathShiftKeyword) mathShiftValue() {}
+
+// MathShiftKeyword value constants.
+const (
+	MathShiftNormal  MathShiftKeyword = "normal"
+	MathShiftCompact MathShiftKeyword = "compact"
+)
+
+// MathShift creates a "math-shift" property.
+// Syntax: normal | compact
+// Example: MathShift(MathShiftNormal) -> "math-shift: normal;"
+func MathShift(value MathShiftValue) Property {
+	return Prop("math-shift", value)
+}
+
+// MathStyleValue is implemented by the values of the "math-style" property.
+type MathStyleValue interface {
+	ValueNode
+	mathStyleValue()
+}
+
+// MathStyleKeyword represents a keyword of the "math-style" property.
+type MathStyleKeyword string
+
+func (k MathStyleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MathStyleKeyword) valueNode()      {}
+func (k MathStyleKeyword) mathStyleValue() {}
+
+// MathStyleKeyword value constants.
+const (
+	MathStyleNormal  MathStyleKeyword = "normal"
+	MathStyleCompact MathStyleKeyword = "compact"
+)
+
+// MathStyle creates a "math-style" property.
+// Syntax: normal | compact
+// Example: MathStyle(MathStyleNormal) -> "math-style: normal;"
+func MathStyle(value MathStyleValue) Property {
+	return Prop("math-style", value)
+}
+
+// MaxBlockSizeValue is implemented by the values of the "max-block-size" property.
+type MaxBlockSizeValue interface {
+	ValueNode
+	maxBlockSizeValue()
+}
+
+// MaxBlockSizeKeyword represents a keyword of the "max-block-size" property.
+type MaxBlockSizeKeyword string
+
+func (k MaxBlockSizeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MaxBlockSizeKeyword) valueNode()         {}
+func (k MaxBlockSizeKeyword) maxBlockSizeValue() {}
+
+// MaxBlockSizeKeyword value constants.
+const (
+	MaxBlockSizeNone       MaxBlockSizeKeyword = "none"
+	MaxBlockSizeMinContent MaxBlockSizeKeyword = "min-content"
+	MaxBlockSizeMaxContent MaxBlockSizeKeyword = "max-content"
+	MaxBlockSizeFitContent MaxBlockSizeKeyword = "fit-content"
+)
+
+// MaxBlockSize creates a "max-block-size" property.
+// Syntax: <'max-width'>
+// Example: MaxBlockSize(MaxBlockSizeNone) -> "max-block-size: none;"
+func MaxBlockSize(value MaxBlockSizeValue) Property {
+	return Prop("max-block-size", value)
+}
+
+// MaxHeightValue is implemented by the values of the "max-height" property.
+type MaxHeightValue interface {
+	ValueNode
+	maxHeightValue()
+}
+
+// MaxHeightKeyword represents a keyword of the "max-height" property.
+type MaxHeightKeyword string
+
+func (k MaxHeightKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MaxHeightKeyword) valueNode()      {}
+func (k MaxHeightKeyword) maxHeightValue() {}
+
+// MaxHeightKeyword value constants.
+const (
+	MaxHeightNone       MaxHeightKeyword = "none"
+	MaxHeightMinContent MaxHeightKeyword = "min-content"
+	MaxHeightMaxContent MaxHeightKeyword = "max-content"
+	MaxHeightFitContent MaxHeightKeyword = "fit-content"
+)
+
+// MaxHeight creates a "max-height" property.
+// Syntax: none | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content( <length-percentage [0,∞]> )
+// Example: MaxHeight(MaxHeightNone) -> "max-height: none;"
+func MaxHeight(value MaxHeightValue) Property {
+	return Prop("max-height", value)
+}
+
+// MaxInlineSizeValue is implemented by the values of the "max-inline-size" property.
+type MaxInlineSizeValue interface {
+	ValueNode
+	maxInlineSizeValue()
+}
+
+// MaxInlineSizeKeyword represents a keyword of the "max-inline-size" property.
+type MaxInlineSizeKeyword string
+
+func (k MaxInlineSizeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MaxInlineSizeKeyword) valueNode()          {}
+func (k MaxInlineSizeKeyword) maxInlineSizeValue() {}
+
+// MaxInlineSizeKeyword value constants.
+const (
+	MaxInlineSizeNone       MaxInlineSizeKeyword = "none"
+	MaxInlineSizeMinContent MaxInlineSizeKeyword = "min-content"
+	MaxInlineSizeMaxContent MaxInlineSizeKeyword = "max-content"
+	MaxInlineSizeFitContent MaxInlineSizeKeyword = "fit-content"
+)
+
+// MaxInlineSize creates a "max-inline-size" property.
+// Syntax: <'max-width'>
+// Example: MaxInlineSize(MaxInlineSizeNone) -> "max-inline-size: none;"
+func MaxInlineSize(value MaxInlineSizeValue) Property {
+	return Prop("max-inline-size", value)
+}
+
+// MaxWidthValue is implemented by the values of the "max-width" property.
+type MaxWidthValue interface {
+	ValueNode
+	maxWidthValue()
+}
+
+// MaxWidthKeyword represents a keyword of the "max-width" property.
+type MaxWidthKeyword string
+
+func (k MaxWidthKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MaxWidthKeyword) valueNode()     {}
+func (k MaxWidthKeyword) maxWidthValue() {}
+
+// MaxWidthKeyword value constants.
+const (
+	MaxWidthNone       MaxWidthKeyword = "none"
+	MaxWidthMinContent MaxWidthKeyword = "min-content"
+	MaxWidthMaxContent MaxWidthKeyword = "max-content"
+	MaxWidthFitContent MaxWidthKeyword = "fit-content"
+)
+
+// MaxWidth creates a "max-width" property.
+// Syntax: none | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content( <length-percentage [0,∞]> )
+// Example: MaxWidth(MaxWidthNone) -> "max-width: none;"
+func MaxWidth(value MaxWidthValue) Property {
+	return Prop("max-width", value)
+}
+
+// MinBlockSizeValue is implemented by the values of the "min-block-size" property.
+type MinBlockSizeValue interface {
+	ValueNode
+	minBlockSizeValue()
+}
+
+// MinBlockSizeKeyword represents a keyword of the "min-block-size" property.
+type MinBlockSizeKeyword string
+
+func (k MinBlockSizeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MinBlockSizeKeyword) valueNode()         {}
+func (k MinBlockSizeKeyword) minBlockSizeValue() {}
+
+// MinBlockSizeKeyword value constants.
+const (
+	MinBlockSizeMinContent MinBlockSizeKeyword = "min-content"
+	MinBlockSizeMaxContent MinBlockSizeKeyword = "max-content"
+	MinBlockSizeFitContent MinBlockSizeKeyword = "fit-content"
+)
+
+// MinBlockSize creates a "min-block-size" property.
+// Syntax: <'min-width'>
+// Example: MinBlockSize(MinBlockSizeMinContent) -> "min-block-size: min-content;"
+func MinBlockSize(value MinBlockSizeValue) Property {
+	return Prop("min-block-size", value)
+}
+
+// MinHeightValue is implemented by the values of the "min-height" property.
+type MinHeightValue interface {
+	ValueNode
+	minHeightValue()
+}
+
+// MinHeightKeyword represents a keyword of the "min-height" property.
+type MinHeightKeyword string
+
+func (k MinHeightKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MinHeightKeyword) valueNode()      {}
+func (k MinHeightKeyword) minHeightValue() {}
+
+// MinHeightKeyword value constants.
+const (
+	MinHeightMinContent MinHeightKeyword = "min-content"
+	MinHeightMaxContent MinHeightKeyword = "max-content"
+	MinHeightFitContent MinHeightKeyword = "fit-content"
+)
+
+// MinHeight creates a "min-height" property.
+// Syntax: auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content( <length-percentage [0,∞]> )
+// Example: MinHeight(MinHeightMinContent) -> "min-height: min-content;"
+func MinHeight(value MinHeightValue) Property {
+	return Prop("min-height", value)
+}
+
+// MinInlineSizeValue is implemented by the values of the "min-inline-size" property.
+type MinInlineSizeValue interface {
+	ValueNode
+	minInlineSizeValue()
+}
+
+// MinInlineSizeKeyword represents a keyword of the "min-inline-size" property.
+type MinInlineSizeKeyword string
+
+func (k MinInlineSizeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MinInlineSizeKeyword) valueNode()          {}
+func (k MinInlineSizeKeyword) minInlineSizeValue() {}
+
+// MinInlineSizeKeyword value constants.
+const (
+	MinInlineSizeMinContent MinInlineSizeKeyword = "min-content"
+	MinInlineSizeMaxContent MinInlineSizeKeyword = "max-content"
+	MinInlineSizeFitContent MinInlineSizeKeyword = "fit-content"
+)
+
+// MinInlineSize creates a "min-inline-size" property.
+// Syntax: <'min-width'>
+// Example: MinInlineSize(MinInlineSizeMinContent) -> "min-inline-size: min-content;"
+func MinInlineSize(value MinInlineSizeValue) Property {
+	return Prop("min-inline-size", value)
+}
+
+// MinWidthValue is implemented by the values of the "min-width" property.
+type MinWidthValue interface {
+	ValueNode
+	minWidthValue()
+}
+
+// MinWidthKeyword represents a keyword of the "min-width" property.
+type MinWidthKeyword string
+
+func (k MinWidthKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MinWidthKeyword) valueNode()     {}
+func (k MinWidthKeyword) minWidthValue() {}
+
+// MinWidthKeyword value constants.
+const (
+	MinWidthMinContent MinWidthKeyword = "min-content"
+	MinWidthMaxContent MinWidthKeyword = "max-content"
+	MinWidthFitContent MinWidthKeyword = "fit-content"
+)
+
+// MinWidth creates a "min-width" property.
+// Syntax: auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content( <length-percentage [0,∞]> )
+// Example: MinWidth(MinWidthMinContent) -> "min-width: min-content;"
+func MinWidth(value MinWidthValue) Property {
+	return Prop("min-width", value)
+}
+
+// MixBlendModeValue is implemented by the values of the "mix-blend-mode" property.
+type MixBlendModeValue interface {
+	ValueNode
+	mixBlendModeValue()
+}
+
+// MixBlendModeKeyword represents a keyword of the "mix-blend-mode" property.
+type MixBlendModeKeyword string
+
+func (k MixBlendModeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k MixBlendModeKeyword) valueNode()         {}
+func (k MixBlendModeKeyword) mixBlendModeValue() {}
+
+// MixBlendModeKeyword value constants.
+const (
+	MixBlendModeNormal      MixBlendModeKeyword = "normal"
+	MixBlendModeMultiply    MixBlendModeKeyword = "multiply"
+	MixBlendModeScreen      MixBlendModeKeyword = "screen"
+	MixBlendModeOverlay     MixBlendModeKeyword = "overlay"
+	MixBlendModeDarken      MixBlendModeKeyword = "darken"
+	MixBlendModeLighten     MixBlendModeKeyword = "lighten"
+	MixBlendModeColorDodge  MixBlendModeKeyword = "color-dodge"
+	MixBlendModeColorBurn   MixBlendModeKeyword = "color-burn"
+	MixBlendModeHardLight   MixBlendModeKeyword = "hard-light"
+	MixBlendModeSoftLight   MixBlendModeKeyword = "soft-light"
+	MixBlendModeDifference  MixBlendModeKeyword = "difference"
+	MixBlendModeExclusion   MixBlendModeKeyword = "exclusion"
+	MixBlendModeHue         MixBlendModeKeyword = "hue"
+	MixBlendModeSaturation  MixBlendModeKeyword = "saturation"
+	MixBlendModeColor       MixBlendModeKeyword = "color"
+	MixBlendModeLuminosity  MixBlendModeKeyword = "luminosity"
+	MixBlendModePlusDarker  MixBlendModeKeyword = "plus-darker"
+	MixBlendModePlusLighter MixBlendModeKeyword = "plus-lighter"
+)
+
+// MixBlendMode creates a "mix-blend-mode" property.
+// Syntax: <blend-mode> | plus-darker | plus-lighter
+// Example: MixBlendMode(MixBlendModeNormal) -> "mix-blend-mode: normal;"
+func MixBlendMode(value MixBlendModeValue) Property {
+	return Prop("mix-blend-mode", value)
+}
+
+// ObjectFitValue is implemented by the values of the "object-fit" property.
+type ObjectFitValue interface {
+	ValueNode
+	objectFitValue()
+}
+
+// ObjectFitKeyword represents a keyword of the "object-fit" property.
+type ObjectFitKeyword string
+
+func (k ObjectFitKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ObjectFitKeyword) valueNode()      {}
+func (k ObjectFitKeyword) objectFitValue() {}
+
+// ObjectFitKeyword value constants.
+const (
+	ObjectFitFill      ObjectFitKeyword = "fill"
+	ObjectFitContain   ObjectFitKeyword = "contain"
+	ObjectFitCover     ObjectFitKeyword = "cover"
+	ObjectFitNone      ObjectFitKeyword = "none"
+	ObjectFitScaleDown ObjectFitKeyword = "scale-down"
+)
+
+// ObjectFit creates an "object-fit" property.
+// Syntax: fill | contain | cover | none | scale-down
+// Example: ObjectFit(ObjectFitFill) -> "object-fit: fill;"
+func ObjectFit(value ObjectFitValue) Property {
+	return Prop("object-fit", value)
+}
+
+// ObjectPositionValue is implemented by the values of the "object-position" property.
+type ObjectPositionValue interface {
+	ValueNode
+	objectPositionValue()
+}
+
+// ObjectPositionKeyword represents a keyword of the "object-position" property.
+type ObjectPositionKeyword string
+
+func (k ObjectPositionKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ObjectPositionKeyword) valueNode()           {}
+func (k ObjectPositionKeyword) objectPositionValue() {}
+
+// ObjectPositionKeyword value constants.
+const (
+	ObjectPositionLeft   ObjectPositionKeyword = "left"
+	ObjectPositionCenter ObjectPositionKeyword = "center"
+	ObjectPositionRight  ObjectPositionKeyword = "right"
+	ObjectPositionTop    ObjectPositionKeyword = "top"
+	ObjectPositionBottom ObjectPositionKeyword = "bottom"
+)
+
+// ObjectPosition creates an "object-position" property.
+// Syntax: <position>
+// Example: ObjectPosition(ObjectPositionLeft) -> "object-position: left;"
+func ObjectPosition(values ...ObjectPositionValue) Property {
+	return Prop("object-position", valueNodes(values)...)
+}
+
+// ObjectViewBoxValue is implemented by the values of the "object-view-box" property.
+type ObjectViewBoxValue interface {
+	ValueNode
+	objectViewBoxValue()
+}
+
+// ObjectViewBoxKeyword represents a keyword of the "object-view-box" property.
+type ObjectViewBoxKeyword string
+
+func (k ObjectViewBoxKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ObjectViewBoxKeyword) valueNode()          {}
+func (k ObjectViewBoxKeyword) objectViewBoxValue() {}
+
+// ObjectViewBoxKeyword value constants.
+const (
+	ObjectViewBoxNone ObjectViewBoxKeyword = "none"
+)
+
+// ObjectViewBox creates an "object-view-box" property.
+// Syntax: none | <basic-shape-rect>
+// Example: ObjectViewBox(ObjectViewBoxNone) -> "object-view-box: none;"
+func ObjectViewBox(value ObjectViewBoxValue) Property {
+	return Prop("object-view-box", value)
+}
+
+// OffsetValue is implemented by the values of the "offset" property.
+type OffsetValue interface {
+	ValueNode
+	offsetValue()
+}
+
+// Offset creates an "offset" property.
+// Syntax: [ <'offset-position'>? [ <'offset-path'> [ <'offset-distance'> || <'offset-rotate'> ]? ]? ]! [ / <'offset-anchor'> ]?
+// Example: Offset(Auto) -> "offset: auto;"
+func Offset(values ...OffsetValue) Property {
+	return Prop("offset", valueNodes(values)...)
+}
+
+// OffsetAnchorValue is implemented by the values of the "offset-anchor" property.
+type OffsetAnchorValue interface {
+	ValueNode
+	offsetAnchorValue()
+}
+
+// OffsetAnchorKeyword represents a keyword of the "offset-anchor" property.
+type OffsetAnchorKeyword string
+
+func (k OffsetAnchorKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OffsetAnchorKeyword) valueNode()         {}
+func (k OffsetAnchorKeyword) offsetAnchorValue() {}
+
+// OffsetAnchorKeyword also implements the value interfaces of the shorthands of "offset-anchor".
+func (k OffsetAnchorKeyword) offsetValue() {}
+
+// OffsetAnchorKeyword value constants.
+const (
+	OffsetAnchorLeft   OffsetAnchorKeyword = "left"
+	OffsetAnchorCenter OffsetAnchorKeyword = "center"
+	OffsetAnchorRight  OffsetAnchorKeyword = "right"
+	OffsetAnchorTop    OffsetAnchorKeyword = "top"
+	OffsetAnchorBottom OffsetAnchorKeyword = "bottom"
+)
+
+// OffsetAnchor creates an "offset-anchor" property.
+// Syntax: auto | <position>
+// Example: OffsetAnchor(OffsetAnchorLeft) -> "offset-anchor: left;"
+func OffsetAnchor(values ...OffsetAnchorValue) Property {
+	return Prop("offset-anchor", valueNodes(values)...)
+}
+
+// OffsetDistanceValue is implemented by the values of the "offset-distance" property.
+type OffsetDistanceValue interface {
+	ValueNode
+	offsetDistanceValue()
+}
+
+// OffsetDistance creates an "offset-distance" property.
+// Syntax: <length-percentage>
+// Example: OffsetDistance(PX(1)) -> "offset-distance: 1px;"
+func OffsetDistance(value OffsetDistanceValue) Property {
+	return Prop("offset-distance", value)
+}
+
+// OffsetPathValue is implemented by the values of the "offset-path" property.
+type OffsetPathValue interface {
+	ValueNode
+	offsetPathValue()
+}
+
+// OffsetPathKeyword represents a keyword of the "offset-path" property.
+type OffsetPathKeyword string
+
+func (k OffsetPathKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OffsetPathKeyword) valueNode()       {}
+func (k OffsetPathKeyword) offsetPathValue() {}
+
+// OffsetPathKeyword also implements the value interfaces of the shorthands of "offset-path".
+func (k OffsetPathKeyword) offsetValue() {}
+
+// OffsetPathKeyword value constants.
+const (
+	OffsetPathNone       OffsetPathKeyword = "none"
+	OffsetPathContentBox OffsetPathKeyword = "content-box"
+	OffsetPathPaddingBox OffsetPathKeyword = "padding-box"
+	OffsetPathBorderBox  OffsetPathKeyword = "border-box"
+	OffsetPathFillBox    OffsetPathKeyword = "fill-box"
+	OffsetPathStrokeBox  OffsetPathKeyword = "stroke-box"
+	OffsetPathViewBox    OffsetPathKeyword = "view-box"
+)
+
+// OffsetPath creates an "offset-path" property.
+// Syntax: none | <offset-path> || <coord-box>
+// Example: OffsetPath(OffsetPathNone) -> "offset-path: none;"
+func OffsetPath(values ...OffsetPathValue) Property {
+	return Prop("offset-path", valueNodes(values)...)
+}
+
+// OffsetPositionValue is implemented by the values of the "offset-position" property.
+type OffsetPositionValue interface {
+	ValueNode
+	offsetPositionValue()
+}
+
+// OffsetPositionKeyword represents a keyword of the "offset-position" property.
+type OffsetPositionKeyword string
+
+func (k OffsetPositionKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OffsetPositionKeyword) valueNode()           {}
+func (k OffsetPositionKeyword) offsetPositionValue() {}
+
+// OffsetPositionKeyword also implements the value interfaces of the shorthands of "offset-position".
+func (k OffsetPositionKeyword) offsetValue() {}
+
+// OffsetPositionKeyword value constants.
+const (
+	OffsetPositionNormal OffsetPositionKeyword = "normal"
+	OffsetPositionLeft   OffsetPositionKeyword = "left"
+	OffsetPositionCenter OffsetPositionKeyword = "center"
+	OffsetPositionRight  OffsetPositionKeyword = "right"
+	OffsetPositionTop    OffsetPositionKeyword = "top"
+	OffsetPositionBottom OffsetPositionKeyword = "bottom"
+)
+
+// OffsetPosition creates an "offset-position" property.
+// Syntax: normal | auto | <position>
+// Example: OffsetPosition(OffsetPositionNormal) -> "offset-position: normal;"
+func OffsetPosition(values ...OffsetPositionValue) Property {
+	return Prop("offset-position", valueNodes(values)...)
+}
+
+// OffsetRotateValue is implemented by the values of the "offset-rotate" property.
+type OffsetRotateValue interface {
+	ValueNode
+	offsetRotateValue()
+}
+
+// OffsetRotateKeyword represents a keyword of the "offset-rotate" property.
+type OffsetRotateKeyword string
+
+func (k OffsetRotateKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OffsetRotateKeyword) valueNode()         {}
+func (k OffsetRotateKeyword) offsetRotateValue() {}
+
+// OffsetRotateKeyword also implements the value interfaces of the shorthands of "offset-rotate".
+func (k OffsetRotateKeyword) offsetValue() {}
+
+// OffsetRotateKeyword value constants.
+const (
+	OffsetRotateReverse OffsetRotateKeyword = "reverse"
+)
+
+// OffsetRotate creates an "offset-rotate" property.
+// Syntax: [ auto | reverse ] || <angle>
+// Example: OffsetRotate(OffsetRotateReverse) -> "offset-rotate: reverse;"
+func OffsetRotate(values ...OffsetRotateValue) Property {
+	return Prop("offset-rotate", valueNodes(values)...)
+}
+
+// OpacityValue is implemented by the values of the "opacity" property.
+type OpacityValue interface {
+	ValueNode
+	opacityValue()
+}
+
+// Opacity creates an "opacity" property.
+// Syntax: <alpha-value>
+// Example: Opacity(Num(0.5)) -> "opacity: 0.5;"
+func Opacity(value OpacityValue) Property {
+	return Prop("opacity", value)
+}
+
+// OrderValue is implemented by the values of the "order" property.
+type OrderValue interface {
+	ValueNode
+	orderValue()
+}
+
+// Order creates an "order" property.
+// Syntax: <integer>
+// Example: Order(Int(1)) -> "order: 1;"
+func Order(value OrderValue) Property {
+	return Prop("order", value)
+}
+
+// OrphansValue is implemented by the values of the "orphans" property.
+type OrphansValue interface {
+	ValueNode
+	orphansValue()
+}
+
+// Orphans creates an "orphans" property.
+// Syntax: <integer [1,∞]>
+// Example: Orphans(Int(1)) -> "orphans: 1;"
+func Orphans(value OrphansValue) Property {
+	return Prop("orphans", value)
+}
+
+// OutlineValue is implemented by the values of the "outline" property.
+type OutlineValue interface {
+	ValueNode
+	outlineValue()
+}
+
+// Outline creates an "outline" property.
+// Syntax: [ <'outline-width'> || <'outline-style'> || <'outline-color'> ]
+// Example: Outline(PX(1)) -> "outline: 1px;"
+func Outline(values ...OutlineValue) Property {
+	return Prop("outline", valueNodes(values)...)
+}
+
+// OutlineColorValue is implemented by the values of the "outline-color" property.
+type OutlineColorValue interface {
+	ValueNode
+	outlineColorValue()
+}
+
+// OutlineColor creates an "outline-color" property.
+// Syntax: auto | <color>
+// Example: OutlineColor(Auto) -> "outline-color: auto;"
+func OutlineColor(value OutlineColorValue) Property {
+	return Prop("outline-color", value)
+}
+
+// OutlineOffsetValue is implemented by the values of the "outline-offset" property.
+type OutlineOffsetValue interface {
+	ValueNode
+	outlineOffsetValue()
+}
+
+// OutlineOffset creates an "outline-offset" property.
+// Syntax: <length>
+// Example: OutlineOffset(PX(1)) -> "outline-offset: 1px;"
+func OutlineOffset(value OutlineOffsetValue) Property {
+	return Prop("outline-offset", value)
+}
+
+// OutlineStyleValue is implemented by the values of the "outline-style" property.
+type OutlineStyleValue interface {
+	ValueNode
+	outlineStyleValue()
+}
+
+// OutlineStyleKeyword represents a keyword of the "outline-style" property.
+type OutlineStyleKeyword string
+
+func (k OutlineStyleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OutlineStyleKeyword) valueNode()         {}
+func (k OutlineStyleKeyword) outlineStyleValue() {}
+
+// OutlineStyleKeyword also implements the value interfaces of the shorthands of "outline-style".
+func (k OutlineStyleKeyword) outlineValue() {}
+
+// OutlineStyleKeyword value constants.
+const (
+	OutlineStyleNone   OutlineStyleKeyword = "none"
+	OutlineStyleDotted OutlineStyleKeyword = "dotted"
+	OutlineStyleDashed OutlineStyleKeyword = "dashed"
+	OutlineStyleSolid  OutlineStyleKeyword = "solid"
+	OutlineStyleDouble OutlineStyleKeyword = "double"
+	OutlineStyleGroove OutlineStyleKeyword = "groove"
+	OutlineStyleRidge  OutlineStyleKeyword = "ridge"
+	OutlineStyleInset  OutlineStyleKeyword = "inset"
+	OutlineStyleOutset OutlineStyleKeyword = "outset"
+)
+
+// OutlineStyle creates an "outline-style" property.
+// Syntax: auto | <outline-line-style>
+// Example: OutlineStyle(OutlineStyleNone) -> "outline-style: none;"
+func OutlineStyle(value OutlineStyleValue) Property {
+	return Prop("outline-style", value)
+}
+
+// OutlineWidthValue is implemented by the values of the "outline-width" property.
+type OutlineWidthValue interface {
+	ValueNode
+	outlineWidthValue()
+}
+
+// OutlineWidthKeyword represents a keyword of the "outline-width" property.
+type OutlineWidthKeyword string
+
+func (k OutlineWidthKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OutlineWidthKeyword) valueNode()         {}
+func (k OutlineWidthKeyword) outlineWidthValue() {}
+
+// OutlineWidthKeyword also implements the value interfaces of the shorthands of "outline-width".
+func (k OutlineWidthKeyword) outlineValue() {}
+
+// OutlineWidthKeyword value constants.
+const (
+	OutlineWidthThin   OutlineWidthKeyword = "thin"
+	OutlineWidthMedium OutlineWidthKeyword = "medium"
+	OutlineWidthThick  OutlineWidthKeyword = "thick"
+)
+
+// OutlineWidth creates an "outline-width" property.
+// Syntax: <line-width>
+// Example: OutlineWidth(OutlineWidthThin) -> "outline-width: thin;"
+func OutlineWidth(value OutlineWidthValue) Property {
+	return Prop("outline-width", value)
+}
+
+// OverflowValue is implemented by the values of the "overflow" property.
+type OverflowValue interface {
+	ValueNode
+	overflowValue()
+}
+
+// OverflowKeyword represents a keyword of the "overflow" property.
+type OverflowKeyword string
+
+func (k OverflowKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OverflowKeyword) valueNode()     {}
+func (k OverflowKeyword) overflowValue() {}
+
+// OverflowKeyword value constants.
+const (
+	OverflowVisible OverflowKeyword = "visible"
+	OverflowHidden  OverflowKeyword = "hidden"
+	OverflowClip    OverflowKeyword = "clip"
+	OverflowScroll  OverflowKeyword = "scroll"
+)
+
+// Overflow creates an "overflow" property.
+// Syntax: [ visible | hidden | clip | scroll | auto ]{1,2}
+// Example: Overflow(OverflowVisible) -> "overflow: visible;"
+func Overflow(values ...OverflowValue) Property {
+	return Prop("overflow", valueNodes(values)...)
+}
+
+// OverflowAnchorValue is implemented by the values of the "overflow-anchor" property.
+type OverflowAnchorValue interface {
+	ValueNode
+	overflowAnchorValue()
+}
+
+// OverflowAnchorKeyword represents a keyword of the "overflow-anchor" property.
+type OverflowAnchorKeyword string
+
+func (k OverflowAnchorKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OverflowAnchorKeyword) valueNode()           {}
+func (k OverflowAnchorKeyword) overflowAnchorValue() {}
+
+// OverflowAnchorKeyword value constants.
+const (
+	OverflowAnchorNone OverflowAnchorKeyword = "none"
+)
+
+// OverflowAnchor creates an "overflow-anchor" property.
+// Syntax: auto | none
+// Example: OverflowAnchor(OverflowAnchorNone) -> "overflow-anchor: none;"
+func OverflowAnchor(value OverflowAnchorValue) Property {
+	return Prop("overflow-anchor", value)
+}
+
+// OverflowBlockValue is implemented by the values of the "overflow-block" property.
+type OverflowBlockValue interface {
+	ValueNode
+	overflowBlockValue()
+}
+
+// OverflowBlockKeyword represents a keyword of the "overflow-block" property.
+type OverflowBlockKeyword string
+
+func (k OverflowBlockKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OverflowBlockKeyword) valueNode()          {}
+func (k OverflowBlockKeyword) overflowBlockValue() {}
+
+// OverflowBlockKeyword value constants.
+const (
+	OverflowBlockVisible OverflowBlockKeyword = "visible"
+	OverflowBlockHidden  OverflowBlockKeyword = "hidden"
+	OverflowBlockClip    OverflowBlockKeyword = "clip"
+	OverflowBlockScroll  OverflowBlockKeyword = "scroll"
+)
+
+// OverflowBlock creates an "overflow-block" property.
+// Syntax: visible | hidden | clip | scroll | auto
+// Example: OverflowBlock(OverflowBlockVisible) -> "overflow-block: visible;"
+func OverflowBlock(value OverflowBlockValue) Property {
+	return Prop("overflow-block", value)
+}
+
+// OverflowClipMarginValue is implemented by the values of the "overflow-clip-margin" property.
+type OverflowClipMarginValue interface {
+	ValueNode
+	overflowClipMarginValue()
+}
+
+// OverflowClipMarginKeyword represents a keyword of the "overflow-clip-margin" property.
+type OverflowClipMarginKeyword string
+
+func (k OverflowClipMarginKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OverflowClipMarginKeyword) valueNode()               {}
+func (k OverflowClipMarginKeyword) overflowClipMarginValue() {}
+
+// OverflowClipMarginKeyword value constants.
+const (
+	OverflowClipMarginContentBox OverflowClipMarginKeyword = "content-box"
+	OverflowClipMarginPaddingBox OverflowClipMarginKeyword = "padding-box"
+	OverflowClipMarginBorderBox  OverflowClipMarginKeyword = "border-box"
+)
+
+// OverflowClipMargin creates an "overflow-clip-margin" property.
+// Syntax: <visual-box> || <length [0,∞]>
+// Example: OverflowClipMargin(OverflowClipMarginContentBox) -> "overflow-clip-margin: content-box;"
+func OverflowClipMargin(values ...OverflowClipMarginValue) Property {
+	return Prop("overflow-clip-margin", valueNodes(values)...)
+}
+
+// OverflowInlineValue is implemented by the values of the "overflow-inline" property.
+type OverflowInlineValue interface {
+	ValueNode
+	overflowInlineValue()
+}
+
+// OverflowInlineKeyword represents a keyword of the "overflow-inline" property.
+type OverflowInlineKeyword string
+
+func (k OverflowInlineKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OverflowInlineKeyword) valueNode()           {}
+func (k OverflowInlineKeyword) overflowInlineValue() {}
+
+// OverflowInlineKeyword value constants.
+const (
+	OverflowInlineVisible OverflowInlineKeyword = "visible"
+	OverflowInlineHidden  OverflowInlineKeyword = "hidden"
+	OverflowInlineClip    OverflowInlineKeyword = "clip"
+	OverflowInlineScroll  OverflowInlineKeyword = "scroll"
+)
+
+// OverflowInline creates an "overflow-inline" property.
+// Syntax: visible | hidden | clip | scroll | auto
+// Example: OverflowInline(OverflowInlineVisible) -> "overflow-inline: visible;"
+func OverflowInline(value OverflowInlineValue) Property {
+	return Prop("overflow-inline", value)
+}
+
+// OverflowWrapValue is implemented by the values of the "overflow-wrap" property.
+type OverflowWrapValue interface {
+	ValueNode
+	overflowWrapValue()
+}
+
+// OverflowWrapKeyword represents a keyword of the "overflow-wrap" property.
+type OverflowWrapKeyword string
+
+func (k OverflowWrapKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OverflowWrapKeyword) valueNode()         {}
+func (k OverflowWrapKeyword) overflowWrapValue() {}
+
+// OverflowWrapKeyword value constants.
+const (
+	OverflowWrapNormal    OverflowWrapKeyword = "normal"
+	OverflowWrapBreakWord OverflowWrapKeyword = "break-word"
+	OverflowWrapAnywhere  OverflowWrapKeyword = "anywhere"
+)
+
+// OverflowWrap creates an "overflow-wrap" property.
+// Syntax: normal | break-word | anywhere
+// Example: OverflowWrap(OverflowWrapNormal) -> "overflow-wrap: normal;"
+func OverflowWrap(value OverflowWrapValue) Property {
+	return Prop("overflow-wrap", value)
+}
+
+// OverflowXValue is implemented by the values of the "overflow-x" property.
+type OverflowXValue interface {
+	ValueNode
+	overflowXValue()
+}
+
+// OverflowXKeyword represents a keyword of the "overflow-x" property.
+type OverflowXKeyword string
+
+func (k OverflowXKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OverflowXKeyword) valueNode()      {}
+func (k OverflowXKeyword) overflowXValue() {}
+
+// OverflowXKeyword value constants.
+const (
+	OverflowXVisible OverflowXKeyword = "visible"
+	OverflowXHidden  OverflowXKeyword = "hidden"
+	OverflowXClip    OverflowXKeyword = "clip"
+	OverflowXScroll  OverflowXKeyword = "scroll"
+)
+
+// OverflowX creates an "overflow-x" property.
+// Syntax: visible | hidden | clip | scroll | auto
+// Example: OverflowX(OverflowXVisible) -> "overflow-x: visible;"
+func OverflowX(value OverflowXValue) Property {
+	return Prop("overflow-x", value)
+}
+
+// OverflowYValue is implemented by the values of the "overflow-y" property.
+type OverflowYValue interface {
+	ValueNode
+	overflowYValue()
+}
+
+// OverflowYKeyword represents a keyword of the "overflow-y" property.
+type OverflowYKeyword string
+
+func (k OverflowYKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OverflowYKeyword) valueNode()      {}
+func (k OverflowYKeyword) overflowYValue() {}
+
+// OverflowYKeyword value constants.
+const (
+	OverflowYVisible OverflowYKeyword = "visible"
+	OverflowYHidden  OverflowYKeyword = "hidden"
+	OverflowYClip    OverflowYKeyword = "clip"
+	OverflowYScroll  OverflowYKeyword = "scroll"
+)
+
+// OverflowY creates an "overflow-y" property.
+// Syntax: visible | hidden | clip | scroll | auto
+// Example: OverflowY(OverflowYVisible) -> "overflow-y: visible;"
+func OverflowY(value OverflowYValue) Property {
+	return Prop("overflow-y", value)
+}
+
+// OverlayValue is implemented by the values of the "overlay" property.
+type OverlayValue interface {
+	ValueNode
+	overlayValue()
+}
+
+// OverlayKeyword represents a keyword of the "overlay" property.
+type OverlayKeyword string
+
+func (k OverlayKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OverlayKeyword) valueNode()    {}
+func (k OverlayKeyword) overlayValue() {}
+
+// OverlayKeyword value constants.
+const (
+	OverlayNone OverlayKeyword = "none"
+)
+
+// Overlay creates an "overlay" property.
+// Syntax: none | auto
+// Example: Overlay(OverlayNone) -> "overlay: none;"
+func Overlay(value OverlayValue) Property {
+	return Prop("overlay", value)
+}
+
+// OverscrollBehaviorValue is implemented by the values of the "overscroll-behavior" property.
+type OverscrollBehaviorValue interface {
+	ValueNode
+	overscrollBehaviorValue()
+}
+
+// OverscrollBehaviorKeyword represents a keyword of the "overscroll-behavior" property.
+type OverscrollBehaviorKeyword string
+
+func (k OverscrollBehaviorKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OverscrollBehaviorKeyword) valueNode()               {}
+func (k OverscrollBehaviorKeyword) overscrollBehaviorValue() {}
+
+// OverscrollBehaviorKeyword value constants.
+const (
+	OverscrollBehaviorContain OverscrollBehaviorKeyword = "contain"
+	OverscrollBehaviorNone    OverscrollBehaviorKeyword = "none"
+)
+
+// OverscrollBehavior creates an "overscroll-behavior" property.
+// Syntax: [ contain | none | auto ]{1,2}
+// Example: OverscrollBehavior(OverscrollBehaviorContain) -> "overscroll-behavior: contain;"
+func OverscrollBehavior(values ...OverscrollBehaviorValue) Property {
+	return Prop("overscroll-behavior", valueNodes(values)...)
+}
+
+// OverscrollBehaviorBlockValue is implemented by the values of the "overscroll-behavior-block" property.
+type OverscrollBehaviorBlockValue interface {
+	ValueNode
+	overscrollBehaviorBlockValue()
+}
+
+// OverscrollBehaviorBlockKeyword represents a keyword of the "overscroll-behavior-block" property.
+type OverscrollBehaviorBlockKeyword string
+
+func (k OverscrollBehaviorBlockKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OverscrollBehaviorBlockKeyword) valueNode()                    {}
+func (k OverscrollBehaviorBlockKeyword) overscrollBehaviorBlockValue() {}
+
+// OverscrollBehaviorBlockKeyword value constants.
+const (
+	OverscrollBehaviorBlockContain OverscrollBehaviorBlockKeyword = "contain"
+	OverscrollBehaviorBlockNone    OverscrollBehaviorBlockKeyword = "none"
+)
+
+// OverscrollBehaviorBlock creates an "overscroll-behavior-block" property.
+// Syntax: contain | none | auto
+// Example: OverscrollBehaviorBlock(OverscrollBehaviorBlockContain) -> "overscroll-behavior-block: contain;"
+func OverscrollBehaviorBlock(value OverscrollBehaviorBlockValue) Property {
+	return Prop("overscroll-behavior-block", value)
+}
+
+// OverscrollBehaviorInlineValue is implemented by the values of the "overscroll-behavior-inline" property.
+type OverscrollBehaviorInlineValue interface {
+	ValueNode
+	overscrollBehaviorInlineValue()
+}
+
+// OverscrollBehaviorInlineKeyword represents a keyword of the "overscroll-behavior-inline" property.
+type OverscrollBehaviorInlineKeyword string
+
+func (k OverscrollBehaviorInlineKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OverscrollBehaviorInlineKeyword) valueNode()                     {}
+func (k OverscrollBehaviorInlineKeyword) overscrollBehaviorInlineValue() {}
+
+// OverscrollBehaviorInlineKeyword value constants.
+const (
+	OverscrollBehaviorInlineContain OverscrollBehaviorInlineKeyword = "contain"
+	OverscrollBehaviorInlineNone    OverscrollBehaviorInlineKeyword = "none"
+)
+
+// OverscrollBehaviorInline creates an "overscroll-behavior-inline" property.
+// Syntax: contain | none | auto
+// Example: OverscrollBehaviorInline(OverscrollBehaviorInlineContain) -> "overscroll-behavior-inline: contain;"
+func OverscrollBehaviorInline(value OverscrollBehaviorInlineValue) Property {
+	return Prop("overscroll-behavior-inline", value)
+}
+
+// OverscrollBehaviorXValue is implemented by the values of the "overscroll-behavior-x" property.
+type OverscrollBehaviorXValue interface {
+	ValueNode
+	overscrollBehaviorXValue()
+}
+
+// OverscrollBehaviorXKeyword represents a keyword of the "overscroll-behavior-x" property.
+type OverscrollBehaviorXKeyword string
+
+func (k OverscrollBehaviorXKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OverscrollBehaviorXKeyword) valueNode()                {}
+func (k OverscrollBehaviorXKeyword) overscrollBehaviorXValue() {}
+
+// OverscrollBehaviorXKeyword value constants.
+const (
+	OverscrollBehaviorXContain OverscrollBehaviorXKeyword = "contain"
+	OverscrollBehaviorXNone    OverscrollBehaviorXKeyword = "none"
+)
+
+// OverscrollBehaviorX creates an "overscroll-behavior-x" property.
+// Syntax: contain | none | auto
+// Example: OverscrollBehaviorX(OverscrollBehaviorXContain) -> "overscroll-behavior-x: contain;"
+func OverscrollBehaviorX(value OverscrollBehaviorXValue) Property {
+	return Prop("overscroll-behavior-x", value)
+}
+
+// OverscrollBehaviorYValue is implemented by the values of the "overscroll-behavior-y" property.
+type OverscrollBehaviorYValue interface {
+	ValueNode
+	overscrollBehaviorYValue()
+}
+
+// OverscrollBehaviorYKeyword represents a keyword of the "overscroll-behavior-y" property.
+type OverscrollBehaviorYKeyword string
+
+func (k OverscrollBehaviorYKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k OverscrollBehaviorYKeyword) valueNode()                {}
+func (k OverscrollBehaviorYKeyword) overscrollBehaviorYValue() {}
+
+// OverscrollBehaviorYKeyword value constants.
+const (
+	OverscrollBehaviorYContain OverscrollBehaviorYKeyword = "contain"
+	OverscrollBehaviorYNone    OverscrollBehaviorYKeyword = "none"
+)
+
+// OverscrollBehaviorY creates an "overscroll-behavior-y" property.
+// Syntax: contain | none | auto
+// Example: OverscrollBehaviorY(OverscrollBehaviorYContain) -> "overscroll-behavior-y: contain;"
+func OverscrollBehaviorY(value OverscrollBehaviorYValue) Property {
+	return Prop("overscroll-behavior-y", value)
+}
+
+// PaddingBlockValue is implemented by the values of the "padding-block" property.
+type PaddingBlockValue interface {
+	ValueNode
+	paddingBlockValue()
+}
+
+// PaddingBlock creates a "padding-block" property.
+// Syntax: <'padding-left'>{1,2}
+// Example: PaddingBlock(PX(1)) -> "padding-block: 1px;"
+func PaddingBlock(values ...PaddingBlockValue) Property {
+	return Prop("padding-block", valueNodes(values)...)
+}
+
+// PaddingBlockEndValue is implemented by the values of the "padding-block-end" property.
+type PaddingBlockEndValue interface {
+	ValueNode
+	paddingBlockEndValue()
+}
+
+// PaddingBlockEnd creates a "padding-block-end" property.
+// Syntax: <'padding-left'>
+// Example: PaddingBlockEnd(PX(1)) -> "padding-block-end: 1px;"
+func PaddingBlockEnd(value PaddingBlockEndValue) Property {
+	return Prop("padding-block-end", value)
+}
+
+// PaddingBlockStartValue is implemented by the values of the "padding-block-start" property.
+type PaddingBlockStartValue interface {
+	ValueNode
+	paddingBlockStartValue()
+}
+
+// PaddingBlockStart creates a "padding-block-start" property.
+// Syntax: <'padding-left'>
+// Example: PaddingBlockStart(PX(1)) -> "padding-block-start: 1px;"
+func PaddingBlockStart(value PaddingBlockStartValue) Property {
+	return Prop("padding-block-start", value)
+}
+
+// PaddingInlineValue is implemented by the values of the "padding-inline" property.
+type PaddingInlineValue interface {
+	ValueNode
+	paddingInlineValue()
+}
+
+// PaddingInline creates a "padding-inline" property.
+// Syntax: <'padding-left'>{1,2}
+// Example: PaddingInline(PX(1)) -> "padding-inline: 1px;"
+func PaddingInline(values ...PaddingInlineValue) Property {
+	return Prop("padding-inline", valueNodes(values)...)
+}
+
+// PaddingInlineEndValue is implemented by the values of the "padding-inline-end" property.
+type PaddingInlineEndValue interface {
+	ValueNode
+	paddingInlineEndValue()
+}
+
+// PaddingInlineEnd creates a "padding-inline-end" property.
+// Syntax: <'padding-left'>
+// Example: PaddingInlineEnd(PX(1)) -> "padding-inline-end: 1px;"
+func PaddingInlineEnd(value PaddingInlineEndValue) Property {
+	return Prop("padding-inline-end", value)
+}
+
+// PaddingInlineStartValue is implemented by the values of the "padding-inline-start" property.
+type PaddingInlineStartValue interface {
+	ValueNode
+	paddingInlineStartValue()
+}
+
+// PaddingInlineStart creates a "padding-inline-start" property.
+// Syntax: <'padding-left'>
+// Example: PaddingInlineStart(PX(1)) -> "padding-inline-start: 1px;"
+func PaddingInlineStart(value PaddingInlineStartValue) Property {
+	return Prop("padding-inline-start", value)
+}
+
+// PageValue is implemented by the values of the "page" property.
+type PageValue interface {
+	ValueNode
+	pageValue()
+}
+
+// Page creates a "page" property.
+// Syntax: auto | <custom-ident>
+// Example: Page(Auto) -> "page: auto;"
+func Page(value PageValue) Property {
+	return Prop("page", value)
+}
+
+// PageBreakAfterValue is implemented by the values of the "page-break-after" property.
+type PageBreakAfterValue interface {
+	ValueNode
+	pageBreakAfterValue()
+}
+
+// PageBreakAfterKeyword represents a keyword of the "page-break-after" property.
+type PageBreakAfterKeyword string
+
+func (k PageBreakAfterKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k PageBreakAfterKeyword) valueNode()           {}
+func (k PageBreakAfterKeyword) pageBreakAfterValue() {}
+
+// PageBreakAfterKeyword value constants.
+const (
+	PageBreakAfterAlways PageBreakAfterKeyword = "always"
+	PageBreakAfterAvoid  PageBreakAfterKeyword = "avoid"
+	PageBreakAfterLeft   PageBreakAfterKeyword = "left"
+	PageBreakAfterRight  PageBreakAfterKeyword = "right"
+	PageBreakAfterRecto  PageBreakAfterKeyword = "recto"
+	PageBreakAfterVerso  PageBreakAfterKeyword = "verso"
+)
+
+// PageBreakAfter creates a "page-break-after" property.
+// Syntax: auto | always | avoid | left | right | recto | verso
+// Example: PageBreakAfter(PageBreakAfterAlways) -> "page-break-after: always;"
+func PageBreakAfter(value PageBreakAfterValue) Property {
+	return Prop("page-break-after", value)
+}
+
+// PageBreakBeforeValue is implemented by the values of the "page-break-before" property.
+type PageBreakBeforeValue interface {
+	ValueNode
+	pageBreakBeforeValue()
+}
+
+// PageBreakBeforeKeyword represents a keyword of the "page-break-before" property.
+type PageBreakBeforeKeyword string
+
+func (k PageBreakBeforeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k PageBreakBeforeKeyword) valueNode()            {}
+func (k PageBreakBeforeKeyword) pageBreakBeforeValue() {}
+
+// PageBreakBeforeKeyword value constants.
+const (
+	PageBreakBeforeAlways PageBreakBeforeKeyword = "always"
+	PageBreakBeforeAvoid  PageBreakBeforeKeyword = "avoid"
+	PageBreakBeforeLeft   PageBreakBeforeKeyword = "left"
+	PageBreakBeforeRight  PageBreakBeforeKeyword = "right"
+	PageBreakBeforeRecto  PageBreakBeforeKeyword = "recto"
+	PageBreakBeforeVerso  PageBreakBeforeKeyword = "verso"
+)
+
+// PageBreakBefore creates a "page-break-before" property.
+// Syntax: auto | always | avoid | left | right | recto | verso
+// Example: PageBreakBefore(PageBreakBeforeAlways) -> "page-break-before: always;"
+func PageBreakBefore(value PageBreakBeforeValue) Property {
+	return Prop("page-break-before", value)
+}
+
+// PageBreakInsideValue is implemented by the values of the "page-break-inside" property.
+type PageBreakInsideValue interface {
+	ValueNode
+	pageBreakInsideValue()
+}
+
+// PageBreakInsideKeyword represents a keyword of the "page-break-inside" property.
+type PageBreakInsideKeyword string
+
+func (k PageBreakInsideKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k PageBreakInsideKeyword) valueNode()            {}
+func (k PageBreakInsideKeyword) pageBreakInsideValue() {}
+
+// PageBreakInsideKeyword value constants.
+const (
+	PageBreakInsideAvoid PageBreakInsideKeyword = "avoid"
+)
+
+// PageBreakInside creates a "page-break-inside" property.
+// Syntax: auto | avoid
+// Example: PageBreakInside(PageBreakInsideAvoid) -> "page-break-inside: avoid;"
+func PageBreakInside(value PageBreakInsideValue) Property {
+	return Prop("page-break-inside", value)
+}
+
+// PaintOrderValue is implemented by the values of the "paint-order" property.
+type PaintOrderValue interface {
+	ValueNode
+	paintOrderValue()
+}
+
+// PaintOrderKeyword represents a keyword of the "paint-order" property.
+type PaintOrderKeyword string
+
+func (k PaintOrderKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k PaintOrderKeyword) valueNode()       {}
+func (k PaintOrderKeyword) paintOrderValue() {}
+
+// PaintOrderKeyword value constants.
+const (
+	PaintOrderNormal  PaintOrderKeyword = "normal"
+	PaintOrderFill    PaintOrderKeyword = "fill"
+	PaintOrderStroke  PaintOrderKeyword = "stroke"
+	PaintOrderMarkers PaintOrderKeyword = "markers"
+)
+
+// PaintOrder creates a "paint-order" property.
+// Syntax: normal | [ fill || stroke || markers ]
+// Example: PaintOrder(PaintOrderNormal) -> "paint-order: normal;"
+func PaintOrder(values ...PaintOrderValue) Property {
+	return Prop("paint-order", valueNodes(values)...)
+}
+
+// PerspectiveValue is implemented by the values of the "perspective" property.
+type PerspectiveValue interface {
+	ValueNode
+	perspectiveValue()
+}
+
+// PerspectiveKeyword represents a keyword of the "perspective" property.
+type PerspectiveKeyword string
+
+func (k PerspectiveKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k PerspectiveKeyword) valueNode()        {}
+func (k PerspectiveKeyword) perspectiveValue() {}
+
+// PerspectiveKeyword value constants.
+const (
+	PerspectiveNone PerspectiveKeyword = "none"
+)
+
+// Perspective creates a "perspective" property.
+// Syntax: none | <length>
+// Example: Perspective(PerspectiveNone) -> "perspective: none;"
+func Perspective(value PerspectiveValue) Property {
+	return Prop("perspective", value)
+}
+
+// PerspectiveOriginValue is implemented by the values of the "perspective-origin" property.
+type PerspectiveOriginValue interface {
+	ValueNode
+	perspectiveOriginValue()
+}
+
+// PerspectiveOriginKeyword represents a keyword of the "perspective-origin" property.
+type PerspectiveOriginKeyword string
+
+func (k PerspectiveOriginKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k PerspectiveOriginKeyword) valueNode()              {}
+func (k PerspectiveOriginKeyword) perspectiveOriginValue() {}
+
+// PerspectiveOriginKeyword value constants.
+const (
+	PerspectiveOriginLeft   PerspectiveOriginKeyword = "left"
+	PerspectiveOriginCenter PerspectiveOriginKeyword = "center"
+	PerspectiveOriginRight  PerspectiveOriginKeyword = "right"
+	PerspectiveOriginTop    PerspectiveOriginKeyword = "top"
+	PerspectiveOriginBottom PerspectiveOriginKeyword = "bottom"
+)
+
+// PerspectiveOrigin creates a "perspective-origin" property.
+// Syntax: <position>
+// Example: PerspectiveOrigin(PerspectiveOriginLeft) -> "perspective-origin: left;"
+func PerspectiveOrigin(values ...PerspectiveOriginValue) Property {
+	return Prop("perspective-origin", valueNodes(values)...)
+}
+
+// PlaceContentValue is implemented by the values of the "place-content" property.
+type PlaceContentValue interface {
+	ValueNode
+	placeContentValue()
+}
+
+// PlaceContent creates a "place-content" property.
+// Syntax: <'align-content'> <'justify-content'>?
+// Example: PlaceContent(Inherit) -> "place-content: inherit;"
+func PlaceContent(values ...PlaceContentValue) Property {
+	return Prop("place-content", valueNodes(values)...)
+}
+
+// PlaceItemsValue is implemented by the values of the "place-items" property.
+type PlaceItemsValue interface {
+	ValueNode
+	placeItemsValue()
+}
+
+// PlaceItems creates a "place-items" property.
+// Syntax: <'align-items'> <'justify-items'>?
+// Example: PlaceItems(Inherit) -> "place-items: inherit;"
+func PlaceItems(values ...PlaceItemsValue) Property {
+	return Prop("place-items", valueNodes(values)...)
+}
+
+// PlaceSelfValue is implemented by the values of the "place-self" property.
+type PlaceSelfValue interface {
+	ValueNode
+	placeSelfValue()
+}
+
+// PlaceSelf creates a "place-self" property.
+// Syntax: <'align-self'> <'justify-self'>?
+// Example: PlaceSelf(Auto) -> "place-self: auto;"
+func PlaceSelf(values ...PlaceSelfValue) Property {
+	return Prop("place-self", valueNodes(values)...)
+}
+
+// PointerEventsValue is implemented by the values of the "pointer-events" property.
+type PointerEventsValue interface {
+	ValueNode
+	pointerEventsValue()
+}
+
+// PointerEventsKeyword represents a keyword of the "pointer-events" property.
+type PointerEventsKeyword string
+
+func (k PointerEventsKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k PointerEventsKeyword) valueNode()          {}
+func (k PointerEventsKeyword) pointerEventsValue() {}
+
+// PointerEventsKeyword value constants.
+const (
+	PointerEventsNone           PointerEventsKeyword = "none"
+	PointerEventsVisiblePainted PointerEventsKeyword = "visiblePainted"
+	PointerEventsVisibleFill    PointerEventsKeyword = "visibleFill"
+	PointerEventsVisibleStroke  PointerEventsKeyword = "visibleStroke"
+	PointerEventsVisible        PointerEventsKeyword = "visible"
+	PointerEventsPainted        PointerEventsKeyword = "painted"
+	PointerEventsFill           PointerEventsKeyword = "fill"
+	PointerEventsStroke         PointerEventsKeyword = "stroke"
+	PointerEventsAll            PointerEventsKeyword = "all"
+)
+
+// PointerEvents creates a "pointer-events" property.
+// Syntax: auto | none | visiblePainted | visibleFill | visibleStroke | visible | painted | fill | stroke | all | inherit
+// Example: PointerEvents(PointerEventsNone) -> "pointer-events: none;"
+func PointerEvents(value PointerEventsValue) Property {
+	return Prop("pointer-events", value)
+}
+
+// PositionValue is implemented by the values of the "position" property.
+type PositionValue interface {
+	ValueNode
+	positionValue()
+}
+
+// PositionKeyword represents a keyword of the "position" property.
+type PositionKeyword string
+
+func (k PositionKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k PositionKeyword) valueNode()     {}
+func (k PositionKeyword) positionValue() {}
+
+// PositionKeyword value constants.
+const (
+	PositionStatic   PositionKeyword = "static"
+	PositionRelative PositionKeyword = "relative"
+	PositionAbsolute PositionKeyword = "absolute"
+	PositionSticky   PositionKeyword = "sticky"
+	PositionFixed    PositionKeyword = "fixed"
+)
+
+// Position creates a "position" property.
+// Syntax: static | relative | absolute | sticky | fixed
+// Example: Position(PositionStatic) -> "position: static;"
+func Position(value PositionValue) Property {
+	return Prop("position", value)
+}
+
+// PositionAnchorValue is implemented by the values of the "position-anchor" property.
+type PositionAnchorValue interface {
+	ValueNode
+	positionAnchorValue()
+}
+
+// PositionAnchor creates a "position-anchor" property.
+// Syntax: auto | <anchor-name>
+// Example: PositionAnchor(Auto) -> "position-anchor: auto;"
+func PositionAnchor(value PositionAnchorValue) Property {
+	return Prop("position-anchor", value)
+}
+
+// PositionAreaValue is implemented by the values of the "position-area" property.
+type PositionAreaValue interface {
+	ValueNode
+	positionAreaValue()
+}
+
+// PositionAreaKeyword represents a keyword of the "position-area" property.
+type PositionAreaKeyword string
+
+func (k PositionAreaKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k PositionAreaKeyword) valueNode()         {}
+func (k PositionAreaKeyword) positionAreaValue() {}
+
+// PositionAreaKeyword value constants.
+const (
+	PositionAreaNone                PositionAreaKeyword = "none"
+	PositionAreaLeft                PositionAreaKeyword = "left"
+	PositionAreaCenter              PositionAreaKeyword = "center"
+	PositionAreaRight               PositionAreaKeyword = "right"
+	PositionAreaSpanLeft            PositionAreaKeyword = "span-left"
+	PositionAreaSpanRight           PositionAreaKeyword = "span-right"
+	PositionAreaXStart              PositionAreaKeyword = "x-start"
+	PositionAreaXEnd                PositionAreaKeyword = "x-end"
+	PositionAreaSpanXStart          PositionAreaKeyword = "span-x-start"
+	PositionAreaSpanXEnd            PositionAreaKeyword = "span-x-end"
+	PositionAreaXSelfStart          PositionAreaKeyword = "x-self-start"
+	PositionAreaXSelfEnd            PositionAreaKeyword = "x-self-end"
+	PositionAreaSpanXSelfStart      PositionAreaKeyword = "span-x-self-start"
+	PositionAreaSpanXSelfEnd        PositionAreaKeyword = "span-x-self-end"
+	PositionAreaSpanAll             PositionAreaKeyword = "span-all"
+	PositionAreaTop                 PositionAreaKeyword = "top"
+	PositionAreaBottom              PositionAreaKeyword = "bottom"
+	PositionAreaSpanTop             PositionAreaKeyword = "span-top"
+	PositionAreaSpanBottom          PositionAreaKeyword = "span-bottom"
+	PositionAreaYStart              PositionAreaKeyword = "y-start"
+	PositionAreaYEnd                PositionAreaKeyword = "y-end"
+	PositionAreaSpanYStart          PositionAreaKeyword = "span-y-start"
+	PositionAreaSpanYEnd            PositionAreaKeyword = "span-y-end"
+	PositionAreaYSelfStart          PositionAreaKeyword = "y-self-start"
+	PositionAreaYSelfEnd            PositionAreaKeyword = "y-self-end"
+	PositionAreaSpanYSelfStart      PositionAreaKeyword = "span-y-self-start"
+	PositionAreaSpanYSelfEnd        PositionAreaKeyword = "span-y-self-end"
+	PositionAreaBlockStart          PositionAreaKeyword = "block-start"
+	PositionAreaBlockEnd            PositionAreaKeyword = "block-end"
+	PositionAreaSpanBlockStart      PositionAreaKeyword = "span-block-start"
+	PositionAreaSpanBlockEnd        PositionAreaKeyword = "span-block-end"
+	PositionAreaInlineStart         PositionAreaKeyword = "inline-start"
+	PositionAreaInlineEnd           PositionAreaKeyword = "inline-end"
+	PositionAreaSpanInlineStart     PositionAreaKeyword = "span-inline-start"
+	PositionAreaSpanInlineEnd       PositionAreaKeyword = "span-inline-end"
+	PositionAreaSelfBlockStart      PositionAreaKeyword = "self-block-start"
+	PositionAreaSelfBlockEnd        PositionAreaKeyword = "self-block-end"
+	PositionAreaSpanSelfBlockStart  PositionAreaKeyword = "span-self-block-start"
+	PositionAreaSpanSelfBlockEnd    PositionAreaKeyword = "span-self-block-end"
+	PositionAreaSelfInlineStart     PositionAreaKeyword = "self-inline-start"
+	PositionAreaSelfInlineEnd       PositionAreaKeyword = "self-inline-end"
+	PositionAreaSpanSelfInlineStart PositionAreaKeyword = "span-self-inline-start"
+	PositionAreaSpanSelfInlineEnd   PositionAreaKeyword = "span-self-inline-end"
+	PositionAreaStart               PositionAreaKeyword = "start"
+	PositionAreaEnd                 PositionAreaKeyword = "end"
+	PositionAreaSpanStart           PositionAreaKeyword = "span-start"
+	PositionAreaSpanEnd             PositionAreaKeyword = "span-end"
+	PositionAreaSelfStart           PositionAreaKeyword = "self-start"
+	PositionAreaSelfEnd             PositionAreaKeyword = "self-end"
+	PositionAreaSpanSelfStart       PositionAreaKeyword = "span-self-start"
+	PositionAreaSpanSelfEnd         PositionAreaKeyword = "span-self-end"
+)
+
+// PositionArea creates a "position-area" property.
+// Syntax: none | <position-area>
+// Example: PositionArea(PositionAreaNone) -> "position-area: none;"
+func PositionArea(values ...PositionAreaValue) Property {
+	return Prop("position-area", valueNodes(values)...)
+}
+
+// PositionTryValue is implemented by the values of the "position-try" property.
+type PositionTryValue interface {
+	ValueNode
+	positionTryValue()
+}
+
+// PositionTry creates a "position-try" property.
+// Syntax: <'position-try-order'>? <'position-try-fallbacks'>
+// Example: PositionTry(Ident("--a")) -> "position-try: --a;"
+func PositionTry(values ...PositionTryValue) Property {
+	return Prop("position-try", valueNodes(values)...)
+}
+
+// PositionTryFallbacksValue is implemented by the values of the "position-try-fallbacks" property.
+type PositionTryFallbacksValue interface {
+	ValueNode
+	positionTryFallbacksValue()
+}
+
+// PositionTryFallbacksKeyword represents a keyword of the "position-try-fallbacks" property.
+type PositionTryFallbacksKeyword string
+
+func (k PositionTryFallbacksKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k PositionTryFallbacksKeyword) valueNode()                 {}
+func (k PositionTryFallbacksKeyword) positionTryFallbacksValue() {}
+
+// PositionTryFallbacksKeyword also implements the value interfaces of the shorthands of "position-try-fallbacks".
+func (k PositionTryFallbacksKeyword) positionTryValue() {}
+
+// PositionTryFallbacksKeyword value constants.
+const (
+	PositionTryFallbacksNone                PositionTryFallbacksKeyword = "none"
+	PositionTryFallbacksFlipBlock           PositionTryFallbacksKeyword = "flip-block"
+	PositionTryFallbacksFlipInline          PositionTryFallbacksKeyword = "flip-inline"
+	PositionTryFallbacksFlipStart           PositionTryFallbacksKeyword = "flip-start"
+	PositionTryFallbacksLeft                PositionTryFallbacksKeyword = "left"
+	PositionTryFallbacksCenter              PositionTryFallbacksKeyword = "center"
+	PositionTryFallbacksRight               PositionTryFallbacksKeyword = "right"
+	PositionTryFallbacksSpanLeft            PositionTryFallbacksKeyword = "span-left"
+	PositionTryFallbacksSpanRight           PositionTryFallbacksKeyword = "span-right"
+	PositionTryFallbacksXStart              PositionTryFallbacksKeyword = "x-start"
+	PositionTryFallbacksXEnd                PositionTryFallbacksKeyword = "x-end"
+	PositionTryFallbacksSpanXStart          PositionTryFallbacksKeyword = "span-x-start"
+	PositionTryFallbacksSpanXEnd            PositionTryFallbacksKeyword = "span-x-end"
+	PositionTryFallbacksXSelfStart          PositionTryFallbacksKeyword = "x-self-start"
+	PositionTryFallbacksXSelfEnd            PositionTryFallbacksKeyword = "x-self-end"
+	PositionTryFallbacksSpanXSelfStart      PositionTryFallbacksKeyword = "span-x-self-start"
+	PositionTryFallbacksSpanXSelfEnd        PositionTryFallbacksKeyword = "span-x-self-end"
+	PositionTryFallbacksSpanAll             PositionTryFallbacksKeyword = "span-all"
+	PositionTryFallbacksTop                 PositionTryFallbacksKeyword = "top"
+	PositionTryFallbacksBottom              PositionTryFallbacksKeyword = "bottom"
+	PositionTryFallbacksSpanTop             PositionTryFallbacksKeyword = "span-top"
+	PositionTryFallbacksSpanBottom          PositionTryFallbacksKeyword = "span-bottom"
+	PositionTryFallbacksYStart              PositionTryFallbacksKeyword = "y-start"
+	PositionTryFallbacksYEnd                PositionTryFallbacksKeyword = "y-end"
+	PositionTryFallbacksSpanYStart          PositionTryFallbacksKeyword = "span-y-start"
+	PositionTryFallbacksSpanYEnd            PositionTryFallbacksKeyword = "span-y-end"
+	PositionTryFallbacksYSelfStart          PositionTryFallbacksKeyword = "y-self-start"
+	PositionTryFallbacksYSelfEnd            PositionTryFallbacksKeyword = "y-self-end"
+	PositionTryFallbacksSpanYSelfStart      PositionTryFallbacksKeyword = "span-y-self-start"
+	PositionTryFallbacksSpanYSelfEnd        PositionTryFallbacksKeyword = "span-y-self-end"
+	PositionTryFallbacksBlockStart          PositionTryFallbacksKeyword = "block-start"
+	PositionTryFallbacksBlockEnd            PositionTryFallbacksKeyword = "block-end"
+	PositionTryFallbacksSpanBlockStart      PositionTryFallbacksKeyword = "span-block-start"
+	PositionTryFallbacksSpanBlockEnd        PositionTryFallbacksKeyword = "span-block-end"
+	PositionTryFallbacksInlineStart         PositionTryFallbacksKeyword = "inline-start"
+	PositionTryFallbacksInlineEnd           PositionTryFallbacksKeyword = "inline-end"
+	PositionTryFallbacksSpanInlineStart     PositionTryFallbacksKeyword = "span-inline-start"
+	PositionTryFallbacksSpanInlineEnd       PositionTryFallbacksKeyword = "span-inline-end"
+	PositionTryFallbacksSelfBlockStart      PositionTryFallbacksKeyword = "self-block-start"
+	PositionTryFallbacksSelfBlockEnd        PositionTryFallbacksKeyword = "self-block-end"
+	PositionTryFallbacksSpanSelfBlockStart  PositionTryFallbacksKeyword = "span-self-block-start"
+	PositionTryFallbacksSpanSelfBlockEnd    PositionTryFallbacksKeyword = "span-self-block-end"
+	PositionTryFallbacksSelfInlineStart     PositionTryFallbacksKeyword = "self-inline-start"
+	PositionTryFallbacksSelfInlineEnd       PositionTryFallbacksKeyword = "self-inline-end"
+	PositionTryFallbacksSpanSelfInlineStart PositionTryFallbacksKeyword = "span-self-inline-start"
+	PositionTryFallbacksSpanSelfInlineEnd   PositionTryFallbacksKeyword = "span-self-inline-end"
+	PositionTryFallbacksStart               PositionTryFallbacksKeyword = "start"
+	PositionTryFallbacksEnd                 PositionTryFallbacksKeyword = "end"
+	PositionTryFallbacksSpanStart           PositionTryFallbacksKeyword = "span-start"
+	PositionTryFallbacksSpanEnd             PositionTryFallbacksKeyword = "span-end"
+	PositionTryFallbacksSelfStart           PositionTryFallbacksKeyword = "self-start"
+	PositionTryFallbacksSelfEnd             PositionTryFallbacksKeyword = "self-end"
+	PositionTryFallbacksSpanSelfStart       PositionTryFallbacksKeyword = "span-self-start"
+	PositionTryFallbacksSpanSelfEnd         PositionTryFallbacksKeyword = "span-self-end"
+)
+
+// PositionTryFallbacks creates a "position-try-fallbacks" property.
+// Syntax: none | [ [ <dashed-ident> || <try-tactic> ] | <'position-area'> ]#
+// Example: PositionTryFallbacks(Ident("--a"), PositionTryFallbacksFlipBlock) -> "position-try-fallbacks: --a flip-block;"
+// It sets a single layer; use PositionTryFallbacksLayers for several.
+func PositionTryFallbacks(values ...PositionTryFallbacksValue) Property {
+	return Prop("position-try-fallbacks", valueNodes(values)...)
+}
+
+// PositionTryFallbacksLayers creates a "position-try-fallbacks" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: PositionTryFallbacksLayers([]PositionTryFallbacksValue{Ident("--a"), PositionTryFallbacksFlipBlock}, []PositionTryFallbacksValue{Ident("--a"), PositionTryFallbacksFlipBlock}) -> "position-try-fallbacks: --a flip-block, --a flip-block;"
+func PositionTryFallbacksLayers(layers ...[]PositionTryFallbacksValue) Property {
+	return commaProp("position-try-fallbacks", layerNodes(layers)...)
+}
+
+// PositionTryOrderValue is implemented by the values of the "position-try-order" property.
+type PositionTryOrderValue interface {
+	ValueNode
+	positionTryOrderValue()
+}
+
+// PositionTryOrderKeyword represents a keyword of the "position-try-order" property.
+type PositionTryOrderKeyword string
+
+func (k PositionTryOrderKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k PositionTryOrderKeyword) valueNode()             {}
+func (k PositionTryOrderKeyword) positionTryOrderValue() {}
+
+// PositionTryOrderKeyword also implements the value interfaces of the shorthands of "position-try-order".
+func (k PositionTryOrderKeyword) positionTryValue() {}
+
+// PositionTryOrderKeyword value constants.
+const (
+	PositionTryOrderNormal         PositionTryOrderKeyword = "normal"
+	PositionTryOrderMostWidth      PositionTryOrderKeyword = "most-width"
+	PositionTryOrderMostHeight     PositionTryOrderKeyword = "most-height"
+	PositionTryOrderMostBlockSize  PositionTryOrderKeyword = "most-block-size"
+	PositionTryOrderMostInlineSize PositionTryOrderKeyword = "most-inline-size"
+)
+
+// PositionTryOrder creates a "position-try-order" property.
+// Syntax: normal | <try-size>
+// Example: PositionTryOrder(PositionTryOrderNormal) -> "position-try-order: normal;"
+func PositionTryOrder(value PositionTryOrderValue) Property {
+	return Prop("position-try-order", value)
+}
+
+// PositionVisibilityValue is implemented by the values of the "position-visibility" property.
+type PositionVisibilityValue interface {
+	ValueNode
+	positionVisibilityValue()
+}
+
+// PositionVisibilityKeyword represents a keyword of the "position-visibility" property.
+type PositionVisibilityKeyword string
+
+func (k PositionVisibilityKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k PositionVisibilityKeyword) valueNode()               {}
+func (k PositionVisibilityKeyword) positionVisibilityValue() {}
+
+// PositionVisibilityKeyword value constants.
+const (
+	PositionVisibilityAlways         PositionVisibilityKeyword = "always"
+	PositionVisibilityAnchorsValid   PositionVisibilityKeyword = "anchors-valid"
+	PositionVisibilityAnchorsVisible PositionVisibilityKeyword = "anchors-visible"
+	PositionVisibilityNoOverflow     PositionVisibilityKeyword = "no-overflow"
+)
+
+// PositionVisibility creates a "position-visibility" property.
+// Syntax: always | [ anchors-valid || anchors-visible || no-overflow ]
+// Example: PositionVisibility(PositionVisibilityAlways) -> "position-visibility: always;"
+func PositionVisibility(values ...PositionVisibilityValue) Property {
+	return Prop("position-visibility", valueNodes(values)...)
+}
+
+// PrintColorAdjustValue is implemented by the values of the "print-color-adjust" property.
+type PrintColorAdjustValue interface {
+	ValueNode
+	printColorAdjustValue()
+}
+
+// PrintColorAdjustKeyword represents a keyword of the "print-color-adjust" property.
+type PrintColorAdjustKeyword string
+
+func (k PrintColorAdjustKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k PrintColorAdjustKeyword) valueNode()             {}
+func (k PrintColorAdjustKeyword) printColorAdjustValue() {}
+
+// PrintColorAdjustKeyword value constants.
+const (
+	PrintColorAdjustEconomy PrintColorAdjustKeyword = "economy"
+	PrintColorAdjustExact   PrintColorAdjustKeyword = "exact"
+)
+
+// PrintColorAdjust creates a "print-color-adjust" property.
+// Syntax: economy | exact
+// Example: PrintColorAdjust(PrintColorAdjustEconomy) -> "print-color-adjust: economy;"
+func PrintColorAdjust(value PrintColorAdjustValue) Property {
+	return Prop("print-color-adjust", value)
+}
+
+// QuotesValue is implemented by the values of the "quotes" property.
+type QuotesValue interface {
+	ValueNode
+	quotesValue()
+}
+
+// QuotesKeyword represents a keyword of the "quotes" property.
+type QuotesKeyword string
+
+func (k QuotesKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k QuotesKeyword) valueNode()   {}
+func (k QuotesKeyword) quotesValue() {}
+
+// QuotesKeyword value constants.
+const (
+	QuotesNone QuotesKeyword = "none"
+)
+
+// Quotes creates a "quotes" property.
+// Syntax: none | auto | [ <string> <string> ]+
+// Example: Quotes(QuotesNone) -> "quotes: none;"
+func Quotes(values ...QuotesValue) Property {
+	return Prop("quotes", valueNodes(values)...)
+}
+
+// RValue is implemented by the values of the "r" property.
+type RValue interface {
+	ValueNode
+	rValue()
+}
+
+// R creates a "r" property.
+// Syntax: <length> | <percentage>
+// Example: R(PX(1)) -> "r: 1px;"
+func R(value RValue) Property {
+	return Prop("r", value)
+}
+
+// ReadingFlowValue is implemented by the values of the "reading-flow" property.
+type ReadingFlowValue interface {
+	ValueNode
+	readingFlowValue()
+}
+
+// ReadingFlowKeyword represents a keyword of the "reading-flow" property.
+type ReadingFlowKeyword string
+
+func (k ReadingFlowKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ReadingFlowKeyword) valueNode()        {}
+func (k ReadingFlowKeyword) readingFlowValue() {}
+
+// ReadingFlowKeyword value constants.
+const (
+	ReadingFlowNormal      ReadingFlowKeyword = "normal"
+	ReadingFlowFlexVisual  ReadingFlowKeyword = "flex-visual"
+	ReadingFlowFlexFlow    ReadingFlowKeyword = "flex-flow"
+	ReadingFlowGridRows    ReadingFlowKeyword = "grid-rows"
+	ReadingFlowGridColumns ReadingFlowKeyword = "grid-columns"
+	ReadingFlowGridOrder   ReadingFlowKeyword = "grid-order"
+)
+
+// ReadingFlow creates a "reading-flow" property.
+// Syntax: normal | flex-visual | flex-flow | grid-rows | grid-columns | grid-order
+// Example: ReadingFlow(ReadingFlowNormal) -> "reading-flow: normal;"
+func ReadingFlow(value ReadingFlowValue) Property {
+	return Prop("reading-flow", value)
+}
+
+// ResizeValue is implemented by the values of the "resize" property.
+type ResizeValue interface {
+	ValueNode
+	resizeValue()
+}
+
+// ResizeKeyword represents a keyword of the "resize" property.
+type ResizeKeyword string
+
+func (k ResizeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ResizeKeyword) valueNode()   {}
+func (k ResizeKeyword) resizeValue() {}
+
+// ResizeKeyword value constants.
+const (
+	ResizeNone       ResizeKeyword = "none"
+	ResizeBoth       ResizeKeyword = "both"
+	ResizeHorizontal ResizeKeyword = "horizontal"
+	ResizeVertical   ResizeKeyword = "vertical"
+	ResizeBlock      ResizeKeyword = "block"
+	ResizeInline     ResizeKeyword = "inline"
+)
+
+// Resize creates a "resize" property.
+// Syntax: none | both | horizontal | vertical | block | inline
+// Example: Resize(ResizeNone) -> "resize: none;"
+func Resize(value ResizeValue) Property {
+	return Prop("resize", value)
+}
+
+// RightValue is implemented by the values of the "right" property.
+type RightValue interface {
+	ValueNode
+	rightValue()
+}
+
+// Right creates a "right" property.
+// Syntax: <length-percentage> | auto
+// Example: Right(PX(1)) -> "right: 1px;"
+func Right(value RightValue) Property {
+	return Prop("right", value)
+}
+
+// RotateValue is implemented by the values of the "rotate" property.
+type RotateValue interface {
+	ValueNode
+	rotateValue()
+}
+
+// RotateKeyword represents a keyword of the "rotate" property.
+type RotateKeyword string
+
+func (k RotateKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k RotateKeyword) valueNode()   {}
+func (k RotateKeyword) rotateValue() {}
+
+// RotateKeyword value constants.
+const (
+	RotateNone RotateKeyword = "none"
+)
+
+// Rotate creates a "rotate" property.
+// Syntax: none | <angle> | [ x | y | z | <number>{3} ] && <angle>
+// Example: Rotate(RotateNone) -> "rotate: none;"
+func Rotate(values ...RotateValue) Property {
+	return Prop("rotate", valueNodes(values)...)
+}
+
+// RowGapValue is implemented by the values of the "row-gap" property.
+type RowGapValue interface {
+	ValueNode
+	rowGapValue()
+}
+
+// RowGapKeyword represents a keyword of the "row-gap" property.
+type RowGapKeyword string
+
+func (k RowGapKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k RowGapKeyword) valueNode()   {}
+func (k RowGapKeyword) rowGapValue() {}
+
+// RowGapKeyword also implements the value interfaces of the shorthands of "row-gap".
+func (k RowGapKeyword) gapValue() {}
+
+// RowGapKeyword value constants.
+const (
+	RowGapNormal RowGapKeyword = "normal"
+)
+
+// RowGap creates a "row-gap" property.
+// Syntax: normal | <length-percentage [0,∞]>
+// Example: RowGap(RowGapNormal) -> "row-gap: normal;"
+func RowGap(value RowGapValue) Property {
+	return Prop("row-gap", value)
+}
+
+// RubyAlignValue is implemented by the values of the "ruby-align" property.
+type RubyAlignValue interface {
+	ValueNode
+	rubyAlignValue()
+}
+
+// RubyAlignKeyword represents a keyword of the "ruby-align" property.
+type RubyAlignKeyword string
+
+func (k RubyAlignKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k RubyAlignKeyword) valueNode()      {}
+func (k RubyAlignKeyword) rubyAlignValue() {}
+
+// RubyAlignKeyword value constants.
+const (
+	RubyAlignStart        RubyAlignKeyword = "start"
+	RubyAlignCenter       RubyAlignKeyword = "center"
+	RubyAlignSpaceBetween RubyAlignKeyword = "space-between"
+	RubyAlignSpaceAround  RubyAlignKeyword = "space-around"
+)
+
+// RubyAlign creates a "ruby-align" property.
+// Syntax: start | center | space-between | space-around
+// Example: RubyAlign(RubyAlignStart) -> "ruby-align: start;"
+func RubyAlign(value RubyAlignValue) Property {
+	return Prop("ruby-align", value)
+}
+
+// RubyMergeValue is implemented by the values of the "ruby-merge" property.
+type RubyMergeValue interface {
+	ValueNode
+	rubyMergeValue()
+}
+
+// RubyMergeKeyword represents a keyword of the "ruby-merge" property.
+type RubyMergeKeyword string
+
+func (k RubyMergeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k RubyMergeKeyword) valueNode()      {}
+func (k RubyMergeKeyword) rubyMergeValue() {}
+
+// RubyMergeKeyword value constants.
+const (
+	RubyMergeSeparate RubyMergeKeyword = "separate"
+	RubyMergeMerge    RubyMergeKeyword = "merge"
+)
+
+// RubyMerge creates a "ruby-merge" property.
+// Syntax: separate | merge | auto
+// Example: RubyMerge(RubyMergeSeparate) -> "ruby-merge: separate;"
+func RubyMerge(value RubyMergeValue) Property {
+	return Prop("ruby-merge", value)
+}
+
+// RubyPositionValue is implemented by the values of the "ruby-position" property.
+type RubyPositionValue interface {
+	ValueNode
+	rubyPositionValue()
+}
+
+// RubyPositionKeyword represents a keyword of the "ruby-position" property.
+type RubyPositionKeyword string
+
+func (k RubyPositionKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k RubyPositionKeyword) valueNode()         {}
+func (k RubyPositionKeyword) rubyPositionValue() {}
+
+// RubyPositionKeyword value constants.
+const (
+	RubyPositionAlternate      RubyPositionKeyword = "alternate"
+	RubyPositionOver           RubyPositionKeyword = "over"
+	RubyPositionUnder          RubyPositionKeyword = "under"
+	RubyPositionInterCharacter RubyPositionKeyword = "inter-character"
+)
+
+// RubyPosition creates a "ruby-position" property.
+// Syntax: [ alternate || [ over | under ] ] | inter-character
+// Example: RubyPosition(RubyPositionAlternate) -> "ruby-position: alternate;"
+func RubyPosition(values ...RubyPositionValue) Property {
+	return Prop("ruby-position", valueNodes(values)...)
+}
+
+// RxValue is implemented by the values of the "rx" property.
+type RxValue interface {
+	ValueNode
+	rxValue()
+}
+
+// Rx creates a "rx" property.
+// Syntax: <length> | <percentage> | auto
+// Example: Rx(PX(1)) -> "rx: 1px;"
+func Rx(value RxValue) Property {
+	return Prop("rx", value)
+}
+
+// RyValue is implemented by the values of the "ry" property.
+type RyValue interface {
+	ValueNode
+	ryValue()
+}
+
+// Ry creates a "ry" property.
+// Syntax: <length> | <percentage> | auto
+// Example: Ry(PX(1)) -> "ry: 1px;"
+func Ry(value RyValue) Property {
+	return Prop("ry", value)
+}
+
+// ScaleValue is implemented by the values of the "scale" property.
+type ScaleValue interface {
+	ValueNode
+	scaleValue()
+}
+
+// ScaleKeyword represents a keyword of the "scale" property.
+type ScaleKeyword string
+
+func (k ScaleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ScaleKeyword) valueNode()  {}
+func (k ScaleKeyword) scaleValue() {}
+
+// ScaleKeyword value constants.
+const (
+	ScaleNone ScaleKeyword = "none"
+)
+
+// Scale creates a "scale" property.
+// Syntax: none | [ <number> | <percentage> ]{1,3}
+// Example: Scale(ScaleNone) -> "scale: none;"
+func Scale(values ...ScaleValue) Property {
+	return Prop("scale", valueNodes(values)...)
+}
+
+// ScrollBehaviorValue is implemented by the values of the "scroll-behavior" property.
+type ScrollBehaviorValue interface {
+	ValueNode
+	scrollBehaviorValue()
+}
+
+// ScrollBehaviorKeyword represents a keyword of the "scroll-behavior" property.
+type ScrollBehaviorKeyword string
+
+func (k ScrollBehaviorKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ScrollBehaviorKeyword) valueNode()           {}
+func (k ScrollBehaviorKeyword) scrollBehaviorValue() {}
+
+// ScrollBehaviorKeyword value constants.
+const (
+	ScrollBehaviorSmooth ScrollBehaviorKeyword = "smooth"
+)
+
+// ScrollBehavior creates a "scroll-behavior" property.
+// Syntax: auto | smooth
+// Example: ScrollBehavior(ScrollBehaviorSmooth) -> "scroll-behavior: smooth;"
+func ScrollBehavior(value ScrollBehaviorValue) Property {
+	return Prop("scroll-behavior", value)
+}
+
+// ScrollInitialTargetValue is implemented by the values of the "scroll-initial-target" property.
+type ScrollInitialTargetValue interface {
+	ValueNode
+	scrollInitialTargetValue()
+}
+
+// ScrollInitialTargetKeyword represents a keyword of the "scroll-initial-target" property.
+type ScrollInitialTargetKeyword string
+
+func (k ScrollInitialTargetKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ScrollInitialTargetKeyword) valueNode()                {}
+func (k ScrollInitialTargetKeyword) scrollInitialTargetValue() {}
+
+// ScrollInitialTargetKeyword value constants.
+const (
+	ScrollInitialTargetNone    ScrollInitialTargetKeyword = "none"
+	ScrollInitialTargetNearest ScrollInitialTargetKeyword = "nearest"
+)
+
+// ScrollInitialTarget creates a "scroll-initial-target" property.
+// Syntax: none | nearest
+// Example: ScrollInitialTarget(ScrollInitialTargetNone) -> "scroll-initial-target: none;"
+func ScrollInitialTarget(value ScrollInitialTargetValue) Property {
+	return Prop("scroll-initial-target", value)
+}
+
+// ScrollMarginValue is implemented by the values of the "scroll-margin" property.
+type ScrollMarginValue interface {
+	ValueNode
+	scrollMarginValue()
+}
+
+// ScrollMargin creates a "scroll-margin" property.
+// Syntax: <length>{1,4}
+// Example: ScrollMargin(PX(1)) -> "scroll-margin: 1px;"
+func ScrollMargin(values ...ScrollMarginValue) Property {
+	return Prop("scroll-margin", valueNodes(values)...)
+}
+
+// ScrollMarginBlockValue is implemented by the values of the "scroll-margin-block" property.
+type ScrollMarginBlockValue interface {
+	ValueNode
+	scrollMarginBlockValue()
+}
+
+// ScrollMarginBlock creates a "scroll-margin-block" property.
+// Syntax: <length>{1,2}
+// Example: ScrollMarginBlock(PX(1)) -> "scroll-margin-block: 1px;"
+func ScrollMarginBlock(values ...ScrollMarginBlockValue) Property {
+	return Prop("scroll-margin-block", valueNodes(values)...)
+}
+
+// ScrollMarginBlockEndValue is implemented by the values of the "scroll-margin-block-end" property.
+type ScrollMarginBlockEndValue interface {
+	ValueNode
+	scrollMarginBlockEndValue()
+}
+
+// ScrollMarginBlockEnd creates a "scroll-margin-block-end" property.
+// Syntax: <length>
+// Example: ScrollMarginBlockEnd(PX(1)) -> "scroll-margin-block-end: 1px;"
+func ScrollMarginBlockEnd(value ScrollMarginBlockEndValue) Property {
+	return Prop("scroll-margin-block-end", value)
+}
+
+// ScrollMarginBlockStartValue is implemented by the values of the "scroll-margin-block-start" property.
+type ScrollMarginBlockStartValue interface {
+	ValueNode
+	scrollMarginBlockStartValue()
+}
+
+// ScrollMarginBlockStart creates a "scroll-margin-block-start" property.
+// Syntax: <length>
+// Example: ScrollMarginBlockStart(PX(1)) -> "scroll-margin-block-start: 1px;"
+func ScrollMarginBlockStart(value ScrollMarginBlockStartValue) Property {
+	return Prop("scroll-margin-block-start", value)
+}
+
+// ScrollMarginBottomValue is implemented by the values of the "scroll-margin-bottom" property.
+type ScrollMarginBottomValue interface {
+	ValueNode
+	scrollMarginBottomValue()
+}
+
+// ScrollMarginBottom creates a "scroll-margin-bottom" property.
+// Syntax: <length>
+// Example: ScrollMarginBottom(PX(1)) -> "scroll-margin-bottom: 1px;"
+func ScrollMarginBottom(value ScrollMarginBottomValue) Property {
+	return Prop("scroll-margin-bottom", value)
+}
+
+// ScrollMarginInlineValue is implemented by the values of the "scroll-margin-inline" property.
+type ScrollMarginInlineValue interface {
+	ValueNode
+	scrollMarginInlineValue()
+}
+
+// ScrollMarginInline creates a "scroll-margin-inline" property.
+// Syntax: <length>{1,2}
+// Example: ScrollMarginInline(PX(1)) -> "scroll-margin-inline: 1px;"
+func ScrollMarginInline(values ...ScrollMarginInlineValue) Property {
+	return Prop("scroll-margin-inline", valueNodes(values)...)
+}
+
+// ScrollMarginInlineEndValue is implemented by the values of the "scroll-margin-inline-end" property.
+type ScrollMarginInlineEndValue interface {
+	ValueNode
+	scrollMarginInlineEndValue()
+}
+
+// ScrollMarginInlineEnd creates a "scroll-margin-inline-end" property.
+// Syntax: <length>
+// Example: ScrollMarginInlineEnd(PX(1)) -> "scroll-margin-inline-end: 1px;"
+func ScrollMarginInlineEnd(value ScrollMarginInlineEndValue) Property {
+	return Prop("scroll-margin-inline-end", value)
+}
+
+// ScrollMarginInlineStartValue is implemented by the values of the "scroll-margin-inline-start" property.
+type ScrollMarginInlineStartValue interface {
+	ValueNode
+	scrollMarginInlineStartValue()
+}
+
+// ScrollMarginInlineStart creates a "scroll-margin-inline-start" property.
+// Syntax: <length>
+// Example: ScrollMarginInlineStart(PX(1)) -> "scroll-margin-inline-start: 1px;"
+func ScrollMarginInlineStart(value ScrollMarginInlineStartValue) Property {
+	return Prop("scroll-margin-inline-start", value)
+}
+
+// ScrollMarginLeftValue is implemented by the values of the "scroll-margin-left" property.
+type ScrollMarginLeftValue interface {
+	ValueNode
+	scrollMarginLeftValue()
+}
+
+// ScrollMarginLeft creates a "scroll-margin-left" property.
+// Syntax: <length>
+// Example: ScrollMarginLeft(PX(1)) -> "scroll-margin-left: 1px;"
+func ScrollMarginLeft(value ScrollMarginLeftValue) Property {
+	return Prop("scroll-margin-left", value)
+}
+
+// ScrollMarginRightValue is implemented by the values of the "scroll-margin-right" property.
+type ScrollMarginRightValue interface {
+	ValueNode
+	scrollMarginRightValue()
+}
+
+// ScrollMarginRight creates a "scroll-margin-right" property.
+// Syntax: <length>
+// Example: ScrollMarginRight(PX(1)) -> "scroll-margin-right: 1px;"
+func ScrollMarginRight(value ScrollMarginRightValue) Property {
+	return Prop("scroll-margin-right", value)
+}
+
+// ScrollMarginTopValue is implemented by the values of the "scroll-margin-top" property.
+type ScrollMarginTopValue interface {
+	ValueNode
+	scrollMarginTopValue()
+}
+
+// ScrollMarginTop creates a "scroll-margin-top" property.
+// Syntax: <length>
+// Example: ScrollMarginTop(PX(1)) -> "scroll-margin-top: 1px;"
+func ScrollMarginTop(value ScrollMarginTopValue) Property {
+	return Prop("scroll-margin-top", value)
+}
+
+// ScrollMarkerGroupValue is implemented by the values of the "scroll-marker-group" property.
+type ScrollMarkerGroupValue interface {
+	ValueNode
+	scrollMarkerGroupValue()
+}
+
+// ScrollMarkerGroupKeyword represents a keyword of the "scroll-marker-group" property.
+type ScrollMarkerGroupKeyword string
+
+func (k ScrollMarkerGroupKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ScrollMarkerGroupKeyword) valueNode()              {}
+func (k ScrollMarkerGroupKeyword) scrollMarkerGroupValue() {}
+
+// ScrollMarkerGroupKeyword value constants.
+const (
+	ScrollMarkerGroupNone   ScrollMarkerGroupKeyword = "none"
+	ScrollMarkerGroupBefore ScrollMarkerGroupKeyword = "before"
+	ScrollMarkerGroupAfter  ScrollMarkerGroupKeyword = "after"
+)
+
+// ScrollMarkerGroup creates a "scroll-marker-group" property.
+// Syntax: none | before | after
+// Example: ScrollMarkerGroup(ScrollMarkerGroupNone) -> "scroll-marker-group: none;"
+func ScrollMarkerGroup(value ScrollMarkerGroupValue) Property {
+	return Prop("scroll-marker-group", value)
+}
+
+// ScrollPaddingValue is implemented by the values of the "scroll-padding" property.
+type ScrollPaddingValue interface {
+	ValueNode
+	scrollPaddingValue()
+}
+
+// ScrollPadding creates a "scroll-padding" property.
+// Syntax: [ auto | <length-percentage> ]{1,4}
+// Example: ScrollPadding(Auto) -> "scroll-padding: auto;"
+func ScrollPadding(values ...ScrollPaddingValue) Property {
+	return Prop("scroll-padding", valueNodes(values)...)
+}
+
+// ScrollPaddingBlockValue is implemented by the values of the "scroll-padding-block" property.
+type ScrollPaddingBlockValue interface {
+	ValueNode
+	scrollPaddingBlockValue()
+}
+
+// ScrollPaddingBlock creates a "scroll-padding-block" property.
+// Syntax: [ auto | <length-percentage> ]{1,2}
+// Example: ScrollPaddingBlock(Auto) -> "scroll-padding-block: auto;"
+func ScrollPaddingBlock(values ...ScrollPaddingBlockValue) Property {
+	return Prop("scroll-padding-block", valueNodes(values)...)
+}
+
+// ScrollPaddingBlockEndValue is implemented by the values of the "scroll-padding-block-end" property.
+type ScrollPaddingBlockEndValue interface {
+	ValueNode
+	scrollPaddingBlockEndValue()
+}
+
+// ScrollPaddingBlockEnd creates a "scroll-padding-block-end" property.
+// Syntax: auto | <length-percentage>
+// Example: ScrollPaddingBlockEnd(Auto) -> "scroll-padding-block-end: auto;"
+func ScrollPaddingBlockEnd(value ScrollPaddingBlockEndValue) Property {
+	return Prop("scroll-padding-block-end", value)
+}
+
+// ScrollPaddingBlockStartValue is implemented by the values of the "scroll-padding-block-start" property.
+type ScrollPaddingBlockStartValue interface {
+	ValueNode
+	scrollPaddingBlockStartValue()
+}
+
+// ScrollPaddingBlockStart creates a "scroll-padding-block-start" property.
+// Syntax: auto | <length-percentage>
+// Example: ScrollPaddingBlockStart(Auto) -> "scroll-padding-block-start: auto;"
+func ScrollPaddingBlockStart(value ScrollPaddingBlockStartValue) Property {
+	return Prop("scroll-padding-block-start", value)
+}
+
+// ScrollPaddingBottomValue is implemented by the values of the "scroll-padding-bottom" property.
+type ScrollPaddingBottomValue interface {
+	ValueNode
+	scrollPaddingBottomValue()
+}
+
+// ScrollPaddingBottom creates a "scroll-padding-bottom" property.
+// Syntax: auto | <length-percentage>
+// Example: ScrollPaddingBottom(Auto) -> "scroll-padding-bottom: auto;"
+func ScrollPaddingBottom(value ScrollPaddingBottomValue) Property {
+	return Prop("scroll-padding-bottom", value)
+}
+
+// ScrollPaddingInlineValue is implemented by the values of the "scroll-padding-inline" property.
+type ScrollPaddingInlineValue interface {
+	ValueNode
+	scrollPaddingInlineValue()
+}
+
+// ScrollPaddingInline creates a "scroll-padding-inline" property.
+// Syntax: [ auto | <length-percentage> ]{1,2}
+// Example: ScrollPaddingInline(Auto) -> "scroll-padding-inline: auto;"
+func ScrollPaddingInline(values ...ScrollPaddingInlineValue) Property {
+	return Prop("scroll-padding-inline", valueNodes(values)...)
+}
+
+// ScrollPaddingInlineEndValue is implemented by the values of the "scroll-padding-inline-end" property.
+type ScrollPaddingInlineEndValue interface {
+	ValueNode
+	scrollPaddingInlineEndValue()
+}
+
+// ScrollPaddingInlineEnd creates a "scroll-padding-inline-end" property.
+// Syntax: auto | <length-percentage>
+// Example: ScrollPaddingInlineEnd(Auto) -> "scroll-padding-inline-end: auto;"
+func ScrollPaddingInlineEnd(value ScrollPaddingInlineEndValue) Property {
+	return Prop("scroll-padding-inline-end", value)
+}
+
+// ScrollPaddingInlineStartValue is implemented by the values of the "scroll-padding-inline-start" property.
+type ScrollPaddingInlineStartValue interface {
+	ValueNode
+	scrollPaddingInlineStartValue()
+}
+
+// ScrollPaddingInlineStart creates a "scroll-padding-inline-start" property.
+// Syntax: auto | <length-percentage>
+// Example: ScrollPaddingInlineStart(Auto) -> "scroll-padding-inline-start: auto;"
+func ScrollPaddingInlineStart(value ScrollPaddingInlineStartValue) Property {
+	return Prop("scroll-padding-inline-start", value)
+}
+
+// ScrollPaddingLeftValue is implemented by the values of the "scroll-padding-left" property.
+type ScrollPaddingLeftValue interface {
+	ValueNode
+	scrollPaddingLeftValue()
+}
+
+// ScrollPaddingLeft creates a "scroll-padding-left" property.
+// Syntax: auto | <length-percentage>
+// Example: ScrollPaddingLeft(Auto) -> "scroll-padding-left: auto;"
+func ScrollPaddingLeft(value ScrollPaddingLeftValue) Property {
+	return Prop("scroll-padding-left", value)
+}
+
+// ScrollPaddingRightValue is implemented by the values of the "scroll-padding-right" property.
+type ScrollPaddingRightValue interface {
+	ValueNode
+	scrollPaddingRightValue()
+}
+
+// ScrollPaddingRight creates a "scroll-padding-right" property.
+// Syntax: auto | <length-percentage>
+// Example: ScrollPaddingRight(Auto) -> "scroll-padding-right: auto;"
+func ScrollPaddingRight(value ScrollPaddingRightValue) Property {
+	return Prop("scroll-padding-right", value)
+}
+
+// ScrollPaddingTopValue is implemented by the values of the "scroll-padding-top" property.
+type ScrollPaddingTopValue interface {
+	ValueNode
+	scrollPaddingTopValue()
+}
+
+// ScrollPaddingTop creates a "scroll-padding-top" property.
+// Syntax: auto | <length-percentage>
+// Example: ScrollPaddingTop(Auto) -> "scroll-padding-top: auto;"
+func ScrollPaddingTop(value ScrollPaddingTopValue) Property {
+	return Prop("scroll-padding-top", value)
+}
+
+// ScrollSnapAlignValue is implemented by the values of the "scroll-snap-align" property.
+type ScrollSnapAlignValue interface {
+	ValueNode
+	scrollSnapAlignValue()
+}
+
+// ScrollSnapAlignKeyword represents a keyword of the "scroll-snap-align" property.
+type ScrollSnapAlignKeyword string
+
+func (k ScrollSnapAlignKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ScrollSnapAlignKeyword) valueNode()            {}
+func (k ScrollSnapAlignKeyword) scrollSnapAlignValue() {}
+
+// ScrollSnapAlignKeyword value constants.
+const (
+	ScrollSnapAlignNone   ScrollSnapAlignKeyword = "none"
+	ScrollSnapAlignStart  ScrollSnapAlignKeyword = "start"
+	ScrollSnapAlignEnd    ScrollSnapAlignKeyword = "end"
+	ScrollSnapAlignCenter ScrollSnapAlignKeyword = "center"
+)
+
+// ScrollSnapAlign creates a "scroll-snap-align" property.
+// Syntax: [ none | start | end | center ]{1,2}
+// Example: ScrollSnapAlign(ScrollSnapAlignNone) -> "scroll-snap-align: none;"
+func ScrollSnapAlign(values ...ScrollSnapAlignValue) Property {
+	return Prop("scroll-snap-align", valueNodes(values)...)
+}
+
+// ScrollSnapStopValue is implemented by the values of the "scroll-snap-stop" property.
+type ScrollSnapStopValue interface {
+	ValueNode
+	scrollSnapStopValue()
+}
+
+// ScrollSnapStopKeyword represents a keyword of the "scroll-snap-stop" property.
+type ScrollSnapStopKeyword string
+
+func (k ScrollSnapStopKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ScrollSnapStopKeyword) valueNode()           {}
+func (k ScrollSnapStopKeyword) scrollSnapStopValue() {}
+
+// ScrollSnapStopKeyword value constants.
+const (
+	ScrollSnapStopNormal ScrollSnapStopKeyword = "normal"
+	ScrollSnapStopAlways ScrollSnapStopKeyword = "always"
+)
+
+// ScrollSnapStop creates a "scroll-snap-stop" property.
+// Syntax: normal | always
+// Example: ScrollSnapStop(ScrollSnapStopNormal) -> "scroll-snap-stop: normal;"
+func ScrollSnapStop(value ScrollSnapStopValue) Property {
+	return Prop("scroll-snap-stop", value)
+}
+
+// ScrollSnapTypeValue is implemented by the values of the "scroll-snap-type" property.
+type ScrollSnapTypeValue interface {
+	ValueNode
+	scrollSnapTypeValue()
+}
+
+// ScrollSnapTypeKeyword represents a keyword of the "scroll-snap-type" property.
+type ScrollSnapTypeKeyword string
+
+func (k ScrollSnapTypeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ScrollSnapTypeKeyword) valueNode()           {}
+func (k ScrollSnapTypeKeyword) scrollSnapTypeValue() {}
+
+// ScrollSnapTypeKeyword value constants.
+const (
+	ScrollSnapTypeNone   ScrollSnapTypeKeyword = "none"
+	ScrollSnapTypeX      ScrollSnapTypeKeyword = "x"
+	ScrollSnapTypeY      ScrollSnapTypeKeyword = "y"
+	ScrollSnapTypeBlock  ScrollSnapTypeKeyword = "block"
+	ScrollSnapTypeInline ScrollSnapTypeKeyword = "inline"
+	ScrollSnapTypeBoth   ScrollSnapTypeKeyword = "both"
+)
+
+// ScrollSnapType creates a "scroll-snap-type" property.
+// Syntax: none | [ x | y | block | inline | both ] [ mandatory | proximity ]?
+// Example: ScrollSnapType(ScrollSnapTypeNone) -> "scroll-snap-type: none;"
+func ScrollSnapType(values ...ScrollSnapTypeValue) Property {
+	return Prop("scroll-snap-type", valueNodes(values)...)
+}
+
+// ScrollTimelineValue is implemented by the values of the "scroll-timeline" property.
+type ScrollTimelineValue interface {
+	ValueNode
+	scrollTimelineValue()
+}
+
+// ScrollTimeline creates a "scroll-timeline" property.
+// Syntax: [ <'scroll-timeline-name'> <'scroll-timeline-axis'>? ]#
+// Example: ScrollTimeline(Ident("--a"), ScrollTimelineAxisInline) -> "scroll-timeline: --a inline;"
+// It sets a single layer; use ScrollTimelineLayers for several.
+func ScrollTimeline(values ...ScrollTimelineValue) Property {
+	return Prop("scroll-timeline", valueNodes(values)...)
+}
+
+// ScrollTimelineLayers creates a "scroll-timeline" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: ScrollTimelineLayers([]ScrollTimelineValue{Ident("--a"), ScrollTimelineAxisInline}, []ScrollTimelineValue{Ident("--a"), ScrollTimelineAxisInline}) -> "scroll-timeline: --a inline, --a inline;"
+func ScrollTimelineLayers(layers ...[]ScrollTimelineValue) Property {
+	return commaProp("scroll-timeline", layerNodes(layers)...)
+}
+
+// ScrollTimelineAxisValue is implemented by the values of the "scroll-timeline-axis" property.
+type ScrollTimelineAxisValue interface {
+	ValueNode
+	scrollTimelineAxisValue()
+}
+
+// ScrollTimelineAxisKeyword represents a keyword of the "scroll-timeline-axis" property.
+type ScrollTimelineAxisKeyword string
+
+func (k ScrollTimelineAxisKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ScrollTimelineAxisKeyword) valueNode()               {}
+func (k ScrollTimelineAxisKeyword) scrollTimelineAxisValue() {}
+
+// ScrollTimelineAxisKeyword also implements the value interfaces of the shorthands of "scroll-timeline-axis".
+func (k ScrollTimelineAxisKeyword) scrollTimelineValue() {}
+
+// ScrollTimelineAxisKeyword value constants.
+const (
+	ScrollTimelineAxisBlock  ScrollTimelineAxisKeyword = "block"
+	ScrollTimelineAxisInline ScrollTimelineAxisKeyword = "inline"
+	ScrollTimelineAxisX      ScrollTimelineAxisKeyword = "x"
+	ScrollTimelineAxisY      ScrollTimelineAxisKeyword = "y"
+)
+
+// ScrollTimelineAxis creates a "scroll-timeline-axis" property.
+// Syntax: <axis>#
+// Example: ScrollTimelineAxis(ScrollTimelineAxisBlock) -> "scroll-timeline-axis: block;"
+func ScrollTimelineAxis(values ...ScrollTimelineAxisValue) Property {
+	return commaProp("scroll-timeline-axis", valueNodes(values)...)
+}
+
+// ScrollTimelineNameValue is implemented by the values of the "scroll-timeline-name" property.
+type ScrollTimelineNameValue interface {
+	ValueNode
+	scrollTimelineNameValue()
+}
+
+// ScrollTimelineNameKeyword represents a keyword of the "scroll-timeline-name" property.
+type ScrollTimelineNameKeyword string
+
+func (k ScrollTimelineNameKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ScrollTimelineNameKeyword) valueNode()               {}
+func (k ScrollTimelineNameKeyword) scrollTimelineNameValue() {}
+
+// ScrollTimelineNameKeyword also implements the value interfaces of the shorthands of "scroll-timeline-name".
+func (k ScrollTimelineNameKeyword) scrollTimelineValue() {}
+
+// ScrollTimelineNameKeyword value constants.
+const (
+	ScrollTimelineNameNone ScrollTimelineNameKeyword = "none"
+)
+
+// ScrollTimelineName creates a "scroll-timeline-name" property.
+// Syntax: [ none | <dashed-ident> ]#
+// Example: ScrollTimelineName(ScrollTimelineNameNone) -> "scroll-timeline-name: none;"
+func ScrollTimelineName(values ...ScrollTimelineNameValue) Property {
+	return commaProp("scroll-timeline-name", valueNodes(values)...)
+}
+
+// ScrollbarColorValue is implemented by the values of the "scrollbar-color" property.
+type ScrollbarColorValue interface {
+	ValueNode
+	scrollbarColorValue()
+}
+
+// ScrollbarColor creates a "scrollbar-color" property.
+// Syntax: auto | <color>{2}
+// Example: ScrollbarColor(Auto) -> "scrollbar-color: auto;"
+func ScrollbarColor(values ...ScrollbarColorValue) Property {
+	return Prop("scrollbar-color", valueNodes(values)...)
+}
+
+// ScrollbarGutterValue is implemented by the values of the "scrollbar-gutter" property.
+type ScrollbarGutterValue interface {
+	ValueNode
+	scrollbarGutterValue()
+}
+
+// ScrollbarGutterKeyword represents a keyword of the "scrollbar-gutter" property.
+type ScrollbarGutterKeyword string
+
+func (k ScrollbarGutterKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ScrollbarGutterKeyword) valueNode()            {}
+func (k ScrollbarGutterKeyword) scrollbarGutterValue() {}
+
+// ScrollbarGutterKeyword value constants.
+const (
+	ScrollbarGutterStable ScrollbarGutterKeyword = "stable"
+)
+
+// ScrollbarGutter creates a "scrollbar-gutter" property.
+// Syntax: auto | stable && both-edges?
+// Example: ScrollbarGutter(ScrollbarGutterStable) -> "scrollbar-gutter: stable;"
+func ScrollbarGutter(values ...ScrollbarGutterValue) Property {
+	return Prop("scrollbar-gutter", valueNodes(values)...)
+}
+
+// ScrollbarWidthValue is implemented by the values of the "scrollbar-width" property.
+type ScrollbarWidthValue interface {
+	ValueNode
+	scrollbarWidthValue()
+}
+
+// ScrollbarWidthKeyword represents a keyword of the "scrollbar-width" property.
+type ScrollbarWidthKeyword string
+
+func (k ScrollbarWidthKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ScrollbarWidthKeyword) valueNode()           {}
+func (k ScrollbarWidthKeyword) scrollbarWidthValue() {}
+
+// ScrollbarWidthKeyword value constants.
+const (
+	ScrollbarWidthThin ScrollbarWidthKeyword = "thin"
+	ScrollbarWidthNone ScrollbarWidthKeyword = "none"
+)
+
+// ScrollbarWidth creates a "scrollbar-width" property.
+// Syntax: auto | thin | none
+// Example: ScrollbarWidth(ScrollbarWidthThin) -> "scrollbar-width: thin;"
+func ScrollbarWidth(value ScrollbarWidthValue) Property {
+	return Prop("scrollbar-width", value)
+}
+
+// ShapeImageThresholdValue is implemented by the values of the "shape-image-threshold" property.
+type ShapeImageThresholdValue interface {
+	ValueNode
+	shapeImageThresholdValue()
+}
+
+// ShapeImageThreshold creates a "shape-image-threshold" property.
+// Syntax: <alpha-value>
+// Example: ShapeImageThreshold(Num(0.5)) -> "shape-image-threshold: 0.5;"
+func ShapeImageThreshold(value ShapeImageThresholdValue) Property {
+	return Prop("shape-image-threshold", value)
+}
+
+// ShapeMarginValue is implemented by the values of the "shape-margin" property.
+type ShapeMarginValue interface {
+	ValueNode
+	shapeMarginValue()
+}
+
+// ShapeMargin creates a "shape-margin" property.
+// Syntax: <length-percentage>
+// Example: ShapeMargin(PX(1)) -> "shape-margin: 1px;"
+func ShapeMargin(value ShapeMarginValue) Property {
+	return Prop("shape-margin", value)
+}
+
+// ShapeOutsideValue is implemented by the values of the "shape-outside" property.
+type ShapeOutsideValue interface {
+	ValueNode
+	shapeOutsideValue()
+}
+
+// ShapeOutsideKeyword represents a keyword of the "shape-outside" property.
+type ShapeOutsideKeyword string
+
+func (k ShapeOutsideKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ShapeOutsideKeyword) valueNode()         {}
+func (k ShapeOutsideKeyword) shapeOutsideValue() {}
+
+// ShapeOutsideKeyword value constants.
+const (
+	ShapeOutsideNone       ShapeOutsideKeyword = "none"
+	ShapeOutsideContentBox ShapeOutsideKeyword = "content-box"
+	ShapeOutsidePaddingBox ShapeOutsideKeyword = "padding-box"
+	ShapeOutsideBorderBox  ShapeOutsideKeyword = "border-box"
+	ShapeOutsideMarginBox  ShapeOutsideKeyword = "margin-box"
+)
+
+// ShapeOutside creates a "shape-outside" property.
+// Syntax: none | [ <shape-box> || <basic-shape> ] | <image>
+// Example: ShapeOutside(ShapeOutsideNone) -> "shape-outside: none;"
+func ShapeOutside(values ...ShapeOutsideValue) Property {
+	return Prop("shape-outside", valueNodes(values)...)
+}
+
+// ShapeRenderingValue is implemented by the values of the "shape-rendering" property.
+type ShapeRenderingValue interface {
+	ValueNode
+	shapeRenderingValue()
+}
+
+// ShapeRenderingKeyword represents a keyword of the "shape-rendering" property.
+type ShapeRenderingKeyword string
+
+func (k ShapeRenderingKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ShapeRenderingKeyword) valueNode()           {}
+func (k ShapeRenderingKeyword) shapeRenderingValue() {}
+
+// ShapeRenderingKeyword value constants.
+const (
+	ShapeRenderingOptimizeSpeed      ShapeRenderingKeyword = "optimizeSpeed"
+	ShapeRenderingCrispEdges         ShapeRenderingKeyword = "crispEdges"
+	ShapeRenderingGeometricPrecision ShapeRenderingKeyword = "geometricPrecision"
+)
+
+// ShapeRendering creates a "shape-rendering" property.
+// Syntax: auto | optimizeSpeed | crispEdges | geometricPrecision
+// Example: ShapeRendering(ShapeRenderingOptimizeSpeed) -> "shape-rendering: optimizeSpeed;"
+func ShapeRendering(value ShapeRenderingValue) Property {
+	return Prop("shape-rendering", value)
+}
+
+// SpeakAsValue is implemented by the values of the "speak-as" property.
+type SpeakAsValue interface {
+	ValueNode
+	speakAsValue()
+}
+
+// SpeakAsKeyword represents a keyword of the "speak-as" property.
+type SpeakAsKeyword string
+
+func (k SpeakAsKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k SpeakAsKeyword) valueNode()    {}
+func (k SpeakAsKeyword) speakAsValue() {}
+
+// SpeakAsKeyword value constants.
+const (
+	SpeakAsNormal             SpeakAsKeyword = "normal"
+	SpeakAsSpellOut           SpeakAsKeyword = "spell-out"
+	SpeakAsDigits             SpeakAsKeyword = "digits"
+	SpeakAsLiteralPunctuation SpeakAsKeyword = "literal-punctuation"
+	SpeakAsNoPunctuation      SpeakAsKeyword = "no-punctuation"
+)
+
+// SpeakAs creates a "speak-as" property.
+// Syntax: normal | spell-out || digits || [ literal-punctuation | no-punctuation ]
+// Example: SpeakAs(SpeakAsNormal) -> "speak-as: normal;"
+func SpeakAs(values ...SpeakAsValue) Property {
+	return Prop("speak-as", valueNodes(values)...)
+}
+
+// StopColorValue is implemented by the values of the "stop-color" property.
+type StopColorValue interface {
+	ValueNode
+	stopColorValue()
+}
+
+// StopColor creates a "stop-color" property.
+// Syntax: <color>
+// Example: StopColor(Red) -> "stop-color: red;"
+func StopColor(value StopColorValue) Property {
+	return Prop("stop-color", value)
+}
+
+// StopOpacityValue is implemented by the values of the "stop-opacity" property.
+type StopOpacityValue interface {
+	ValueNode
+	stopOpacityValue()
+}
+
+// StopOpacity creates a "stop-opacity" property.
+// Syntax: <alpha-value>
+// Example: StopOpacity(Num(0.5)) -> "stop-opacity: 0.5;"
+func StopOpacity(value StopOpacityValue) Property {
+	return Prop("stop-opacity", value)
+}
+
+// StrokeValue is implemented by the values of the "stroke" property.
+type StrokeValue interface {
+	ValueNode
+	strokeValue()
+}
+
+// StrokeKeyword represents a keyword of the "stroke" property.
+type StrokeKeyword string
+
+func (k StrokeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k StrokeKeyword) valueNode()   {}
+func (k StrokeKeyword) strokeValue() {}
+
+// StrokeKeyword value constants.
+const (
+	StrokeNone          StrokeKeyword = "none"
+	StrokeContextFill   StrokeKeyword = "context-fill"
+	StrokeContextStroke StrokeKeyword = "context-stroke"
+)
+
+// Stroke creates a "stroke" property.
+// Syntax: <paint>
+// Example: Stroke(StrokeNone) -> "stroke: none;"
+func Stroke(values ...StrokeValue) Property {
+	return Prop("stroke", valueNodes(values)...)
+}
+
+// StrokeDasharrayValue is implemented by the values of the "stroke-dasharray" property.
+type StrokeDasharrayValue interface {
+	ValueNode
+	strokeDasharrayValue()
+}
+
+// StrokeDasharrayKeyword represents a keyword of the "stroke-dasharray" property.
+type StrokeDasharrayKeyword string
+
+func (k StrokeDasharrayKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k StrokeDasharrayKeyword) valueNode()            {}
+func (k StrokeDasharrayKeyword) strokeDasharrayValue() {}
+
+// StrokeDasharrayKeyword value constants.
+const (
+	StrokeDasharrayNone StrokeDasharrayKeyword = "none"
+)
+
+// StrokeDasharray creates a "stroke-dasharray" property.
+// Syntax: none | <dasharray>
+// Example: StrokeDasharray(StrokeDasharrayNone) -> "stroke-dasharray: none;"
+func StrokeDasharray(values ...StrokeDasharrayValue) Property {
+	return Prop("stroke-dasharray", valueNodes(values)...)
+}
+
+// StrokeDashoffsetValue is implemented by the values of the "stroke-dashoffset" property.
+type StrokeDashoffsetValue interface {
+	ValueNode
+	strokeDashoffsetValue()
+}
+
+// StrokeDashoffset creates a "stroke-dashoffset" property.
+// Syntax: <length-percentage> | <number>
+// Example: StrokeDashoffset(PX(1)) -> "stroke-dashoffset: 1px;"
+func StrokeDashoffset(value StrokeDashoffsetValue) Property {
+	return Prop("stroke-dashoffset", value)
+}
+
+// StrokeLinecapValue is implemented by the values of the "stroke-linecap" property.
+type StrokeLinecapValue interface {
+	ValueNode
+	strokeLinecapValue()
+}
+
+// StrokeLinecapKeyword represents a keyword of the "stroke-linecap" property.
+type StrokeLinecapKeyword string
+
+func (k StrokeLinecapKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k StrokeLinecapKeyword) valueNode()          {}
+func (k StrokeLinecapKeyword) strokeLinecapValue() {}
+
+// StrokeLinecapKeyword value constants.
+const (
+	StrokeLinecapButt   StrokeLinecapKeyword = "butt"
+	StrokeLinecapRound  StrokeLinecapKeyword = "round"
+	StrokeLinecapSquare StrokeLinecapKeyword = "square"
+)
+
+// StrokeLinecap creates a "stroke-linecap" property.
+// Syntax: butt | round | square
+// Example: StrokeLinecap(StrokeLinecapButt) -> "stroke-linecap: butt;"
+func StrokeLinecap(value StrokeLinecapValue) Property {
+	return Prop("stroke-linecap", value)
+}
+
+// StrokeLinejoinValue is implemented by the values of the "stroke-linejoin" property.
+type StrokeLinejoinValue interface {
+	ValueNode
+	strokeLinejoinValue()
+}
+
+// StrokeLinejoinKeyword represents a keyword of the "stroke-linejoin" property.
+type StrokeLinejoinKeyword string
+
+func (k StrokeLinejoinKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k StrokeLinejoinKeyword) valueNode()           {}
+func (k StrokeLinejoinKeyword) strokeLinejoinValue() {}
+
+// StrokeLinejoinKeyword value constants.
+const (
+	StrokeLinejoinMiter     StrokeLinejoinKeyword = "miter"
+	StrokeLinejoinMiterClip StrokeLinejoinKeyword = "miter-clip"
+	StrokeLinejoinRound     StrokeLinejoinKeyword = "round"
+	StrokeLinejoinBevel     StrokeLinejoinKeyword = "bevel"
+	StrokeLinejoinArcs      StrokeLinejoinKeyword = "arcs"
+)
+
+// StrokeLinejoin creates a "stroke-linejoin" property.
+// Syntax: miter | miter-clip | round | bevel | arcs
+// Example: StrokeLinejoin(StrokeLinejoinMiter) -> "stroke-linejoin: miter;"
+func StrokeLinejoin(value StrokeLinejoinValue) Property {
+	return Prop("stroke-linejoin", value)
+}
+
+// StrokeMiterlimitValue is implemented by the values of the "stroke-miterlimit" property.
+type StrokeMiterlimitValue interface {
+	ValueNode
+	strokeMiterlimitValue()
+}
+
+// StrokeMiterlimit creates a "stroke-miterlimit" property.
+// Syntax: <number>
+// Example: StrokeMiterlimit(Num(0.5)) -> "stroke-miterlimit: 0.5;"
+func StrokeMiterlimit(value StrokeMiterlimitValue) Property {
+	return Prop("stroke-miterlimit", value)
+}
+
+// StrokeOpacityValue is implemented by the values of the "stroke-opacity" property.
+type StrokeOpacityValue interface {
+	ValueNode
+	strokeOpacityValue()
+}
+
+// StrokeOpacity creates a "stroke-opacity" property.
+// Syntax: <alpha-value>
+// Example: StrokeOpacity(Num(0.5)) -> "stroke-opacity: 0.5;"
+func StrokeOpacity(value StrokeOpacityValue) Property {
+	return Prop("stroke-opacity", value)
+}
+
+// StrokeWidthValue is implemented by the values of the "stroke-width" property.
+type StrokeWidthValue interface {
+	ValueNode
+	strokeWidthValue()
+}
+
+// StrokeWidth creates a "stroke-width" property.
+// Syntax: <length-percentage> | <number>
+// Example: StrokeWidth(PX(1)) -> "stroke-width: 1px;"
+func StrokeWidth(value StrokeWidthValue) Property {
+	return Prop("stroke-width", value)
+}
+
+// TabSizeValue is implemented by the values of the "tab-size" property.
+type TabSizeValue interface {
+	ValueNode
+	tabSizeValue()
+}
+
+// TabSize creates a "tab-size" property.
+// Syntax: <integer> | <length>
+// Example: TabSize(Int(1)) -> "tab-size: 1;"
+func TabSize(value TabSizeValue) Property {
+	return Prop("tab-size", value)
+}
+
+// TableLayoutValue is implemented by the values of the "table-layout" property.
+type TableLayoutValue interface {
+	ValueNode
+	tableLayoutValue()
+}
+
+// TableLayoutKeyword represents a keyword of the "table-layout" property.
+type TableLayoutKeyword string
+
+func (k TableLayoutKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TableLayoutKeyword) valueNode()        {}
+func (k TableLayoutKeyword) tableLayoutValue() {}
+
+// TableLayoutKeyword value constants.
+const (
+	TableLayoutFixed TableLayoutKeyword = "fixed"
+)
+
+// TableLayout creates a "table-layout" property.
+// Syntax: auto | fixed
+// Example: TableLayout(TableLayoutFixed) -> "table-layout: fixed;"
+func TableLayout(value TableLayoutValue) Property {
+	return Prop("table-layout", value)
+}
+
+// TextAlignValue is implemented by the values of the "text-align" property.
+type TextAlignValue interface {
+	ValueNode
+	textAlignValue()
+}
+
+// TextAlignKeyword represents a keyword of the "text-align" property.
+type TextAlignKeyword string
+
+func (k TextAlignKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextAlignKeyword) valueNode()      {}
+func (k TextAlignKeyword) textAlignValue() {}
+
+// TextAlignKeyword value constants.
+const (
+	TextAlignStart       TextAlignKeyword = "start"
+	TextAlignEnd         TextAlignKeyword = "end"
+	TextAlignLeft        TextAlignKeyword = "left"
+	TextAlignRight       TextAlignKeyword = "right"
+	TextAlignCenter      TextAlignKeyword = "center"
+	TextAlignJustify     TextAlignKeyword = "justify"
+	TextAlignMatchParent TextAlignKeyword = "match-parent"
+)
+
+// TextAlign creates a "text-align" property.
+// Syntax: start | end | left | right | center | justify | match-parent
+// Example: TextAlign(TextAlignStart) -> "text-align: start;"
+func TextAlign(value TextAlignValue) Property {
+	return Prop("text-align", value)
+}
+
+// TextAlignLastValue is implemented by the values of the "text-align-last" property.
+type TextAlignLastValue interface {
+	ValueNode
+	textAlignLastValue()
+}
+
+// TextAlignLastKeyword represents a keyword of the "text-align-last" property.
+type TextAlignLastKeyword string
+
+func (k TextAlignLastKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextAlignLastKeyword) valueNode()          {}
+func (k TextAlignLastKeyword) textAlignLastValue() {}
+
+// TextAlignLastKeyword value constants.
+const (
+	TextAlignLastStart   TextAlignLastKeyword = "start"
+	TextAlignLastEnd     TextAlignLastKeyword = "end"
+	TextAlignLastLeft    TextAlignLastKeyword = "left"
+	TextAlignLastRight   TextAlignLastKeyword = "right"
+	TextAlignLastCenter  TextAlignLastKeyword = "center"
+	TextAlignLastJustify TextAlignLastKeyword = "justify"
+)
+
+// TextAlignLast creates a "text-align-last" property.
+// Syntax: auto | start | end | left | right | center | justify
+// Example: TextAlignLast(TextAlignLastStart) -> "text-align-last: start;"
+func TextAlignLast(value TextAlignLastValue) Property {
+	return Prop("text-align-last", value)
+}
+
+// TextAnchorValue is implemented by the values of the "text-anchor" property.
+type TextAnchorValue interface {
+	ValueNode
+	textAnchorValue()
+}
+
+// TextAnchorKeyword represents a keyword of the "text-anchor" property.
+type TextAnchorKeyword string
+
+func (k TextAnchorKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextAnchorKeyword) valueNode()       {}
+func (k TextAnchorKeyword) textAnchorValue() {}
+
+// TextAnchorKeyword value constants.
+const (
+	TextAnchorStart  TextAnchorKeyword = "start"
+	TextAnchorMiddle TextAnchorKeyword = "middle"
+	TextAnchorEnd    TextAnchorKeyword = "end"
+)
+
+// TextAnchor creates a "text-anchor" property.
+// Syntax: start | middle | end
+// Example: TextAnchor(TextAnchorStart) -> "text-anchor: start;"
+func TextAnchor(value TextAnchorValue) Property {
+	return Prop("text-anchor", value)
+}
+
+// TextAutospaceValue is implemented by the values of the "text-autospace" property.
+type TextAutospaceValue interface {
+	ValueNode
+	textAutospaceValue()
+}
+
+// TextAutospaceKeyword represents a keyword of the "text-autospace" property.
+type TextAutospaceKeyword string
+
+func (k TextAutospaceKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextAutospaceKeyword) valueNode()          {}
+func (k TextAutospaceKeyword) textAutospaceValue() {}
+
+// TextAutospaceKeyword value constants.
+const (
+	TextAutospaceNormal           TextAutospaceKeyword = "normal"
+	TextAutospaceNoAutospace      TextAutospaceKeyword = "no-autospace"
+	TextAutospaceIdeographAlpha   TextAutospaceKeyword = "ideograph-alpha"
+	TextAutospaceIdeographNumeric TextAutospaceKeyword = "ideograph-numeric"
+	TextAutospacePunctuation      TextAutospaceKeyword = "punctuation"
+	TextAutospaceInsert           TextAutospaceKeyword = "insert"
+	TextAutospaceReplace          TextAutospaceKeyword = "replace"
+)
+
+// TextAutospace creates a "text-autospace" property.
+// Syntax: normal | <autospace> | auto
+// Example: TextAutospace(TextAutospaceNormal) -> "text-autospace: normal;"
+func TextAutospace(values ...TextAutospaceValue) Property {
+	return Prop("text-autospace", valueNodes(values)...)
+}
+
+// TextBoxValue is implemented by the values of the "text-box" property.
+type TextBoxValue interface {
+	ValueNode
+	textBoxValue()
+}
+
+// TextBoxKeyword represents a keyword of the "text-box" property.
+type TextBoxKeyword string
+
+func (k TextBoxKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextBoxKeyword) valueNode()    {}
+func (k TextBoxKeyword) textBoxValue() {}
+
+// TextBoxKeyword value constants.
+const (
+	TextBoxNormal TextBoxKeyword = "normal"
+)
+
+// TextBox creates a "text-box" property.
+// Syntax: normal | <'text-box-trim'> || <'text-box-edge'>
+// Example: TextBox(TextBoxNormal) -> "text-box: normal;"
+func TextBox(values ...TextBoxValue) Property {
+	return Prop("text-box", valueNodes(values)...)
+}
+
+// TextBoxEdgeValue is implemented by the values of the "text-box-edge" property.
+type TextBoxEdgeValue interface {
+	ValueNode
+	textBoxEdgeValue()
+}
+
+// TextBoxEdgeKeyword represents a keyword of the "text-box-edge" property.
+type TextBoxEdgeKeyword string
+
+func (k TextBoxEdgeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextBoxEdgeKeyword) valueNode()        {}
+func (k TextBoxEdgeKeyword) textBoxEdgeValue() {}
+
+// TextBoxEdgeKeyword also implements the value interfaces of the shorthands of "text-box-edge".
+func (k TextBoxEdgeKeyword) textBoxValue() {}
+
+// TextBoxEdgeKeyword value constants.
+const (
+	TextBoxEdgeText           TextBoxEdgeKeyword = "text"
+	TextBoxEdgeCap            TextBoxEdgeKeyword = "cap"
+	TextBoxEdgeEx             TextBoxEdgeKeyword = "ex"
+	TextBoxEdgeIdeographic    TextBoxEdgeKeyword = "ideographic"
+	TextBoxEdgeIdeographicInk TextBoxEdgeKeyword = "ideographic-ink"
+)
+
+// TextBoxEdge creates a "text-box-edge" property.
+// Syntax: auto | <text-edge>
+// Example: TextBoxEdge(TextBoxEdgeText) -> "text-box-edge: text;"
+func TextBoxEdge(values ...TextBoxEdgeValue) Property {
+	return Prop("text-box-edge", valueNodes(values)...)
+}
+
+// TextBoxTrimValue is implemented by the values of the "text-box-trim" property.
+type TextBoxTrimValue interface {
+	ValueNode
+	textBoxTrimValue()
+}
+
+// TextBoxTrimKeyword represents a keyword of the "text-box-trim" property.
+type TextBoxTrimKeyword string
+
+func (k TextBoxTrimKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextBoxTrimKeyword) valueNode()        {}
+func (k TextBoxTrimKeyword) textBoxTrimValue() {}
+
+// TextBoxTrimKeyword also implements the value interfaces of the shorthands of "text-box-trim".
+func (k TextBoxTrimKeyword) textBoxValue() {}
+
+// TextBoxTrimKeyword value constants.
+const (
+	TextBoxTrimNone      TextBoxTrimKeyword = "none"
+	TextBoxTrimTrimStart TextBoxTrimKeyword = "trim-start"
+	TextBoxTrimTrimEnd   TextBoxTrimKeyword = "trim-end"
+	TextBoxTrimTrimBoth  TextBoxTrimKeyword = "trim-both"
+)
+
+// TextBoxTrim creates a "text-box-trim" property.
+// Syntax: none | trim-start | trim-end | trim-both
+// Example: TextBoxTrim(TextBoxTrimNone) -> "text-box-trim: none;"
+func TextBoxTrim(value TextBoxTrimValue) Property {
+	return Prop("text-box-trim", value)
+}
+
+// TextCombineUprightValue is implemented by the values of the "text-combine-upright" property.
+type TextCombineUprightValue interface {
+	ValueNode
+	textCombineUprightValue()
+}
+
+// TextCombineUprightKeyword represents a keyword of the "text-combine-upright" property.
+type TextCombineUprightKeyword string
+
+func (k TextCombineUprightKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextCombineUprightKeyword) valueNode()               {}
+func (k TextCombineUprightKeyword) textCombineUprightValue() {}
+
+// TextCombineUprightKeyword value constants.
+const (
+	TextCombineUprightNone   TextCombineUprightKeyword = "none"
+	TextCombineUprightAll    TextCombineUprightKeyword = "all"
+	TextCombineUprightDigits TextCombineUprightKeyword = "digits"
+)
+
+// TextCombineUpright creates a "text-combine-upright" property.
+// Syntax: none | all | [ digits <integer>? ]
+// Example: TextCombineUpright(TextCombineUprightNone) -> "text-combine-upright: none;"
+func TextCombineUpright(values ...TextCombineUprightValue) Property {
+	return Prop("text-combine-upright", valueNodes(values)...)
+}
+
+// TextDecorationValue is implemented by the values of the "text-decoration" property.
+type TextDecorationValue interface {
+	ValueNode
+	textDecorationValue()
+}
+
+// TextDecoration creates a "text-decoration" property.
+// Syntax: <'text-decoration-line'> || <'text-decoration-style'> || <'text-decoration-color'> || <'text-decoration-thickness'>
+// Example: TextDecoration(Red) -> "text-decoration: red;"
+func TextDecoration(values ...TextDecorationValue) Property {
+	return Prop("text-decoration", valueNodes(values)...)
+}
+
+// TextDecorationColorValue is implemented by the values of the "text-decoration-color" property.
+type TextDecorationColorValue interface {
+	ValueNode
+	textDecorationColorValue()
+}
+
+// TextDecorationColor creates a "text-decoration-color" property.
+// Syntax: <color>
+// Example: TextDecorationColor(Red) -> "text-decoration-color: red;"
+func TextDecorationColor(value TextDecorationColorValue) Property {
+	return Prop("text-decoration-color", value)
+}
+
+// TextDecorationLineValue is implemented by the values of the "text-decoration-line" property.
+type TextDecorationLineValue interface {
+	ValueNode
+	textDecorationLineValue()
+}
+
+// TextDecorationLineKeyword represents a keyword of the "text-decoration-line" property.
+type TextDecorationLineKeyword string
+
+func (k TextDecorationLineKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextDecorationLineKeyword) valueNode()               {}
+func (k TextDecorationLineKeyword) textDecorationLineValue() {}
+
+// TextDecorationLineKeyword also implements the value interfaces of the shorthands of "text-decoration-line".
+func (k TextDecorationLineKeyword) textDecorationValue() {}
+
+// TextDecorationLineKeyword value constants.
+const (
+	TextDecorationLineNone          TextDecorationLineKeyword = "none"
+	TextDecorationLineUnderline     TextDecorationLineKeyword = "underline"
+	TextDecorationLineOverline      TextDecorationLineKeyword = "overline"
+	TextDecorationLineLineThrough   TextDecorationLineKeyword = "line-through"
+	TextDecorationLineBlink         TextDecorationLineKeyword = "blink"
+	TextDecorationLineSpellingError TextDecorationLineKeyword = "spelling-error"
+	TextDecorationLineGrammarError  TextDecorationLineKeyword = "grammar-error"
+)
+
+// TextDecorationLine creates a "text-decoration-line" property.
+// Syntax: none | [ underline || overline || line-through || blink ] | spelling-error | grammar-error
+// Example: TextDecorationLine(TextDecorationLineNone) -> "text-decoration-line: none;"
+func TextDecorationLine(values ...TextDecorationLineValue) Property {
+	return Prop("text-decoration-line", valueNodes(values)...)
+}
+
+// TextDecorationSkipInkValue is implemented by the values of the "text-decoration-skip-ink" property.
+type TextDecorationSkipInkValue interface {
+	ValueNode
+	textDecorationSkipInkValue()
+}
+
+// TextDecorationSkipInkKeyword represents a keyword of the "text-decoration-skip-ink" property.
+type TextDecorationSkipInkKeyword string
+
+func (k TextDecorationSkipInkKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextDecorationSkipInkKeyword) valueNode()                  {}
+func (k TextDecorationSkipInkKeyword) textDecorationSkipInkValue() {}
+
+// TextDecorationSkipInkKeyword value constants.
+const (
+	TextDecorationSkipInkAll  TextDecorationSkipInkKeyword = "all"
+	TextDecorationSkipInkNone TextDecorationSkipInkKeyword = "none"
+)
+
+// TextDecorationSkipInk creates a "text-decoration-skip-ink" property.
+// Syntax: auto | all | none
+// Example: TextDecorationSkipInk(TextDecorationSkipInkAll) -> "text-decoration-skip-ink: all;"
+func TextDecorationSkipInk(value TextDecorationSkipInkValue) Property {
+	return Prop("text-decoration-skip-ink", value)
+}
+
+// TextDecorationStyleValue is implemented by the values of the "text-decoration-style" property.
+type TextDecorationStyleValue interface {
+	ValueNode
+	textDecorationStyleValue()
+}
+
+// TextDecorationStyleKeyword represents a keyword of the "text-decoration-style" property.
+type TextDecorationStyleKeyword string
+
+func (k TextDecorationStyleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextDecorationStyleKeyword) valueNode()                {}
+func (k TextDecorationStyleKeyword) textDecorationStyleValue() {}
+
+// TextDecorationStyleKeyword also implements the value interfaces of the shorthands of "text-decoration-style".
+func (k TextDecorationStyleKeyword) textDecorationValue() {}
+
+// TextDecorationStyleKeyword value constants.
+const (
+	TextDecorationStyleSolid  TextDecorationStyleKeyword = "solid"
+	TextDecorationStyleDouble TextDecorationStyleKeyword = "double"
+	TextDecorationStyleDotted TextDecorationStyleKeyword = "dotted"
+	TextDecorationStyleDashed TextDecorationStyleKeyword = "dashed"
+	TextDecorationStyleWavy   TextDecorationStyleKeyword = "wavy"
+)
+
+// TextDecorationStyle creates a "text-decoration-style" property.
+// Syntax: solid | double | dotted | dashed | wavy
+// Example: TextDecorationStyle(TextDecorationStyleSolid) -> "text-decoration-style: solid;"
+func TextDecorationStyle(value TextDecorationStyleValue) Property {
+	return Prop("text-decoration-style", value)
+}
+
+// TextDecorationThicknessValue is implemented by the values of the "text-decoration-thickness" property.
+type TextDecorationThicknessValue interface {
+	ValueNode
+	textDecorationThicknessValue()
+}
+
+// TextDecorationThicknessKeyword represents a keyword of the "text-decoration-thickness" property.
+type TextDecorationThicknessKeyword string
+
+func (k TextDecorationThicknessKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextDecorationThicknessKeyword) valueNode()                    {}
+func (k TextDecorationThicknessKeyword) textDecorationThicknessValue() {}
+
+// TextDecorationThicknessKeyword also implements the value interfaces of the shorthands of "text-decoration-thickness".
+func (k TextDecorationThicknessKeyword) textDecorationValue() {}
+
+// TextDecorationThicknessKeyword value constants.
+const (
+	TextDecorationThicknessFromFont TextDecorationThicknessKeyword = "from-font"
+)
+
+// TextDecorationThickness creates a "text-decoration-thickness" property.
+// Syntax: auto | from-font | <length> | <percentage>
+// Example: TextDecorationThickness(TextDecorationThicknessFromFont) -> "text-decoration-thickness: from-font;"
+func TextDecorationThickness(value TextDecorationThicknessValue) Property {
+	return Prop("text-decoration-thickness", value)
+}
+
+// TextEmphasisValue is implemented by the values of the "text-emphasis" property.
+type TextEmphasisValue interface {
+	ValueNode
+	textEmphasisValue()
+}
+
+// TextEmphasis creates a "text-emphasis" property.
+// Syntax: <'text-emphasis-style'> || <'text-emphasis-color'>
+// Example: TextEmphasis(Str("a")) -> "text-emphasis: "a";"
+func TextEmphasis(values ...TextEmphasisValue) Property {
+	return Prop("text-emphasis", valueNodes(values)...)
+}
+
+// TextEmphasisColorValue is implemented by the values of the "text-emphasis-color" property.
+type TextEmphasisColorValue interface {
+	ValueNode
+	textEmphasisColorValue()
+}
+
+// TextEmphasisColor creates a "text-emphasis-color" property.
+// Syntax: <color>
+// Example: TextEmphasisColor(Red) -> "text-emphasis-color: red;"
+func TextEmphasisColor(value TextEmphasisColorValue) Property {
+	return Prop("text-emphasis-color", value)
+}
+
+// TextEmphasisPositionValue is implemented by the values of the "text-emphasis-position" property.
+type TextEmphasisPositionValue interface {
+	ValueNode
+	textEmphasisPositionValue()
+}
+
+// TextEmphasisPositionKeyword represents a keyword of the "text-emphasis-position" property.
+type TextEmphasisPositionKeyword string
+
+func (k TextEmphasisPositionKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextEmphasisPositionKeyword) valueNode()                 {}
+func (k TextEmphasisPositionKeyword) textEmphasisPositionValue() {}
+
+// TextEmphasisPositionKeyword value constants.
+const (
+	TextEmphasisPositionOver  TextEmphasisPositionKeyword = "over"
+	TextEmphasisPositionUnder TextEmphasisPositionKeyword = "under"
+)
+
+// TextEmphasisPosition creates a "text-emphasis-position" property.
+// Syntax: auto | [ over | under ] && [ right | left ]?
+// Example: TextEmphasisPosition(TextEmphasisPositionOver) -> "text-emphasis-position: over;"
+func TextEmphasisPosition(values ...TextEmphasisPositionValue) Property {
+	return Prop("text-emphasis-position", valueNodes(values)...)
+}
+
+// TextEmphasisStyleValue is implemented by the values of the "text-emphasis-style" property.
+type TextEmphasisStyleValue interface {
+	ValueNode
+	textEmphasisStyleValue()
+}
+
+// TextEmphasisStyleKeyword represents a keyword of the "text-emphasis-style" property.
+type TextEmphasisStyleKeyword string
+
+func (k TextEmphasisStyleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextEmphasisStyleKeyword) valueNode()              {}
+func (k TextEmphasisStyleKeyword) textEmphasisStyleValue() {}
+
+// TextEmphasisStyleKeyword also implements the value interfaces of the shorthands of "text-emphasis-style".
+func (k TextEmphasisStyleKeyword) textEmphasisValue() {}
+
+// TextEmphasisStyleKeyword value constants.
+const (
+	TextEmphasisStyleNone         TextEmphasisStyleKeyword = "none"
+	TextEmphasisStyleFilled       TextEmphasisStyleKeyword = "filled"
+	TextEmphasisStyleOpen         TextEmphasisStyleKeyword = "open"
+	TextEmphasisStyleDot          TextEmphasisStyleKeyword = "dot"
+	TextEmphasisStyleCircle       TextEmphasisStyleKeyword = "circle"
+	TextEmphasisStyleDoubleCircle TextEmphasisStyleKeyword = "double-circle"
+	TextEmphasisStyleTriangle     TextEmphasisStyleKeyword = "triangle"
+	TextEmphasisStyleSesame       TextEmphasisStyleKeyword = "sesame"
+)
+
+// TextEmphasisStyle creates a "text-emphasis-style" property.
+// Syntax: none | [ [ filled | open ] || [ dot | circle | double-circle | triangle | sesame ] ] | <string>
+// Example: TextEmphasisStyle(TextEmphasisStyleNone) -> "text-emphasis-style: none;"
+func TextEmphasisStyle(values ...TextEmphasisStyleValue) Property {
+	return Prop("text-emphasis-style", valueNodes(values)...)
+}
+
+// TextIndentValue is implemented by the values of the "text-indent" property.
+type TextIndentValue interface {
+	ValueNode
+	textIndentValue()
+}
+
+// TextIndent creates a "text-indent" property.
+// Syntax: <length-percentage> && hanging? && each-line?
+// Example: TextIndent(PX(1)) -> "text-indent: 1px;"
+func TextIndent(values ...TextIndentValue) Property {
+	return Prop("text-indent", valueNodes(values)...)
+}
+
+// TextJustifyValue is implemented by the values of the "text-justify" property.
+type TextJustifyValue interface {
+	ValueNode
+	textJustifyValue()
+}
+
+// TextJustifyKeyword represents a keyword of the "text-justify" property.
+type TextJustifyKeyword string
+
+func (k TextJustifyKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextJustifyKeyword) valueNode()        {}
+func (k TextJustifyKeyword) textJustifyValue() {}
+
+// TextJustifyKeyword value constants.
+const (
+	TextJustifyInterCharacter TextJustifyKeyword = "inter-character"
+	TextJustifyInterWord      TextJustifyKeyword = "inter-word"
+	TextJustifyNone           TextJustifyKeyword = "none"
+)
+
+// TextJustify creates a "text-justify" property.
+// Syntax: auto | inter-character | inter-word | none
+// Example: TextJustify(TextJustifyInterCharacter) -> "text-justify: inter-character;"
+func TextJustify(value TextJustifyValue) Property {
+	return Prop("text-justify", value)
+}
+
+// TextOrientationValue is implemented by the values of the "text-orientation" property.
+type TextOrientationValue interface {
+	ValueNode
+	textOrientationValue()
+}
+
+// TextOrientationKeyword represents a keyword of the "text-orientation" property.
+type TextOrientationKeyword string
+
+func (k TextOrientationKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextOrientationKeyword) valueNode()            {}
+func (k TextOrientationKeyword) textOrientationValue() {}
+
+// TextOrientationKeyword value constants.
+const (
+	TextOrientationMixed    TextOrientationKeyword = "mixed"
+	TextOrientationUpright  TextOrientationKeyword = "upright"
+	TextOrientationSideways TextOrientationKeyword = "sideways"
+)
+
+// TextOrientation creates a "text-orientation" property.
+// Syntax: mixed | upright | sideways
+// Example: TextOrientation(TextOrientationMixed) -> "text-orientation: mixed;"
+func TextOrientation(value TextOrientationValue) Property {
+	return Prop("text-orientation", value)
+}
+
+// TextOverflowValue is implemented by the values of the "text-overflow" property.
+type TextOverflowValue interface {
+	ValueNode
+	textOverflowValue()
+}
+
+// TextOverflowKeyword represents a keyword of the "text-overflow" property.
+type TextOverflowKeyword string
+
+func (k TextOverflowKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextOverflowKeyword) valueNode()         {}
+func (k TextOverflowKeyword) textOverflowValue() {}
+
+// TextOverflowKeyword value constants.
+const (
+	TextOverflowClip     TextOverflowKeyword = "clip"
+	TextOverflowEllipsis TextOverflowKeyword = "ellipsis"
+)
+
+// TextOverflow creates a "text-overflow" property.
+// Syntax: [ clip | ellipsis | <string> ]{1,2}
+// Example: TextOverflow(TextOverflowClip) -> "text-overflow: clip;"
+func TextOverflow(values ...TextOverflowValue) Property {
+	return Prop("text-overflow", valueNodes(values)...)
+}
+
+// TextRenderingValue is implemented by the values of the "text-rendering" property.
+type TextRenderingValue interface {
+	ValueNode
+	textRenderingValue()
+}
+
+// TextRenderingKeyword represents a keyword of the "text-rendering" property.
+type TextRenderingKeyword string
+
+func (k TextRenderingKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextRenderingKeyword) valueNode()          {}
+func (k TextRenderingKeyword) textRenderingValue() {}
+
+// TextRenderingKeyword value constants.
+const (
+	TextRenderingOptimizeSpeed      TextRenderingKeyword = "optimizeSpeed"
+	TextRenderingOptimizeLegibility TextRenderingKeyword = "optimizeLegibility"
+	TextRenderingGeometricPrecision TextRenderingKeyword = "geometricPrecision"
+)
+
+// TextRendering creates a "text-rendering" property.
+// Syntax: auto | optimizeSpeed | optimizeLegibility | geometricPrecision
+// Example: TextRendering(TextRenderingOptimizeSpeed) -> "text-rendering: optimizeSpeed;"
+func TextRendering(value TextRenderingValue) Property {
+	return Prop("text-rendering", value)
+}
+
+// TextShadowValue is implemented by the values of the "text-shadow" property.
+type TextShadowValue interface {
+	ValueNode
+	textShadowValue()
+}
+
+// TextShadowKeyword represents a keyword of the "text-shadow" property.
+type TextShadowKeyword string
+
+func (k TextShadowKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextShadowKeyword) valueNode()       {}
+func (k TextShadowKeyword) textShadowValue() {}
+
+// TextShadowKeyword value constants.
+const (
+	TextShadowNone TextShadowKeyword = "none"
+)
+
+// TextShadow creates a "text-shadow" property.
+// Syntax: none | <shadow-t>#
+// Example: TextShadow(PX(1), PX(2), Red) -> "text-shadow: 1px 2px red;"
+// It sets a single layer; use TextShadowLayers for several.
+func TextShadow(values ...TextShadowValue) Property {
+	return Prop("text-shadow", valueNodes(values)...)
+}
+
+// TextShadowLayers creates a "text-shadow" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: TextShadowLayers([]TextShadowValue{PX(1), PX(2), Red}, []TextShadowValue{PX(1), PX(2), Red}) -> "text-shadow: 1px 2px red, 1px 2px red;"
+func TextShadowLayers(layers ...[]TextShadowValue) Property {
+	return commaProp("text-shadow", layerNodes(layers)...)
+}
+
+// TextSizeAdjustValue is implemented by the values of the "text-size-adjust" property.
+type TextSizeAdjustValue interface {
+	ValueNode
+	textSizeAdjustValue()
+}
+
+// TextSizeAdjustKeyword represents a keyword of the "text-size-adjust" property.
+type TextSizeAdjustKeyword string
+
+func (k TextSizeAdjustKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextSizeAdjustKeyword) valueNode()           {}
+func (k TextSizeAdjustKeyword) textSizeAdjustValue() {}
+
+// TextSizeAdjustKeyword value constants.
+const (
+	TextSizeAdjustNone TextSizeAdjustKeyword = "none"
+)
+
+// TextSizeAdjust creates a "text-size-adjust" property.
+// Syntax: none | auto | <percentage>
+// Example: TextSizeAdjust(TextSizeAdjustNone) -> "text-size-adjust: none;"
+func TextSizeAdjust(value TextSizeAdjustValue) Property {
+	return Prop("text-size-adjust", value)
+}
+
+// TextSpacingTrimValue is implemented by the values of the "text-spacing-trim" property.
+type TextSpacingTrimValue interface {
+	ValueNode
+	textSpacingTrimValue()
+}
+
+// TextSpacingTrimKeyword represents a keyword of the "text-spacing-trim" property.
+type TextSpacingTrimKeyword string
+
+func (k TextSpacingTrimKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextSpacingTrimKeyword) valueNode()            {}
+func (k TextSpacingTrimKeyword) textSpacingTrimValue() {}
+
+// TextSpacingTrimKeyword value constants.
+const (
+	TextSpacingTrimSpaceAll   TextSpacingTrimKeyword = "space-all"
+	TextSpacingTrimNormal     TextSpacingTrimKeyword = "normal"
+	TextSpacingTrimSpaceFirst TextSpacingTrimKeyword = "space-first"
+	TextSpacingTrimTrimStart  TextSpacingTrimKeyword = "trim-start"
+	TextSpacingTrimTrimBoth   TextSpacingTrimKeyword = "trim-both"
+	TextSpacingTrimTrimAll    TextSpacingTrimKeyword = "trim-all"
+)
+
+// TextSpacingTrim creates a "text-spacing-trim" property.
+// Syntax: space-all | normal | space-first | trim-start | trim-both | trim-all | auto
+// Example: TextSpacingTrim(TextSpacingTrimSpaceAll) -> "text-spacing-trim: space-all;"
+func TextSpacingTrim(value TextSpacingTrimValue) Property {
+	return Prop("text-spacing-trim", value)
+}
+
+// TextTransformValue is implemented by the values of the "text-transform" property.
+type TextTransformValue interface {
+	ValueNode
+	textTransformValue()
+}
+
+// TextTransformKeyword represents a keyword of the "text-transform" property.
+type TextTransformKeyword string
+
+func (k TextTransformKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextTransformKeyword) valueNode()          {}
+func (k TextTransformKeyword) textTransformValue() {}
+
+// TextTransformKeyword value constants.
+const (
+	TextTransformNone         TextTransformKeyword = "none"
+	TextTransformCapitalize   TextTransformKeyword = "capitalize"
+	TextTransformUppercase    TextTransformKeyword = "uppercase"
+	TextTransformLowercase    TextTransformKeyword = "lowercase"
+	TextTransformFullWidth    TextTransformKeyword = "full-width"
+	TextTransformFullSizeKana TextTransformKeyword = "full-size-kana"
+)
+
+// TextTransform creates a "text-transform" property.
+// Syntax: none | [ capitalize | uppercase | lowercase ] || full-width || full-size-kana
+// Example: TextTransform(TextTransformNone) -> "text-transform: none;"
+func TextTransform(values ...TextTransformValue) Property {
+	return Prop("text-transform", valueNodes(values)...)
+}
+
+// TextUnderlineOffsetValue is implemented by the values of the "text-underline-offset" property.
+type TextUnderlineOffsetValue interface {
+	ValueNode
+	textUnderlineOffsetValue()
+}
+
+// TextUnderlineOffset creates a "text-underline-offset" property.
+// Syntax: auto | <length> | <percentage>
+// Example: TextUnderlineOffset(Auto) -> "text-underline-offset: auto;"
+func TextUnderlineOffset(value TextUnderlineOffsetValue) Property {
+	return Prop("text-underline-offset", value)
+}
+
+// TextUnderlinePositionValue is implemented by the values of the "text-underline-position" property.
+type TextUnderlinePositionValue interface {
+	ValueNode
+	textUnderlinePositionValue()
+}
+
+// TextUnderlinePositionKeyword represents a keyword of the "text-underline-position" property.
+type TextUnderlinePositionKeyword string
+
+func (k TextUnderlinePositionKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextUnderlinePositionKeyword) valueNode()                  {}
+func (k TextUnderlinePositionKeyword) textUnderlinePositionValue() {}
+
+// TextUnderlinePositionKeyword value constants.
+const (
+	TextUnderlinePositionFromFont TextUnderlinePositionKeyword = "from-font"
+	TextUnderlinePositionUnder    TextUnderlinePositionKeyword = "under"
+	TextUnderlinePositionLeft     TextUnderlinePositionKeyword = "left"
+	TextUnderlinePositionRight    TextUnderlinePositionKeyword = "right"
+)
+
+// TextUnderlinePosition creates a "text-underline-position" property.
+// Syntax: auto | from-font | [ under || [ left | right ] ]
+// Example: TextUnderlinePosition(TextUnderlinePositionFromFont) -> "text-underline-position: from-font;"
+func TextUnderlinePosition(values ...TextUnderlinePositionValue) Property {
+	return Prop("text-underline-position", valueNodes(values)...)
+}
+
+// TextWrapValue is implemented by the values of the "text-wrap" property.
+type TextWrapValue interface {
+	ValueNode
+	textWrapValue()
+}
+
+// TextWrap creates a "text-wrap" property.
+// Syntax: <'text-wrap-mode'> || <'text-wrap-style'>
+// Example: TextWrap(Auto) -> "text-wrap: auto;"
+func TextWrap(values ...TextWrapValue) Property {
+	return Prop("text-wrap", valueNodes(values)...)
+}
+
+// TextWrapModeValue is implemented by the values of the "text-wrap-mode" property.
+type TextWrapModeValue interface {
+	ValueNode
+	textWrapModeValue()
+}
+
+// TextWrapModeKeyword represents a keyword of the "text-wrap-mode" property.
+type TextWrapModeKeyword string
+
+func (k TextWrapModeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextWrapModeKeyword) valueNode()         {}
+func (k TextWrapModeKeyword) textWrapModeValue() {}
+
+// TextWrapModeKeyword also implements the value interfaces of the shorthands of "text-wrap-mode".
+func (k TextWrapModeKeyword) textWrapValue()   {}
+func (k TextWrapModeKeyword) whiteSpaceValue() {}
+
+// TextWrapModeKeyword value constants.
+const (
+	TextWrapModeWrap   TextWrapModeKeyword = "wrap"
+	TextWrapModeNowrap TextWrapModeKeyword = "nowrap"
+)
+
+// TextWrapMode creates a "text-wrap-mode" property.
+// Syntax: wrap | nowrap
+// Example: TextWrapMode(TextWrapModeWrap) -> "text-wrap-mode: wrap;"
+func TextWrapMode(value TextWrapModeValue) Property {
+	return Prop("text-wrap-mode", value)
+}
+
+// TextWrapStyleValue is implemented by the values of the "text-wrap-style" property.
+type TextWrapStyleValue interface {
+	ValueNode
+	textWrapStyleValue()
+}
+
+// TextWrapStyleKeyword represents a keyword of the "text-wrap-style" property.
+type TextWrapStyleKeyword string
+
+func (k TextWrapStyleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TextWrapStyleKeyword) valueNode()          {}
+func (k TextWrapStyleKeyword) textWrapStyleValue() {}
+
+// TextWrapStyleKeyword also implements the value interfaces of the shorthands of "text-wrap-style".
+func (k TextWrapStyleKeyword) textWrapValue() {}
+
+// TextWrapStyleKeyword value constants.
+const (
+	TextWrapStyleBalance TextWrapStyleKeyword = "balance"
+	TextWrapStyleStable  TextWrapStyleKeyword = "stable"
+	TextWrapStylePretty  TextWrapStyleKeyword = "pretty"
+)
+
+// TextWrapStyle creates a "text-wrap-style" property.
+// Syntax: auto | balance | stable | pretty
+// Example: TextWrapStyle(TextWrapStyleBalance) -> "text-wrap-style: balance;"
+func TextWrapStyle(value TextWrapStyleValue) Property {
+	return Prop("text-wrap-style", value)
+}
+
+// TimelineScopeValue is implemented by the values of the "timeline-scope" property.
+type TimelineScopeValue interface {
+	ValueNode
+	timelineScopeValue()
+}
+
+// TimelineScopeKeyword represents a keyword of the "timeline-scope" property.
+type TimelineScopeKeyword string
+
+func (k TimelineScopeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TimelineScopeKeyword) valueNode()          {}
+func (k TimelineScopeKeyword) timelineScopeValue() {}
+
+// TimelineScopeKeyword value constants.
+const (
+	TimelineScopeNone TimelineScopeKeyword = "none"
+)
+
+// TimelineScope creates a "timeline-scope" property.
+// Syntax: none | <dashed-ident>#
+// Example: TimelineScope(Ident("--a")) -> "timeline-scope: --a;"
+func TimelineScope(values ...TimelineScopeValue) Property {
+	return commaProp("timeline-scope", valueNodes(values)...)
+}
+
+// TopValue is implemented by the values of the "top" property.
+type TopValue interface {
+	ValueNode
+	topValue()
+}
+
+// Top creates a "top" property.
+// Syntax: <length-percentage> | auto
+// Example: Top(PX(1)) -> "top: 1px;"
+func Top(value TopValue) Property {
+	return Prop("top", value)
+}
+
+// TouchActionValue is implemented by the values of the "touch-action" property.
+type TouchActionValue interface {
+	ValueNode
+	touchActionValue()
+}
+
+// TouchActionKeyword represents a keyword of the "touch-action" property.
+type TouchActionKeyword string
+
+func (k TouchActionKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TouchActionKeyword) valueNode()        {}
+func (k TouchActionKeyword) touchActionValue() {}
+
+// TouchActionKeyword value constants.
+const (
+	TouchActionNone         TouchActionKeyword = "none"
+	TouchActionPanX         TouchActionKeyword = "pan-x"
+	TouchActionPanLeft      TouchActionKeyword = "pan-left"
+	TouchActionPanRight     TouchActionKeyword = "pan-right"
+	TouchActionPanY         TouchActionKeyword = "pan-y"
+	TouchActionPanUp        TouchActionKeyword = "pan-up"
+	TouchActionPanDown      TouchActionKeyword = "pan-down"
+	TouchActionPinchZoom    TouchActionKeyword = "pinch-zoom"
+	TouchActionManipulation TouchActionKeyword = "manipulation"
+)
+
+// TouchAction creates a "touch-action" property.
+// Syntax: auto | none | [ [ pan-x | pan-left | pan-right ] || [ pan-y | pan-up | pan-down ] || pinch-zoom ] | manipulation
+// Example: TouchAction(TouchActionNone) -> "touch-action: none;"
+func TouchAction(values ...TouchActionValue) Property {
+	return Prop("touch-action", valueNodes(values)...)
+}
+
+// TransformValue is implemented by the values of the "transform" property.
+type TransformValue interface {
+	ValueNode
+	transformValue()
+}
+
+// TransformKeyword represents a keyword of the "transform" property.
+type TransformKeyword string
+
+func (k TransformKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TransformKeyword) valueNode()      {}
+func (k TransformKeyword) transformValue() {}
+
+// TransformKeyword value constants.
+const (
+	TransformNone TransformKeyword = "none"
+)
+
+// Transform creates a "transform" property.
+// Syntax: none | <transform-list>
+// Example: Transform(TransformNone) -> "transform: none;"
+func Transform(values ...TransformValue) Property {
+	return Prop("transform", valueNodes(values)...)
+}
+
+// TransformBoxValue is implemented by the values of the "transform-box" property.
+type TransformBoxValue interface {
+	ValueNode
+	transformBoxValue()
+}
+
+// TransformBoxKeyword represents a keyword of the "transform-box" property.
+type TransformBoxKeyword string
+
+func (k TransformBoxKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TransformBoxKeyword) valueNode()         {}
+func (k TransformBoxKeyword) transformBoxValue() {}
+
+// TransformBoxKeyword value constants.
+const (
+	TransformBoxContentBox TransformBoxKeyword = "content-box"
+	TransformBoxBorderBox  TransformBoxKeyword = "border-box"
+	TransformBoxFillBox    TransformBoxKeyword = "fill-box"
+	TransformBoxStrokeBox  TransformBoxKeyword = "stroke-box"
+	TransformBoxViewBox    TransformBoxKeyword = "view-box"
+)
+
+// TransformBox creates a "transform-box" property.
+// Syntax: content-box | border-box | fill-box | stroke-box | view-box
+// Example: TransformBox(TransformBoxContentBox) -> "transform-box: content-box;"
+func TransformBox(value TransformBoxValue) Property {
+	return Prop("transform-box", value)
+}
+
+// TransformOriginValue is implemented by the values of the "transform-origin" property.
+type TransformOriginValue interface {
+	ValueNode
+	transformOriginValue()
+}
+
+// TransformOriginKeyword represents a keyword of the "transform-origin" property.
+type TransformOriginKeyword string
+
+func (k TransformOriginKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TransformOriginKeyword) valueNode()            {}
+func (k TransformOriginKeyword) transformOriginValue() {}
+
+// TransformOriginKeyword value constants.
+const (
+	TransformOriginLeft   TransformOriginKeyword = "left"
+	TransformOriginCenter TransformOriginKeyword = "center"
+	TransformOriginRight  TransformOriginKeyword = "right"
+	TransformOriginTop    TransformOriginKeyword = "top"
+	TransformOriginBottom TransformOriginKeyword = "bottom"
+)
+
+// TransformOrigin creates a "transform-origin" property.
+// Syntax: [ <length-percentage> | left | center | right | top | bottom ] | [ [ <length-percentage> | left | center | right ] && [ <length-percentage> | top | center | bottom ] ] <length>?
+// Example: TransformOrigin(TransformOriginLeft) -> "transform-origin: left;"
+func TransformOrigin(values ...TransformOriginValue) Property {
+	return Prop("transform-origin", valueNodes(values)...)
+}
+
+// TransformStyleValue is implemented by the values of the "transform-style" property.
+type TransformStyleValue interface {
+	ValueNode
+	transformStyleValue()
+}
+
+// TransformStyleKeyword represents a keyword of the "transform-style" property.
+type TransformStyleKeyword string
+
+func (k TransformStyleKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TransformStyleKeyword) valueNode()           {}
+func (k TransformStyleKeyword) transformStyleValue() {}
+
+// TransformStyleKeyword value constants.
+const (
+	TransformStyleFlat       TransformStyleKeyword = "flat"
+	TransformStylePreserve3d TransformStyleKeyword = "preserve-3d"
+)
+
+// TransformStyle creates a "transform-style" property.
+// Syntax: flat | preserve-3d
+// Example: TransformStyle(TransformStyleFlat) -> "transform-style: flat;"
+func TransformStyle(value TransformStyleValue) Property {
+	return Prop("transform-style", value)
+}
+
+// TransitionValue is implemented by the values of the "transition" property.
+type TransitionValue interface {
+	ValueNode
+	transitionValue()
+}
+
+// Transition creates a "transition" property.
+// Syntax: <single-transition>#
+// Example: Transition(Ident("opacity"), MS(200)) -> "transition: opacity 200ms;"
+// It sets a single layer; use TransitionLayers for several.
+func Transition(values ...TransitionValue) Property {
+	return Prop("transition", valueNodes(values)...)
+}
+
+// TransitionLayers creates a "transition" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: TransitionLayers([]TransitionValue{Ident("opacity"), MS(200)}, []TransitionValue{Ident("opacity"), MS(200)}) -> "transition: opacity 200ms, opacity 200ms;"
+func TransitionLayers(layers ...[]TransitionValue) Property {
+	return commaProp("transition", layerNodes(layers)...)
+}
+
+// TransitionBehaviorValue is implemented by the values of the "transition-behavior" property.
+type TransitionBehaviorValue interface {
+	ValueNode
+	transitionBehaviorValue()
+}
+
+// TransitionBehaviorKeyword represents a keyword of the "transition-behavior" property.
+type TransitionBehaviorKeyword string
+
+func (k TransitionBehaviorKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TransitionBehaviorKeyword) valueNode()               {}
+func (k TransitionBehaviorKeyword) transitionBehaviorValue() {}
+
+// TransitionBehaviorKeyword also implements the value interfaces of the shorthands of "transition-behavior".
+func (k TransitionBehaviorKeyword) transitionValue() {}
+
+// TransitionBehaviorKeyword value constants.
+const (
+	TransitionBehaviorNormal        TransitionBehaviorKeyword = "normal"
+	TransitionBehaviorAllowDiscrete TransitionBehaviorKeyword = "allow-discrete"
+)
+
+// TransitionBehavior creates a "transition-behavior" property.
+// Syntax: <transition-behavior-value>#
+// Example: TransitionBehavior(TransitionBehaviorNormal) -> "transition-behavior: normal;"
+func TransitionBehavior(values ...TransitionBehaviorValue) Property {
+	return commaProp("transition-behavior", valueNodes(values)...)
+}
+
+// TransitionDelayValue is implemented by the values of the "transition-delay" property.
+type TransitionDelayValue interface {
+	ValueNode
+	transitionDelayValue()
+}
+
+// TransitionDelay creates a "transition-delay" property.
+// Syntax: <time>#
+// Example: TransitionDelay(MS(200)) -> "transition-delay: 200ms;"
+func TransitionDelay(values ...TransitionDelayValue) Property {
+	return commaProp("transition-delay", valueNodes(values)...)
+}
+
+// TransitionDurationValue is implemented by the values of the "transition-duration" property.
+type TransitionDurationValue interface {
+	ValueNode
+	transitionDurationValue()
+}
+
+// TransitionDuration creates a "transition-duration" property.
+// Syntax: <time [0s,∞]>#
+// Example: TransitionDuration(MS(200)) -> "transition-duration: 200ms;"
+func TransitionDuration(values ...TransitionDurationValue) Property {
+	return commaProp("transition-duration", valueNodes(values)...)
+}
+
+// TransitionPropertyValue is implemented by the values of the "transition-property" property.
+type TransitionPropertyValue interface {
+	ValueNode
+	transitionPropertyValue()
+}
+
+// TransitionPropertyKeyword represents a keyword of the "transition-property" property.
+type TransitionPropertyKeyword string
+
+func (k TransitionPropertyKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TransitionPropertyKeyword) valueNode()               {}
+func (k TransitionPropertyKeyword) transitionPropertyValue() {}
+
+// TransitionPropertyKeyword also implements the value interfaces of the shorthands of "transition-property".
+func (k TransitionPropertyKeyword) transitionValue() {}
+
+// TransitionPropertyKeyword value constants.
+const (
+	TransitionPropertyNone TransitionPropertyKeyword = "none"
+	TransitionPropertyAll  TransitionPropertyKeyword = "all"
+)
+
+// TransitionProperty creates a "transition-property" property.
+// Syntax: none | <single-transition-property>#
+// Example: TransitionProperty(TransitionPropertyNone) -> "transition-property: none;"
+func TransitionProperty(values ...TransitionPropertyValue) Property {
+	return commaProp("transition-property", valueNodes(values)...)
+}
+
+// TransitionTimingFunctionValue is implemented by the values of the "transition-timing-function" property.
+type TransitionTimingFunctionValue interface {
+	ValueNode
+	transitionTimingFunctionValue()
+}
+
+// TransitionTimingFunctionKeyword represents a keyword of the "transition-timing-function" property.
+type TransitionTimingFunctionKeyword string
+
+func (k TransitionTimingFunctionKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TransitionTimingFunctionKeyword) valueNode()                     {}
+func (k TransitionTimingFunctionKeyword) transitionTimingFunctionValue() {}
+
+// TransitionTimingFunctionKeyword also implements the value interfaces of the shorthands of "transition-timing-function".
+func (k TransitionTimingFunctionKeyword) transitionValue() {}
+
+// TransitionTimingFunctionKeyword value constants.
+const (
+	TransitionTimingFunctionLinear    TransitionTimingFunctionKeyword = "linear"
+	TransitionTimingFunctionEase      TransitionTimingFunctionKeyword = "ease"
+	TransitionTimingFunctionEaseIn    TransitionTimingFunctionKeyword = "ease-in"
+	TransitionTimingFunctionEaseOut   TransitionTimingFunctionKeyword = "ease-out"
+	TransitionTimingFunctionEaseInOut TransitionTimingFunctionKeyword = "ease-in-out"
+	TransitionTimingFunctionStepStart TransitionTimingFunctionKeyword = "step-start"
+	TransitionTimingFunctionStepEnd   TransitionTimingFunctionKeyword = "step-end"
+)
+
+// TransitionTimingFunction creates a "transition-timing-function" property.
+// Syntax: <easing-function>#
+// Example: TransitionTimingFunction(TransitionTimingFunctionLinear) -> "transition-timing-function: linear;"
+func TransitionTimingFunction(values ...TransitionTimingFunctionValue) Property {
+	return commaProp("transition-timing-function", valueNodes(values)...)
+}
+
+// TranslateValue is implemented by the values of the "translate" property.
+type TranslateValue interface {
+	ValueNode
+	translateValue()
+}
+
+// TranslateKeyword represents a keyword of the "translate" property.
+type TranslateKeyword string
+
+func (k TranslateKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k TranslateKeyword) valueNode()      {}
+func (k TranslateKeyword) translateValue() {}
+
+// TranslateKeyword value constants.
+const (
+	TranslateNone TranslateKeyword = "none"
+)
+
+// Translate creates a "translate" property.
+// Syntax: none | <length-percentage> [ <length-percentage> <length>? ]?
+// Example: Translate(TranslateNone) -> "translate: none;"
+func Translate(values ...TranslateValue) Property {
+	return Prop("translate", valueNodes(values)...)
+}
+
+// UnicodeBidiValue is implemented by the values of the "unicode-bidi" property.
+type UnicodeBidiValue interface {
+	ValueNode
+	unicodeBidiValue()
+}
+
+// UnicodeBidiKeyword represents a keyword of the "unicode-bidi" property.
+type UnicodeBidiKeyword string
+
+func (k UnicodeBidiKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k UnicodeBidiKeyword) valueNode()        {}
+func (k UnicodeBidiKeyword) unicodeBidiValue() {}
+
+// UnicodeBidiKeyword value constants.
+const (
+	UnicodeBidiNormal          UnicodeBidiKeyword = "normal"
+	UnicodeBidiEmbed           UnicodeBidiKeyword = "embed"
+	UnicodeBidiIsolate         UnicodeBidiKeyword = "isolate"
+	UnicodeBidiBidiOverride    UnicodeBidiKeyword = "bidi-override"
+	UnicodeBidiIsolateOverride UnicodeBidiKeyword = "isolate-override"
+	UnicodeBidiPlaintext       UnicodeBidiKeyword = "plaintext"
+)
+
+// UnicodeBidi creates an "unicode-bidi" property.
+// Syntax: normal | embed | isolate | bidi-override | isolate-override | plaintext
+// Example: UnicodeBidi(UnicodeBidiNormal) -> "unicode-bidi: normal;"
+func UnicodeBidi(value UnicodeBidiValue) Property {
+	return Prop("unicode-bidi", value)
+}
+
+// UserSelectValue is implemented by the values of the "user-select" property.
+type UserSelectValue interface {
+	ValueNode
+	userSelectValue()
+}
+
+// UserSelectKeyword represents a keyword of the "user-select" property.
+type UserSelectKeyword string
+
+func (k UserSelectKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k UserSelectKeyword) valueNode()       {}
+func (k UserSelectKeyword) userSelectValue() {}
+
+// UserSelectKeyword value constants.
+const (
+	UserSelectText UserSelectKeyword = "text"
+	UserSelectNone UserSelectKeyword = "none"
+	UserSelectAll  UserSelectKeyword = "all"
+)
+
+// UserSelect creates an "user-select" property.
+// Syntax: auto | text | none | all
+// Example: UserSelect(UserSelectText) -> "user-select: text;"
+func UserSelect(value UserSelectValue) Property {
+	return Prop("user-select", value)
+}
+
+// VectorEffectValue is implemented by the values of the "vector-effect" property.
+type VectorEffectValue interface {
+	ValueNode
+	vectorEffectValue()
+}
+
+// VectorEffectKeyword represents a keyword of the "vector-effect" property.
+type VectorEffectKeyword string
+
+func (k VectorEffectKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k VectorEffectKeyword) valueNode()         {}
+func (k VectorEffectKeyword) vectorEffectValue() {}
+
+// VectorEffectKeyword value constants.
+const (
+	VectorEffectNone             VectorEffectKeyword = "none"
+	VectorEffectNonScalingStroke VectorEffectKeyword = "non-scaling-stroke"
+	VectorEffectNonScalingSize   VectorEffectKeyword = "non-scaling-size"
+	VectorEffectNonRotation      VectorEffectKeyword = "non-rotation"
+	VectorEffectFixedPosition    VectorEffectKeyword = "fixed-position"
+)
+
+// VectorEffect creates a "vector-effect" property.
+// Syntax: none | non-scaling-stroke | non-scaling-size | non-rotation | fixed-position
+// Example: VectorEffect(VectorEffectNone) -> "vector-effect: none;"
+func VectorEffect(value VectorEffectValue) Property {
+	return Prop("vector-effect", value)
+}
+
+// VerticalAlignValue is implemented by the values of the "vertical-align" property.
+type VerticalAlignValue interface {
+	ValueNode
+	verticalAlignValue()
+}
+
+// VerticalAlignKeyword represents a keyword of the "vertical-align" property.
+type VerticalAlignKeyword string
+
+func (k VerticalAlignKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k VerticalAlignKeyword) valueNode()          {}
+func (k VerticalAlignKeyword) verticalAlignValue() {}
+
+// VerticalAlignKeyword value constants.
+const (
+	VerticalAlignBaseline   VerticalAlignKeyword = "baseline"
+	VerticalAlignSub        VerticalAlignKeyword = "sub"
+	VerticalAlignSuper      VerticalAlignKeyword = "super"
+	VerticalAlignTextTop    VerticalAlignKeyword = "text-top"
+	VerticalAlignTextBottom VerticalAlignKeyword = "text-bottom"
+	VerticalAlignMiddle     VerticalAlignKeyword = "middle"
+	VerticalAlignTop        VerticalAlignKeyword = "top"
+	VerticalAlignBottom     VerticalAlignKeyword = "bottom"
+)
+
+// VerticalAlign creates a "vertical-align" property.
+// Syntax: baseline | sub | super | text-top | text-bottom | middle | top | bottom | <percentage> | <length>
+// Example: VerticalAlign(VerticalAlignBaseline) -> "vertical-align: baseline;"
+func VerticalAlign(value VerticalAlignValue) Property {
+	return Prop("vertical-align", value)
+}
+
+// ViewTimelineValue is implemented by the values of the "view-timeline" property.
+type ViewTimelineValue interface {
+	ValueNode
+	viewTimelineValue()
+}
+
+// ViewTimeline creates a "view-timeline" property.
+// Syntax: [ <'view-timeline-name'> <'view-timeline-axis'>? ]#
+// Example: ViewTimeline(Ident("--a"), ViewTimelineAxisInline) -> "view-timeline: --a inline;"
+// It sets a single layer; use ViewTimelineLayers for several.
+func ViewTimeline(values ...ViewTimelineValue) Property {
+	return Prop("view-timeline", valueNodes(values)...)
+}
+
+// ViewTimelineLayers creates a "view-timeline" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: ViewTimelineLayers([]ViewTimelineValue{Ident("--a"), ViewTimelineAxisInline}, []ViewTimelineValue{Ident("--a"), ViewTimelineAxisInline}) -> "view-timeline: --a inline, --a inline;"
+func ViewTimelineLayers(layers ...[]ViewTimelineValue) Property {
+	return commaProp("view-timeline", layerNodes(layers)...)
+}
+
+// ViewTimelineAxisValue is implemented by the values of the "view-timeline-axis" property.
+type ViewTimelineAxisValue interface {
+	ValueNode
+	viewTimelineAxisValue()
+}
+
+// ViewTimelineAxisKeyword represents a keyword of the "view-timeline-axis" property.
+type ViewTimelineAxisKeyword string
+
+func (k ViewTimelineAxisKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ViewTimelineAxisKeyword) valueNode()             {}
+func (k ViewTimelineAxisKeyword) viewTimelineAxisValue() {}
+
+// ViewTimelineAxisKeyword also implements the value interfaces of the shorthands of "view-timeline-axis".
+func (k ViewTimelineAxisKeyword) viewTimelineValue() {}
+
+// ViewTimelineAxisKeyword value constants.
+const (
+	ViewTimelineAxisBlock  ViewTimelineAxisKeyword = "block"
+	ViewTimelineAxisInline ViewTimelineAxisKeyword = "inline"
+	ViewTimelineAxisX      ViewTimelineAxisKeyword = "x"
+	ViewTimelineAxisY      ViewTimelineAxisKeyword = "y"
+)
+
+// ViewTimelineAxis creates a "view-timeline-axis" property.
+// Syntax: <axis>#
+// Example: ViewTimelineAxis(ViewTimelineAxisBlock) -> "view-timeline-axis: block;"
+func ViewTimelineAxis(values ...ViewTimelineAxisValue) Property {
+	return commaProp("view-timeline-axis", valueNodes(values)...)
+}
+
+// ViewTimelineInsetValue is implemented by the values of the "view-timeline-inset" property.
+type ViewTimelineInsetValue interface {
+	ValueNode
+	viewTimelineInsetValue()
+}
+
+// ViewTimelineInset creates a "view-timeline-inset" property.
+// Syntax: [ [ auto | <length-percentage> ]{1,2} ]#
+// Example: ViewTimelineInset(PX(10), Auto) -> "view-timeline-inset: 10px auto;"
+// It sets a single layer; use ViewTimelineInsetLayers for several.
+func ViewTimelineInset(values ...ViewTimelineInsetValue) Property {
+	return Prop("view-timeline-inset", valueNodes(values)...)
+}
+
+// ViewTimelineInsetLayers creates a "view-timeline-inset" property from comma separated layers,
+// each made of values separated by spaces.
+// Example: ViewTimelineInsetLayers([]ViewTimelineInsetValue{PX(10), Auto}, []ViewTimelineInsetValue{PX(10), Auto}) -> "view-timeline-inset: 10px auto, 10px auto;"
+func ViewTimelineInsetLayers(layers ...[]ViewTimelineInsetValue) Property {
+	return commaProp("view-timeline-inset", layerNodes(layers)...)
+}
+
+// ViewTimelineNameValue is implemented by the values of the "view-timeline-name" property.
+type ViewTimelineNameValue interface {
+	ValueNode
+	viewTimelineNameValue()
+}
+
+// ViewTimelineNameKeyword represents a keyword of the "view-timeline-name" property.
+type ViewTimelineNameKeyword string
+
+func (k ViewTimelineNameKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ViewTimelineNameKeyword) valueNode()             {}
+func (k ViewTimelineNameKeyword) viewTimelineNameValue() {}
+
+// ViewTimelineNameKeyword also implements the value interfaces of the shorthands of "view-timeline-name".
+func (k ViewTimelineNameKeyword) viewTimelineValue() {}
+
+// ViewTimelineNameKeyword value constants.
+const (
+	ViewTimelineNameNone ViewTimelineNameKeyword = "none"
+)
+
+// ViewTimelineName creates a "view-timeline-name" property.
+// Syntax: [ none | <dashed-ident> ]#
+// Example: ViewTimelineName(ViewTimelineNameNone) -> "view-timeline-name: none;"
+func ViewTimelineName(values ...ViewTimelineNameValue) Property {
+	return commaProp("view-timeline-name", valueNodes(values)...)
+}
+
+// ViewTransitionClassValue is implemented by the values of the "view-transition-class" property.
+type ViewTransitionClassValue interface {
+	ValueNode
+	viewTransitionClassValue()
+}
+
+// ViewTransitionClassKeyword represents a keyword of the "view-transition-class" property.
+type ViewTransitionClassKeyword string
+
+func (k ViewTransitionClassKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ViewTransitionClassKeyword) valueNode()                {}
+func (k ViewTransitionClassKeyword) viewTransitionClassValue() {}
+
+// ViewTransitionClassKeyword value constants.
+const (
+	ViewTransitionClassNone ViewTransitionClassKeyword = "none"
+)
+
+// ViewTransitionClass creates a "view-transition-class" property.
+// Syntax: none | <custom-ident>+
+// Example: ViewTransitionClass(ViewTransitionClassNone) -> "view-transition-class: none;"
+func ViewTransitionClass(values ...ViewTransitionClassValue) Property {
+	return Prop("view-transition-class", valueNodes(values)...)
+}
+
+// ViewTransitionNameValue is implemented by the values of the "view-transition-name" property.
+type ViewTransitionNameValue interface {
+	ValueNode
+	viewTransitionNameValue()
+}
+
+// ViewTransitionNameKeyword represents a keyword of the "view-transition-name" property.
+type ViewTransitionNameKeyword string
+
+func (k ViewTransitionNameKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ViewTransitionNameKeyword) valueNode()               {}
+func (k ViewTransitionNameKeyword) viewTransitionNameValue() {}
+
+// ViewTransitionNameKeyword value constants.
+const (
+	ViewTransitionNameNone ViewTransitionNameKeyword = "none"
+)
+
+// ViewTransitionName creates a "view-transition-name" property.
+// Syntax: none | <custom-ident>
+// Example: ViewTransitionName(ViewTransitionNameNone) -> "view-transition-name: none;"
+func ViewTransitionName(value ViewTransitionNameValue) Property {
+	return Prop("view-transition-name", value)
+}
+
+// VisibilityValue is implemented by the values of the "visibility" property.
+type VisibilityValue interface {
+	ValueNode
+	visibilityValue()
+}
+
+// VisibilityKeyword represents a keyword of the "visibility" property.
+type VisibilityKeyword string
+
+func (k VisibilityKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k VisibilityKeyword) valueNode()       {}
+func (k VisibilityKeyword) visibilityValue() {}
+
+// VisibilityKeyword value constants.
+const (
+	VisibilityVisible  VisibilityKeyword = "visible"
+	VisibilityHidden   VisibilityKeyword = "hidden"
+	VisibilityCollapse VisibilityKeyword = "collapse"
+)
+
+// Visibility creates a "visibility" property.
+// Syntax: visible | hidden | collapse
+// Example: Visibility(VisibilityVisible) -> "visibility: visible;"
+func Visibility(value VisibilityValue) Property {
+	return Prop("visibility", value)
+}
+
+// WhiteSpaceValue is implemented by the values of the "white-space" property.
+type WhiteSpaceValue interface {
+	ValueNode
+	whiteSpaceValue()
+}
+
+// WhiteSpaceKeyword represents a keyword of the "white-space" property.
+type WhiteSpaceKeyword string
+
+func (k WhiteSpaceKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k WhiteSpaceKeyword) valueNode()       {}
+func (k WhiteSpaceKeyword) whiteSpaceValue() {}
+
+// WhiteSpaceKeyword value constants.
+const (
+	WhiteSpaceNormal  WhiteSpaceKeyword = "normal"
+	WhiteSpacePre     WhiteSpaceKeyword = "pre"
+	WhiteSpacePreWrap WhiteSpaceKeyword = "pre-wrap"
+	WhiteSpacePreLine WhiteSpaceKeyword = "pre-line"
+)
+
+// WhiteSpace creates a "white-space" property.
+// Syntax: normal | pre | pre-wrap | pre-line | <'white-space-collapse'> || <'text-wrap-mode'>
+// Example: WhiteSpace(WhiteSpaceNormal) -> "white-space: normal;"
+func WhiteSpace(values ...WhiteSpaceValue) Property {
+	return Prop("white-space", valueNodes(values)...)
+}
+
+// WhiteSpaceCollapseValue is implemented by the values of the "white-space-collapse" property.
+type WhiteSpaceCollapseValue interface {
+	ValueNode
+	whiteSpaceCollapseValue()
+}
+
+// WhiteSpaceCollapseKeyword represents a keyword of the "white-space-collapse" property.
+type WhiteSpaceCollapseKeyword string
+
+func (k WhiteSpaceCollapseKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k WhiteSpaceCollapseKeyword) valueNode()               {}
+func (k WhiteSpaceCollapseKeyword) whiteSpaceCollapseValue() {}
+
+// WhiteSpaceCollapseKeyword also implements the value interfaces of the shorthands of "white-space-collapse".
+func (k WhiteSpaceCollapseKeyword) whiteSpaceValue() {}
+
+// WhiteSpaceCollapseKeyword value constants.
+const (
+	WhiteSpaceCollapseCollapse       WhiteSpaceCollapseKeyword = "collapse"
+	WhiteSpaceCollapsePreserve       WhiteSpaceCollapseKeyword = "preserve"
+	WhiteSpaceCollapsePreserveBreaks WhiteSpaceCollapseKeyword = "preserve-breaks"
+	WhiteSpaceCollapsePreserveSpaces WhiteSpaceCollapseKeyword = "preserve-spaces"
+	WhiteSpaceCollapseBreakSpaces    WhiteSpaceCollapseKeyword = "break-spaces"
+)
+
+// WhiteSpaceCollapse creates a "white-space-collapse" property.
+// Syntax: collapse | preserve | preserve-breaks | preserve-spaces | break-spaces
+// Example: WhiteSpaceCollapse(WhiteSpaceCollapseCollapse) -> "white-space-collapse: collapse;"
+func WhiteSpaceCollapse(value WhiteSpaceCollapseValue) Property {
+	return Prop("white-space-collapse", value)
+}
+
+// WidowsValue is implemented by the values of the "widows" property.
+type WidowsValue interface {
+	ValueNode
+	widowsValue()
+}
+
+// Widows creates a "widows" property.
+// Syntax: <integer [1,∞]>
+// Example: Widows(Int(1)) -> "widows: 1;"
+func Widows(value WidowsValue) Property {
+	return Prop("widows", value)
+}
+
+// WillChangeValue is implemented by the values of the "will-change" property.
+type WillChangeValue interface {
+	ValueNode
+	willChangeValue()
+}
+
+// WillChangeKeyword represents a keyword of the "will-change" property.
+type WillChangeKeyword string
+
+func (k WillChangeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k WillChangeKeyword) valueNode()       {}
+func (k WillChangeKeyword) willChangeValue() {}
+
+// WillChangeKeyword value constants.
+const (
+	WillChangeScrollPosition WillChangeKeyword = "scroll-position"
+	WillChangeContents       WillChangeKeyword = "contents"
+)
+
+// WillChange creates a "will-change" property.
+// Syntax: auto | <animateable-feature>#
+// Example: WillChange(WillChangeScrollPosition) -> "will-change: scroll-position;"
+func WillChange(values ...WillChangeValue) Property {
+	return commaProp("will-change", valueNodes(values)...)
+}
+
+// WordBreakValue is implemented by the values of the "word-break" property.
+type WordBreakValue interface {
+	ValueNode
+	wordBreakValue()
+}
+
+// WordBreakKeyword represents a keyword of the "word-break" property.
+type WordBreakKeyword string
+
+func (k WordBreakKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k WordBreakKeyword) valueNode()      {}
+func (k WordBreakKeyword) wordBreakValue() {}
+
+// WordBreakKeyword value constants.
+const (
+	WordBreakNormal    WordBreakKeyword = "normal"
+	WordBreakBreakAll  WordBreakKeyword = "break-all"
+	WordBreakKeepAll   WordBreakKeyword = "keep-all"
+	WordBreakBreakWord WordBreakKeyword = "break-word"
+)
+
+// WordBreak creates a "word-break" property.
+// Syntax: normal | break-all | keep-all | break-word
+// Example: WordBreak(WordBreakNormal) -> "word-break: normal;"
+func WordBreak(value WordBreakValue) Property {
+	return Prop("word-break", value)
+}
+
+// WordSpacingValue is implemented by the values of the "word-spacing" property.
+type WordSpacingValue interface {
+	ValueNode
+	wordSpacingValue()
+}
+
+// WordSpacingKeyword represents a keyword of the "word-spacing" property.
+type WordSpacingKeyword string
+
+func (k WordSpacingKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k WordSpacingKeyword) valueNode()        {}
+func (k WordSpacingKeyword) wordSpacingValue() {}
+
+// WordSpacingKeyword value constants.
+const (
+	WordSpacingNormal WordSpacingKeyword = "normal"
+)
+
+// WordSpacing creates a "word-spacing" property.
+// Syntax: normal | <length>
+// Example: WordSpacing(WordSpacingNormal) -> "word-spacing: normal;"
+func WordSpacing(value WordSpacingValue) Property {
+	return Prop("word-spacing", value)
+}
+
+// WordWrapValue is implemented by the values of the "word-wrap" property.
+type WordWrapValue interface {
+	ValueNode
+	wordWrapValue()
+}
+
+// WordWrapKeyword represents a keyword of the "word-wrap" property.
+type WordWrapKeyword string
+
+func (k WordWrapKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k WordWrapKeyword) valueNode()     {}
+func (k WordWrapKeyword) wordWrapValue() {}
+
+// WordWrapKeyword value constants.
+const (
+	WordWrapNormal    WordWrapKeyword = "normal"
+	WordWrapBreakWord WordWrapKeyword = "break-word"
+)
+
+// WordWrap creates a "word-wrap" property.
+// Syntax: normal | break-word
+// Example: WordWrap(WordWrapNormal) -> "word-wrap: normal;"
+func WordWrap(value WordWrapValue) Property {
+	return Prop("word-wrap", value)
+}
+
+// WritingModeValue is implemented by the values of the "writing-mode" property.
+type WritingModeValue interface {
+	ValueNode
+	writingModeValue()
+}
+
+// WritingModeKeyword represents a keyword of the "writing-mode" property.
+type WritingModeKeyword string
+
+func (k WritingModeKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k WritingModeKeyword) valueNode()        {}
+func (k WritingModeKeyword) writingModeValue() {}
+
+// WritingModeKeyword value constants.
+const (
+	WritingModeHorizontalTb WritingModeKeyword = "horizontal-tb"
+	WritingModeVerticalRl   WritingModeKeyword = "vertical-rl"
+	WritingModeVerticalLr   WritingModeKeyword = "vertical-lr"
+	WritingModeSidewaysRl   WritingModeKeyword = "sideways-rl"
+	WritingModeSidewaysLr   WritingModeKeyword = "sideways-lr"
+)
+
+// WritingMode creates a "writing-mode" property.
+// Syntax: horizontal-tb | vertical-rl | vertical-lr | sideways-rl | sideways-lr
+// Example: WritingMode(WritingModeHorizontalTb) -> "writing-mode: horizontal-tb;"
+func WritingMode(value WritingModeValue) Property {
+	return Prop("writing-mode", value)
+}
+
+// XValue is implemented by the values of the "x" property.
+type XValue interface {
+	ValueNode
+	xValue()
+}
+
+// X creates a "x" property.
+// Syntax: <length> | <percentage>
+// Example: X(PX(1)) -> "x: 1px;"
+func X(value XValue) Property {
+	return Prop("x", value)
+}
+
+// YValue is implemented by the values of the "y" property.
+type YValue interface {
+	ValueNode
+	yValue()
+}
+
+// Y creates a "y" property.
+// Syntax: <length> | <percentage>
+// Example: Y(PX(1)) -> "y: 1px;"
+func Y(value YValue) Property {
+	return Prop("y", value)
+}
+
+// ZoomValue is implemented by the values of the "zoom" property.
+type ZoomValue interface {
+	ValueNode
+	zoomValue()
+}
+
+// ZoomKeyword represents a keyword of the "zoom" property.
+type ZoomKeyword string
+
+func (k ZoomKeyword) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(k))
+	return err
+}
+
+func (k ZoomKeyword) valueNode() {}
+func (k ZoomKeyword) zoomValue() {}
+
+// ZoomKeyword value constants.
+const (
+	ZoomNormal ZoomKeyword = "normal"
+	ZoomReset  ZoomKeyword = "reset"
+)
+
+// Zoom creates a "zoom" property.
+// Syntax: normal | reset | <number [0,∞]> | <percentage [0,∞]>
+// Example: Zoom(ZoomNormal) -> "zoom: normal;"
+func Zoom(value ZoomValue) Property {
+	return Prop("zoom", value)
+}
+
+func (g GlobalType) alignContentValue()               {}
+func (g GlobalType) alignItemsValue()                 {}
+func (g GlobalType) alignSelfValue()                  {}
+func (g GlobalType) alignmentBaselineValue()          {}
+func (g GlobalType) allValue()                        {}
+func (g GlobalType) anchorNameValue()                 {}
+func (g GlobalType) anchorScopeValue()                {}
+func (g GlobalType) animationValue()                  {}
+func (g GlobalType) animationCompositionValue()       {}
+func (g GlobalType) animationDelayValue()             {}
+func (g GlobalType) animationDirectionValue()         {}
+func (g GlobalType) animationDurationValue()          {}
+func (g GlobalType) animationFillModeValue()          {}
+func (g GlobalType) animationIterationCountValue()    {}
+func (g GlobalType) animationNameValue()              {}
+func (g GlobalType) animationPlayStateValue()         {}
+func (g GlobalType) animationRangeValue()             {}
+func (g GlobalType) animationRangeEndValue()          {}
+func (g GlobalType) animationRangeStartValue()        {}
+func (g GlobalType) animationTimelineValue()          {}
+func (g GlobalType) animationTimingFunctionValue()    {}
+func (g GlobalType) appearanceValue()                 {}
+func (g GlobalType) aspectRatioValue()                {}
+func (g GlobalType) backdropFilterValue()             {}
+func (g GlobalType) backfaceVisibilityValue()         {}
+func (g GlobalType) backgroundValue()                 {}
+func (g GlobalType) backgroundAttachmentValue()       {}
+func (g GlobalType) backgroundBlendModeValue()        {}
+func (g GlobalType) backgroundClipValue()             {}
+func (g GlobalType) backgroundOriginValue()           {}
+func (g GlobalType) backgroundPositionValue()         {}
+func (g GlobalType) backgroundPositionXValue()        {}
+func (g GlobalType) backgroundPositionYValue()        {}
+func (g GlobalType) backgroundRepeatValue()           {}
+func (g GlobalType) backgroundSizeValue()             {}
+func (g GlobalType) baselineShiftValue()              {}
+func (g GlobalType) baselineSourceValue()             {}
+func (g GlobalType) blockSizeValue()                  {}
+func (g GlobalType) borderBlockValue()                {}
+func (g GlobalType) borderBlockColorValue()           {}
+func (g GlobalType) borderBlockEndValue()             {}
+func (g GlobalType) borderBlockEndColorValue()        {}
+func (g GlobalType) borderBlockEndStyleValue()        {}
+func (g GlobalType) borderBlockEndWidthValue()        {}
+func (g GlobalType) borderBlockStartValue()           {}
+func (g GlobalType) borderBlockStartColorValue()      {}
+func (g GlobalType) borderBlockStartStyleValue()      {}
+func (g GlobalType) borderBlockStartWidthValue()      {}
+func (g GlobalType) borderBlockStyleValue()           {}
+func (g GlobalType) borderBlockWidthValue()           {}
+func (g GlobalType) borderBottomValue()               {}
+func (g GlobalType) borderBottomColorValue()          {}
+func (g GlobalType) borderBottomStyleValue()          {}
+func (g GlobalType) borderCollapseValue()             {}
+func (g GlobalType) borderEndEndRadiusValue()         {}
+func (g GlobalType) borderEndStartRadiusValue()       {}
+func (g GlobalType) borderImageValue()                {}
+func (g GlobalType) borderImageOutsetValue()          {}
+func (g GlobalType) borderImageRepeatValue()          {}
+func (g GlobalType) borderImageSliceValue()           {}
+func (g GlobalType) borderImageSourceValue()          {}
+func (g GlobalType) borderImageWidthValue()           {}
+func (g GlobalType) borderInlineValue()               {}
+func (g GlobalType) borderInlineColorValue()          {}
+func (g GlobalType) borderInlineEndValue()            {}
+func (g GlobalType) borderInlineEndColorValue()       {}
+func (g GlobalType) borderInlineEndStyleValue()       {}
+func (g GlobalType) borderInlineEndWidthValue()       {}
+func (g GlobalType) borderInlineStartValue()          {}
+func (g GlobalType) borderInlineStartColorValue()     {}
+func (g GlobalType) borderInlineStartStyleValue()     {}
+func (g GlobalType) borderInlineStartWidthValue()     {}
+func (g GlobalType) borderInlineStyleValue()          {}
+func (g GlobalType) borderInlineWidthValue()          {}
+func (g GlobalType) borderLeftValue()                 {}
+func (g GlobalType) borderLeftColorValue()            {}
+func (g GlobalType) borderLeftStyleValue()            {}
+func (g GlobalType) borderRightValue()                {}
+func (g GlobalType) borderRightColorValue()           {}
+func (g GlobalType) borderRightStyleValue()           {}
+func (g GlobalType) borderSpacingValue()              {}
+func (g GlobalType) borderStartEndRadiusValue()       {}
+func (g GlobalType) borderStartStartRadiusValue()     {}
+func (g GlobalType) borderTopValue()                  {}
+func (g GlobalType) borderTopColorValue()             {}
+func (g GlobalType) borderTopStyleValue()             {}
+func (g GlobalType) bottomValue()                     {}
+func (g GlobalType) boxDecorationBreakValue()         {}
+func (g GlobalType) boxShadowValue()                  {}
+func (g GlobalType) boxSizingValue()                  {}
+func (g GlobalType) breakAfterValue()                 {}
+func (g GlobalType) breakBeforeValue()                {}
+func (g GlobalType) breakInsideValue()                {}
+func (g GlobalType) captionSideValue()                {}
+func (g GlobalType) caretValue()                      {}
+func (g GlobalType) caretColorValue()                 {}
+func (g GlobalType) caretShapeValue()                 {}
+func (g GlobalType) clearValue()                      {}
+func (g GlobalType) clipPathValue()                   {}
+func (g GlobalType) clipRuleValue()                   {}
+func (g GlobalType) colorInterpolationValue()         {}
+func (g GlobalType) colorInterpolationFiltersValue()  {}
+func (g GlobalType) columnCountValue()                {}
+func (g GlobalType) columnFillValue()                 {}
+func (g GlobalType) columnGapValue()                  {}
+func (g GlobalType) columnRuleValue()                 {}
+func (g GlobalType) columnRuleColorValue()            {}
+func (g GlobalType) columnRuleStyleValue()            {}
+func (g GlobalType) columnRuleWidthValue()            {}
+func (g GlobalType) columnSpanValue()                 {}
+func (g GlobalType) columnWidthValue()                {}
+func (g GlobalType) columnsValue()                    {}
+func (g GlobalType) containValue()                    {}
+func (g GlobalType) containIntrinsicBlockSizeValue()  {}
+func (g GlobalType) containIntrinsicHeightValue()     {}
+func (g GlobalType) containIntrinsicInlineSizeValue() {}
+func (g GlobalType) containIntrinsicSizeValue()       {}
+func (g GlobalType) containIntrinsicWidthValue()      {}
+func (g GlobalType) containerValue()                  {}
+func (g GlobalType) containerNameValue()              {}
+func (g GlobalType) containerTypeValue()              {}
+func (g GlobalType) contentVisibilityValue()          {}
+func (g GlobalType) counterIncrementValue()           {}
+func (g GlobalType) counterResetValue()               {}
+func (g GlobalType) counterSetValue()                 {}
+func (g GlobalType) cursorValue()                     {}
+func (g GlobalType) cxValue()                         {}
+func (g GlobalType) cyValue()                         {}
+func (g GlobalType) dValue()                          {}
+func (g GlobalType) directionValue()                  {}
+func (g GlobalType) dominantBaselineValue()           {}
+func (g GlobalType) dynamicRangeLimitValue()          {}
+func (g GlobalType) emptyCellsValue()                 {}
+func (g GlobalType) fieldSizingValue()                {}
+func (g GlobalType) fillValue()                       {}
+func (g GlobalType) fillOpacityValue()                {}
+func (g GlobalType) fillRuleValue()                   {}
+func (g GlobalType) filterValue()                     {}
+func (g GlobalType) flexValue()                       {}
+func (g GlobalType) flexBasisValue()                  {}
+func (g GlobalType) flexFlowValue()                   {}
+func (g GlobalType) flexGrowValue()                   {}
+func (g GlobalType) flexShrinkValue()                 {}
+func (g GlobalType) flexWrapValue()                   {}
+func (g GlobalType) floatValue()                      {}
+func (g GlobalType) floodColorValue()                 {}
+func (g GlobalType) floodOpacityValue()               {}
+func (g GlobalType) fontValue()                       {}
+func (g GlobalType) fontFeatureSettingsValue()        {}
+func (g GlobalType) fontKerningValue()                {}
+func (g GlobalType) fontLanguageOverrideValue()       {}
+func (g GlobalType) fontOpticalSizingValue()          {}
+func (g GlobalType) fontPaletteValue()                {}
+func (g GlobalType) fontSizeAdjustValue()             {}
+func (g GlobalType) fontStretchValue()                {}
+func (g GlobalType) fontStyleValue()                  {}
+func (g GlobalType) fontSynthesisValue()              {}
+func (g GlobalType) fontSynthesisPositionValue()      {}
+func (g GlobalType) fontSynthesisSmallCapsValue()     {}
+func (g GlobalType) fontSynthesisStyleValue()         {}
+func (g GlobalType) fontSynthesisWeightValue()        {}
+func (g GlobalType) fontVariantValue()                {}
+func (g GlobalType) fontVariantAlternatesValue()      {}
+func (g GlobalType) fontVariantCapsValue()            {}
+func (g GlobalType) fontVariantEastAsianValue()       {}
+func (g GlobalType) fontVariantEmojiValue()           {}
+func (g GlobalType) fontVariantLigaturesValue()       {}
+func (g GlobalType) fontVariantNumericValue()         {}
+func (g GlobalType) fontVariantPositionValue()        {}
+func (g GlobalType) fontVariationSettingsValue()      {}
+func (g GlobalType) fontWeightValue()                 {}
+func (g GlobalType) forcedColorAdjustValue()          {}
+func (g GlobalType) gapValue()                        {}
+func (g GlobalType) gridValue()                       {}
+func (g GlobalType) gridAreaValue()                   {}
+func (g GlobalType) gridAutoColumnsValue()            {}
+func (g GlobalType) gridAutoFlowValue()               {}
+func (g GlobalType) gridAutoRowsValue()               {}
+func (g GlobalType) gridColumnValue()                 {}
+func (g GlobalType) gridColumnEndValue()              {}
+func (g GlobalType) gridColumnStartValue()            {}
+func (g GlobalType) gridRowValue()                    {}
+func (g GlobalType) gridRowEndValue()                 {}
+func (g GlobalType) gridRowStartValue()               {}
+func (g GlobalType) gridTemplateValue()               {}
+func (g GlobalType) gridTemplateAreasValue()          {}
+func (g GlobalType) gridTemplateColumnsValue()        {}
+func (g GlobalType) gridTemplateRowsValue()           {}
+func (g GlobalType) hangingPunctuationValue()         {}
+func (g GlobalType) hyphenateCharacterValue()         {}
+func (g GlobalType) hyphenateLimitCharsValue()        {}
+func (g GlobalType) hyphensValue()                    {}
+func (g GlobalType) imageOrientationValue()           {}
+func (g GlobalType) imageRenderingValue()             {}
+func (g GlobalType) initialLetterValue()              {}
+func (g GlobalType) inlineSizeValue()                 {}
+func (g GlobalType) insetValue()                      {}
+func (g GlobalType) insetBlockValue()                 {}
+func (g GlobalType) insetBlockEndValue()              {}
+func (g GlobalType) insetBlockStartValue()            {}
+func (g GlobalType) insetInlineValue()                {}
+func (g GlobalType) insetInlineEndValue()             {}
+func (g GlobalType) insetInlineStartValue()           {}
+func (g GlobalType) interpolateSizeValue()            {}
+func (g GlobalType) isolationValue()                  {}
+func (g GlobalType) justifyContentValue()             {}
+func (g GlobalType) justifyItemsValue()               {}
+func (g GlobalType) justifySelfValue()                {}
+func (g GlobalType) leftValue()                       {}
+func (g GlobalType) lightingColorValue()              {}
+func (g GlobalType) lineBreakValue()                  {}
+func (g GlobalType) lineClampValue()                  {}
+func (g GlobalType) listStyleValue()                  {}
+func (g GlobalType) listStyleImageValue()             {}
+func (g GlobalType) listStylePositionValue()          {}
+func (g GlobalType) listStyleTypeValue()              {}
+func (g GlobalType) marginBlockValue()                {}
+func (g GlobalType) marginBlockEndValue()             {}
+func (g GlobalType) marginBlockStartValue()           {}
+func (g GlobalType) marginBottomValue()               {}
+func (g GlobalType) marginInlineValue()               {}
+func (g GlobalType) marginInlineEndValue()            {}
+func (g GlobalType) marginInlineStartValue()          {}
+func (g GlobalType) marginLeftValue()                 {}
+func (g GlobalType) marginRightValue()                {}
+func (g GlobalType) marginTopValue()                  {}
+func (g GlobalType) marginTrimValue()                 {}
+func (g GlobalType) markerValue()                     {}
+func (g GlobalType) markerEndValue()                  {}
+func (g GlobalType) markerMidValue()                  {}
+func (g GlobalType) markerStartValue()                {}
+func (g GlobalType) maskValue()                       {}
+func (g GlobalType) maskBorderValue()                 {}
+func (g GlobalType) maskBorderModeValue()             {}
+func (g GlobalType) maskBorderOutsetValue()           {}
+func (g GlobalType) maskBorderRepeatValue()           {}
+func (g GlobalType) maskBorderSliceValue()            {}
+func (g GlobalType) maskBorderSourceValue()           {}
+func (g GlobalType) maskBorderWidthValue()            {}
+func (g GlobalType) maskClipValue()                   {}
+func (g GlobalType) maskCompositeValue()              {}
+func (g GlobalType) maskImageValue()                  {}
+func (g GlobalType) maskModeValue()                   {}
+func (g GlobalType) maskOriginValue()                 {}
+func (g GlobalType) maskPositionValue()               {}
+func (g GlobalType) maskRepeatValue()                 {}
+func (g GlobalType) maskSizeValue()                   {}
+func (g GlobalType) maskTypeValue()                   {}
+func (g GlobalType) mathDepthValue()                  {}
+func (g GlobalType) mathShiftValue()                  {}
+func (g GlobalType) mathStyleValue()                  {}
+func (g GlobalType) maxBlockSizeValue()               {}
+func (g GlobalType) maxHeightValue()                  {}
+func (g GlobalType) maxInlineSizeValue()              {}
+func (g GlobalType) maxWidthValue()                   {}
+func (g GlobalType) minBlockSizeValue()               {}
+func (g GlobalType) minHeightValue()                  {}
+func (g GlobalType) minInlineSizeValue()              {}
+func (g GlobalType) minWidthValue()                   {}
+func (g GlobalType) mixBlendModeValue()               {}
+func (g GlobalType) objectFitValue()                  {}
+func (g GlobalType) objectPositionValue()             {}
+func (g GlobalType) objectViewBoxValue()              {}
+func (g GlobalType) offsetValue()                     {}
+func (g GlobalType) offsetAnchorValue()               {}
+func (g GlobalType) offsetDistanceValue()             {}
+func (g GlobalType) offsetPathValue()                 {}
+func (g GlobalType) offsetPositionValue()             {}
+func (g GlobalType) offsetRotateValue()               {}
+func (g GlobalType) opacityValue()                    {}
+func (g GlobalType) orderValue()                      {}
+func (g GlobalType) orphansValue()                    {}
+func (g GlobalType) outlineValue()                    {}
+func (g GlobalType) outlineColorValue()               {}
+func (g GlobalType) outlineOffsetValue()              {}
+func (g GlobalType) outlineStyleValue()               {}
+func (g GlobalType) outlineWidthValue()               {}
+func (g GlobalType) overflowValue()                   {}
+func (g GlobalType) overflowAnchorValue()             {}
+func (g GlobalType) overflowBlockValue()              {}
+func (g GlobalType) overflowClipMarginValue()         {}
+func (g GlobalType) overflowInlineValue()             {}
+func (g GlobalType) overflowWrapValue()               {}
+func (g GlobalType) overflowXValue()                  {}
+func (g GlobalType) overflowYValue()                  {}
+func (g GlobalType) overlayValue()                    {}
+func (g GlobalType) overscrollBehaviorValue()         {}
+func (g GlobalType) overscrollBehaviorBlockValue()    {}
+func (g GlobalType) overscrollBehaviorInlineValue()   {}
+func (g GlobalType) overscrollBehaviorXValue()        {}
+func (g GlobalType) overscrollBehaviorYValue()        {}
+func (g GlobalType) paddingBlockValue()               {}
+func (g GlobalType) paddingBlockEndValue()            {}
+func (g GlobalType) paddingBlockStartValue()          {}
+func (g GlobalType) paddingInlineValue()              {}
+func (g GlobalType) paddingInlineEndValue()           {}
+func (g GlobalType) paddingInlineStartValue()         {}
+func (g GlobalType) pageValue()                       {}
+func (g GlobalType) pageBreakAfterValue()             {}
+func (g GlobalType) pageBreakBeforeValue()            {}
+func (g GlobalType) pageBreakInsideValue()            {}
+func (g GlobalType) paintOrderValue()                 {}
+func (g GlobalType) perspectiveValue()                {}
+func (g GlobalType) perspectiveOriginValue()          {}
+func (g GlobalType) placeContentValue()               {}
+func (g GlobalType) placeItemsValue()                 {}
+func (g GlobalType) placeSelfValue()                  {}
+func (g GlobalType) pointerEventsValue()              {}
+func (g GlobalType) positionValue()                   {}
+func (g GlobalType) positionAnchorValue()             {}
+func (g GlobalType) positionAreaValue()               {}
+func (g GlobalType) positionTryValue()                {}
+func (g GlobalType) positionTryFallbacksValue()       {}
+func (g GlobalType) positionTryOrderValue()           {}
+func (g GlobalType) positionVisibilityValue()         {}
+func (g GlobalType) printColorAdjustValue()           {}
+func (g GlobalType) quotesValue()                     {}
+func (g GlobalType) rValue()                          {}
+func (g GlobalType) readingFlowValue()                {}
+func (g GlobalType) resizeValue()                     {}
+func (g GlobalType) rightValue()                      {}
+func (g GlobalType) rotateValue()                     {}
+func (g GlobalType) rowGapValue()                     {}
+func (g GlobalType) rubyAlignValue()                  {}
+func (g GlobalType) rubyMergeValue()                  {}
+func (g GlobalType) rubyPositionValue()               {}
+func (g GlobalType) rxValue()                         {}
+func (g GlobalType) ryValue()                         {}
+func (g GlobalType) scaleValue()                      {}
+func (g GlobalType) scrollBehaviorValue()             {}
+func (g GlobalType) scrollInitialTargetValue()        {}
+func (g GlobalType) scrollMarginValue()               {}
+func (g GlobalType) scrollMarginBlockValue()          {}
+func (g GlobalType) scrollMarginBlockEndValue()       {}
+func (g GlobalType) scrollMarginBlockStartValue()     {}
+func (g GlobalType) scrollMarginBottomValue()         {}
+func (g GlobalType) scrollMarginInlineValue()         {}
+func (g GlobalType) scrollMarginInlineEndValue()      {}
+func (g GlobalType) scrollMarginInlineStartValue()    {}
+func (g GlobalType) scrollMarginLeftValue()           {}
+func (g GlobalType) scrollMarginRightValue()          {}
+func (g GlobalType) scrollMarginTopValue()            {}
+func (g GlobalType) scrollMarkerGroupValue()          {}
+func (g GlobalType) scrollPaddingValue()              {}
+func (g GlobalType) scrollPaddingBlockValue()         {}
+func (g GlobalType) scrollPaddingBlockEndValue()      {}
+func (g GlobalType) scrollPaddingBlockStartValue()    {}
+func (g GlobalType) scrollPaddingBottomValue()        {}
+func (g GlobalType) scrollPaddingInlineValue()        {}
+func (g GlobalType) scrollPaddingInlineEndValue()     {}
+func (g GlobalType) scrollPaddingInlineStartValue()   {}
+func (g GlobalType) scrollPaddingLeftValue()          {}
+func (g GlobalType) scrollPaddingRightValue()         {}
+func (g GlobalType) scrollPaddingTopValue()           {}
+func (g GlobalType) scrollSnapAlignValue()            {}
+func (g GlobalType) scrollSnapStopValue()             {}
+func (g GlobalType) scrollSnapTypeValue()             {}
+func (g GlobalType) scrollTimelineValue()             {}
+func (g GlobalType) scrollTimelineAxisValue()         {}
+func (g GlobalType) scrollTimelineNameValue()         {}
+func (g GlobalType) scrollbarColorValue()             {}
+func (g GlobalType) scrollbarGutterValue()            {}
+func (g GlobalType) scrollbarWidthValue()             {}
+func (g GlobalType) shapeImageThresholdValue()        {}
+func (g GlobalType) shapeMarginValue()                {}
+func (g GlobalType) shapeOutsideValue()               {}
+func (g GlobalType) shapeRenderingValue()             {}
+func (g GlobalType) speakAsValue()                    {}
+func (g GlobalType) stopColorValue()                  {}
+func (g GlobalType) stopOpacityValue()                {}
+func (g GlobalType) strokeValue()                     {}
+func (g GlobalType) strokeDasharrayValue()            {}
+func (g GlobalType) strokeDashoffsetValue()           {}
+func (g GlobalType) strokeLinecapValue()              {}
+func (g GlobalType) strokeLinejoinValue()             {}
+func (g GlobalType) strokeMiterlimitValue()           {}
+func (g GlobalType) strokeOpacityValue()              {}
+func (g GlobalType) strokeWidthValue()                {}
+func (g GlobalType) tabSizeValue()                    {}
+func (g GlobalType) tableLayoutValue()                {}
+func (g GlobalType) textAlignValue()                  {}
+func (g GlobalType) textAlignLastValue()              {}
+func (g GlobalType) textAnchorValue()                 {}
+func (g GlobalType) textAutospaceValue()              {}
+func (g GlobalType) textBoxValue()                    {}
+func (g GlobalType) textBoxEdgeValue()                {}
+func (g GlobalType) textBoxTrimValue()                {}
+func (g GlobalType) textCombineUprightValue()         {}
+func (g GlobalType) textDecorationValue()             {}
+func (g GlobalType) textDecorationColorValue()        {}
+func (g GlobalType) textDecorationLineValue()         {}
+func (g GlobalType) textDecorationSkipInkValue()      {}
+func (g GlobalType) textDecorationStyleValue()        {}
+func (g GlobalType) textDecorationThicknessValue()    {}
+func (g GlobalType) textEmphasisValue()               {}
+func (g GlobalType) textEmphasisColorValue()          {}
+func (g GlobalType) textEmphasisPositionValue()       {}
+func (g GlobalType) textEmphasisStyleValue()          {}
+func (g GlobalType) textIndentValue()                 {}
+func (g GlobalType) textJustifyValue()                {}
+func (g GlobalType) textOrientationValue()            {}
+func (g GlobalType) textOverflowValue()               {}
+func (g GlobalType) textRenderingValue()              {}
+func (g GlobalType) textShadowValue()                 {}
+func (g GlobalType) textSizeAdjustValue()             {}
+func (g GlobalType) textSpacingTrimValue()            {}
+func (g GlobalType) textTransformValue()              {}
+func (g GlobalType) textUnderlineOffsetValue()        {}
+func (g GlobalType) textUnderlinePositionValue()      {}
+func (g GlobalType) textWrapValue()                   {}
+func (g GlobalType) textWrapModeValue()               {}
+func (g GlobalType) textWrapStyleValue()              {}
+func (g GlobalType) timelineScopeValue()              {}
+func (g GlobalType) topValue()                        {}
+func (g GlobalType) touchActionValue()                {}
+func (g GlobalType) transformValue()                  {}
+func (g GlobalType) transformBoxValue()               {}
+func (g GlobalType) transformOriginValue()            {}
+func (g GlobalType) transformStyleValue()             {}
+func (g GlobalType) transitionValue()                 {}
+func (g GlobalType) transitionBehaviorValue()         {}
+func (g GlobalType) transitionDelayValue()            {}
+func (g GlobalType) transitionDurationValue()         {}
+func (g GlobalType) transitionPropertyValue()         {}
+func (g GlobalType) transitionTimingFunctionValue()   {}
+func (g GlobalType) translateValue()                  {}
+func (g GlobalType) unicodeBidiValue()                {}
+func (g GlobalType) userSelectValue()                 {}
+func (g GlobalType) vectorEffectValue()               {}
+func (g GlobalType) verticalAlignValue()              {}
+func (g GlobalType) viewTimelineValue()               {}
+func (g GlobalType) viewTimelineAxisValue()           {}
+func (g GlobalType) viewTimelineInsetValue()          {}
+func (g GlobalType) viewTimelineNameValue()           {}
+func (g GlobalType) viewTransitionClassValue()        {}
+func (g GlobalType) viewTransitionNameValue()         {}
+func (g GlobalType) visibilityValue()                 {}
+func (g GlobalType) whiteSpaceValue()                 {}
+func (g GlobalType) whiteSpaceCollapseValue()         {}
+func (g GlobalType) widowsValue()                     {}
+func (g GlobalType) willChangeValue()                 {}
+func (g GlobalType) wordBreakValue()                  {}
+func (g GlobalType) wordSpacingValue()                {}
+func (g GlobalType) wordWrapValue()                   {}
+func (g GlobalType) writingModeValue()                {}
+func (g GlobalType) xValue()                          {}
+func (g GlobalType) yValue()                          {}
+func (g GlobalType) zoomValue()                       {}
+
+func (v VarType) alignContentValue()               {}
+func (v VarType) alignItemsValue()                 {}
+func (v VarType) alignSelfValue()                  {}
+func (v VarType) alignmentBaselineValue()          {}
+func (v VarType) allValue()                        {}
+func (v VarType) anchorNameValue()                 {}
+func (v VarType) anchorScopeValue()                {}
+func (v VarType) animationValue()                  {}
+func (v VarType) animationCompositionValue()       {}
+func (v VarType) animationDelayValue()             {}
+func (v VarType) animationDirectionValue()         {}
+func (v VarType) animationDurationValue()          {}
+func (v VarType) animationFillModeValue()          {}
+func (v VarType) animationIterationCountValue()    {}
+func (v VarType) animationNameValue()              {}
+func (v VarType) animationPlayStateValue()         {}
+func (v VarType) animationRangeValue()             {}
+func (v VarType) animationRangeEndValue()          {}
+func (v VarType) animationRangeStartValue()        {}
+func (v VarType) animationTimelineValue()          {}
+func (v VarType) animationTimingFunctionValue()    {}
+func (v VarType) appearanceValue()                 {}
+func (v VarType) aspectRatioValue()                {}
+func (v VarType) backdropFilterValue()             {}
+func (v VarType) backfaceVisibilityValue()         {}
+func (v VarType) backgroundValue()                 {}
+func (v VarType) backgroundAttachmentValue()       {}
+func (v VarType) backgroundBlendModeValue()        {}
+func (v VarType) backgroundClipValue()             {}
+func (v VarType) backgroundOriginValue()           {}
+func (v VarType) backgroundPositionValue()         {}
+func (v VarType) backgroundPositionXValue()        {}
+func (v VarType) backgroundPositionYValue()        {}
+func (v VarType) backgroundRepeatValue()           {}
+func (v VarType) backgroundSizeValue()             {}
+func (v VarType) baselineShiftValue()              {}
+func (v VarType) baselineSourceValue()             {}
+func (v VarType) blockSizeValue()                  {}
+func (v VarType) borderBlockValue()                {}
+func (v VarType) borderBlockColorValue()           {}
+func (v VarType) borderBlockEndValue()             {}
+func (v VarType) borderBlockEndColorValue()        {}
+func (v VarType) borderBlockEndStyleValue()        {}
+func (v VarType) borderBlockEndWidthValue()        {}
+func (v VarType) borderBlockStartValue()           {}
+func (v VarType) borderBlockStartColorValue()      {}
+func (v VarType) borderBlockStartStyleValue()      {}
+func (v VarType) borderBlockStartWidthValue()      {}
+func (v VarType) borderBlockStyleValue()           {}
+func (v VarType) borderBlockWidthValue()           {}
+func (v VarType) borderBottomValue()               {}
+func (v VarType) borderBottomColorValue()          {}
+func (v VarType) borderBottomStyleValue()          {}
+func (v VarType) borderCollapseValue()             {}
+func (v VarType) borderEndEndRadiusValue()         {}
+func (v VarType) borderEndStartRadiusValue()       {}
+func (v VarType) borderImageValue()                {}
+func (v VarType) borderImageOutsetValue()          {}
+func (v VarType) borderImageRepeatValue()          {}
+func (v VarType) borderImageSliceValue()           {}
+func (v VarType) borderImageSourceValue()          {}
+func (v VarType) borderImageWidthValue()           {}
+func (v VarType) borderInlineValue()               {}
+func (v VarType) borderInlineColorValue()          {}
+func (v VarType) borderInlineEndValue()            {}
+func (v VarType) borderInlineEndColorValue()       {}
+func (v VarType) borderInlineEndStyleValue()       {}
+func (v VarType) borderInlineEndWidthValue()       {}
+func (v VarType) borderInlineStartValue()          {}
+func (v VarType) borderInlineStartColorValue()     {}
+func (v VarType) borderInlineStartStyleValue()     {}
+func (v VarType) borderInlineStartWidthValue()     {}
+func (v VarType) borderInlineStyleValue()          {}
+func (v VarType) borderInlineWidthValue()          {}
+func (v VarType) borderLeftValue()                 {}
+func (v VarType) borderLeftColorValue()            {}
+func (v VarType) borderLeftStyleValue()            {}
+func (v VarType) borderRightValue()                {}
+func (v VarType) borderRightColorValue()           {}
+func (v VarType) borderRightStyleValue()           {}
+func (v VarType) borderSpacingValue()              {}
+func (v VarType) borderStartEndRadiusValue()       {}
+func (v VarType) borderStartStartRadiusValue()     {}
+func (v VarType) borderTopValue()                  {}
+func (v VarType) borderTopColorValue()             {}
+func (v VarType) borderTopStyleValue()             {}
+func (v VarType) bottomValue()                     {}
+func (v VarType) boxDecorationBreakValue()         {}
+func (v VarType) boxShadowValue()                  {}
+func (v VarType) boxSizingValue()                  {}
+func (v VarType) breakAfterValue()                 {}
+func (v VarType) breakBeforeValue()                {}
+func (v VarType) breakInsideValue()                {}
+func (v VarType) captionSideValue()                {}
+func (v VarType) caretValue()                      {}
+func (v VarType) caretColorValue()                 {}
+func (v VarType) caretShapeValue()                 {}
+func (v VarType) clearValue()                      {}
+func (v VarType) clipPathValue()                   {}
+func (v VarType) clipRuleValue()                   {}
+func (v VarType) colorInterpolationValue()         {}
+func (v VarType) colorInterpolationFiltersValue()  {}
+func (v VarType) columnCountValue()                {}
+func (v VarType) columnFillValue()                 {}
+func (v VarType) columnGapValue()                  {}
+func (v VarType) columnRuleValue()                 {}
+func (v VarType) columnRuleColorValue()            {}
+func (v VarType) columnRuleStyleValue()            {}
+func (v VarType) columnRuleWidthValue()            {}
+func (v VarType) columnSpanValue()                 {}
+func (v VarType) columnWidthValue()                {}
+func (v VarType) columnsValue()                    {}
+func (v VarType) containValue()                    {}
+func (v VarType) containIntrinsicBlockSizeValue()  {}
+func (v VarType) containIntrinsicHeightValue()     {}
+func (v VarType) containIntrinsicInlineSizeValue() {}
+func (v VarType) containIntrinsicSizeValue()       {}
+func (v VarType) containIntrinsicWidthValue()      {}
+func (v VarType) containerValue()                  {}
+func (v VarType) containerNameValue()              {}
+func (v VarType) containerTypeValue()              {}
+func (v VarType) contentVisibilityValue()          {}
+func (v VarType) counterIncrementValue()           {}
+func (v VarType) counterResetValue()               {}
+func (v VarType) counterSetValue()                 {}
+func (v VarType) cursorValue()                     {}
+func (v VarType) cxValue()                         {}
+func (v VarType) cyValue()                         {}
+func (v VarType) dValue()                          {}
+func (v VarType) directionValue()                  {}
+func (v VarType) dominantBaselineValue()           {}
+func (v VarType) dynamicRangeLimitValue()          {}
+func (v VarType) emptyCellsValue()                 {}
+func (v VarType) fieldSizingValue()                {}
+func (v VarType) fillValue()                       {}
+func (v VarType) fillOpacityValue()                {}
+func (v VarType) fillRuleValue()                   {}
+func (v VarType) filterValue()                     {}
+func (v VarType) flexValue()                       {}
+func (v VarType) flexBasisValue()                  {}
+func (v VarType) flexFlowValue()                   {}
+func (v VarType) flexGrowValue()                   {}
+func (v VarType) flexShrinkValue()                 {}
+func (v VarType) flexWrapValue()                   {}
+func (v VarType) floatValue()                      {}
+func (v VarType) floodColorValue()                 {}
+func (v VarType) floodOpacityValue()               {}
+func (v VarType) fontValue()                       {}
+func (v VarType) fontFeatureSettingsValue()        {}
+func (v VarType) fontKerningValue()                {}
+func (v VarType) fontLanguageOverrideValue()       {}
+func (v VarType) fontOpticalSizingValue()          {}
+func (v VarType) fontPaletteValue()                {}
+func (v VarType) fontSizeAdjustValue()             {}
+func (v VarType) fontStretchValue()                {}
+func (v VarType) fontStyleValue()                  {}
+func (v VarType) fontSynthesisValue()              {}
+func (v VarType) fontSynthesisPositionValue()      {}
+func (v VarType) fontSynthesisSmallCapsValue()     {}
+func (v VarType) fontSynthesisStyleValue()         {}
+func (v VarType) fontSynthesisWeightValue()        {}
+func (v VarType) fontVariantValue()                {}
+func (v VarType) fontVariantAlternatesValue()      {}
+func (v VarType) fontVariantCapsValue()            {}
+func (v VarType) fontVariantEastAsianValue()       {}
+func (v VarType) fontVariantEmojiValue()           {}
+func (v VarType) fontVariantLigaturesValue()       {}
+func (v VarType) fontVariantNumericValue()         {}
+func (v VarType) fontVariantPositionValue()        {}
+func (v VarType) fontVariationSettingsValue()      {}
+func (v VarType) fontWeightValue()                 {}
+func (v VarType) forcedColorAdjustValue()          {}
+func (v VarType) gapValue()                        {}
+func (v VarType) gridValue()                       {}
+func (v VarType) gridAreaValue()                   {}
+func (v VarType) gridAutoColumnsValue()            {}
+func (v VarType) gridAutoFlowValue()               {}
+func (v VarType) gridAutoRowsValue()               {}
+func (v VarType) gridColumnValue()                 {}
+func (v VarType) gridColumnEndValue()              {}
+func (v VarType) gridColumnStartValue()            {}
+func (v VarType) gridRowValue()                    {}
+func (v VarType) gridRowEndValue()                 {}
+func (v VarType) gridRowStartValue()               {}
+func (v VarType) gridTemplateValue()               {}
+func (v VarType) gridTemplateAreasValue()          {}
+func (v VarType) gridTemplateColumnsValue()        {}
+func (v VarType) gridTemplateRowsValue()           {}
+func (v VarType) hangingPunctuationValue()         {}
+func (v VarType) hyphenateCharacterValue()         {}
+func (v VarType) hyphenateLimitCharsValue()        {}
+func (v VarType) hyphensValue()                    {}
+func (v VarType) imageOrientationValue()           {}
+func (v VarType) imageRenderingValue()             {}
+func (v VarType) initialLetterValue()              {}
+func (v VarType) inlineSizeValue()                 {}
+func (v VarType) insetValue()                      {}
+func (v VarType) insetBlockValue()                 {}
+func (v VarType) insetBlockEndValue()              {}
+func (v VarType) insetBlockStartValue()            {}
+func (v VarType) insetInlineValue()                {}
+func (v VarType) insetInlineEndValue()             {}
+func (v VarType) insetInlineStartValue()           {}
+func (v VarType) interpolateSizeValue()            {}
+func (v VarType) isolationValue()                  {}
+func (v VarType) justifyContentValue()             {}
+func (v VarType) justifyItemsValue()               {}
+func (v VarType) justifySelfValue()                {}
+func (v VarType) leftValue()                       {}
+func (v VarType) lightingColorValue()              {}
+func (v VarType) lineBreakValue()                  {}
+func (v VarType) lineClampValue()                  {}
+func (v VarType) listStyleValue()                  {}
+func (v VarType) listStyleImageValue()             {}
+func (v VarType) listStylePositionValue()          {}
+func (v VarType) listStyleTypeValue()              {}
+func (v VarType) marginBlockValue()                {}
+func (v VarType) marginBlockEndValue()             {}
+func (v VarType) marginBlockStartValue()           {}
+func (v VarType) marginBottomValue()               {}
+func (v VarType) marginInlineValue()               {}
+func (v VarType) marginInlineEndValue()            {}
+func (v VarType) marginInlineStartValue()          {}
+func (v VarType) marginLeftValue()                 {}
+func (v VarType) marginRightValue()                {}
+func (v VarType) marginTopValue()                  {}
+func (v VarType) marginTrimValue()                 {}
+func (v VarType) markerValue()                     {}
+func (v VarType) markerEndValue()                  {}
+func (v VarType) markerMidValue()                  {}
+func (v VarType) markerStartValue()                {}
+func (v VarType) maskValue()                       {}
+func (v VarType) maskBorderValue()                 {}
+func (v VarType) maskBorderModeValue()             {}
+func (v VarType) maskBorderOutsetValue()           {}
+func (v VarType) maskBorderRepeatValue()           {}
+func (v VarType) maskBorderSliceValue()            {}
+func (v VarType) maskBorderSourceValue()           {}
+func (v VarType) maskBorderWidthValue()            {}
+func (v VarType) maskClipValue()                   {}
+func (v VarType) maskCompositeValue()              {}
+func (v VarType) maskImageValue()                  {}
+func (v VarType) maskModeValue()                   {}
+func (v VarType) maskOriginValue()                 {}
+func (v VarType) maskPositionValue()               {}
+func (v VarType) maskRepeatValue()                 {}
+func (v VarType) maskSizeValue()                   {}
+func (v VarType) maskTypeValue()                   {}
+func (v VarType) mathDepthValue()                  {}
+func (v VarType) mathShiftValue()                  {}
+func (v VarType) mathStyleValue()                  {}
+func (v VarType) maxBlockSizeValue()               {}
+func (v VarType) maxHeightValue()                  {}
+func (v VarType) maxInlineSizeValue()              {}
+func (v VarType) maxWidthValue()                   {}
+func (v VarType) minBlockSizeValue()               {}
+func (v VarType) minHeightValue()                  {}
+func (v VarType) minInlineSizeValue()              {}
+func (v VarType) minWidthValue()                   {}
+func (v VarType) mixBlendModeValue()               {}
+func (v VarType) objectFitValue()                  {}
+func (v VarType) objectPositionValue()             {}
+func (v VarType) objectViewBoxValue()              {}
+func (v VarType) offsetValue()                     {}
+func (v VarType) offsetAnchorValue()               {}
+func (v VarType) offsetDistanceValue()             {}
+func (v VarType) offsetPathValue()                 {}
+func (v VarType) offsetPositionValue()             {}
+func (v VarType) offsetRotateValue()               {}
+func (v VarType) opacityValue()                    {}
+func (v VarType) orderValue()                      {}
+func (v VarType) orphansValue()                    {}
+func (v VarType) outlineValue()                    {}
+func (v VarType) outlineColorValue()               {}
+func (v VarType) outlineOffsetValue()              {}
+func (v VarType) outlineStyleValue()               {}
+func (v VarType) outlineWidthValue()               {}
+func (v VarType) overflowValue()                   {}
+func (v VarType) overflowAnchorValue()             {}
+func (v VarType) overflowBlockValue()              {}
+func (v VarType) overflowClipMarginValue()         {}
+func (v VarType) overflowInlineValue()             {}
+func (v VarType) overflowWrapValue()               {}
+func (v VarType) overflowXValue()                  {}
+func (v VarType) overflowYValue()                  {}
+func (v VarType) overlayValue()                    {}
+func (v VarType) overscrollBehaviorValue()         {}
+func (v VarType) overscrollBehaviorBlockValue()    {}
+func (v VarType) overscrollBehaviorInlineValue()   {}
+func (v VarType) overscrollBehaviorXValue()        {}
+func (v VarType) overscrollBehaviorYValue()        {}
+func (v VarType) paddingBlockValue()               {}
+func (v VarType) paddingBlockEndValue()            {}
+func (v VarType) paddingBlockStartValue()          {}
+func (v VarType) paddingInlineValue()              {}
+func (v VarType) paddingInlineEndValue()           {}
+func (v VarType) paddingInlineStartValue()         {}
+func (v VarType) pageValue()                       {}
+func (v VarType) pageBreakAfterValue()             {}
+func (v VarType) pageBreakBeforeValue()            {}
+func (v VarType) pageBreakInsideValue()            {}
+func (v VarType) paintOrderValue()                 {}
+func (v VarType) perspectiveValue()                {}
+func (v VarType) perspectiveOriginValue()          {}
+func (v VarType) placeContentValue()               {}
+func (v VarType) placeItemsValue()                 {}
+func (v VarType) placeSelfValue()                  {}
+func (v VarType) pointerEventsValue()              {}
+func (v VarType) positionValue()                   {}
+func (v VarType) positionAnchorValue()             {}
+func (v VarType) positionAreaValue()               {}
+func (v VarType) positionTryValue()                {}
+func (v VarType) positionTryFallbacksValue()       {}
+func (v VarType) positionTryOrderValue()           {}
+func (v VarType) positionVisibilityValue()         {}
+func (v VarType) printColorAdjustValue()           {}
+func (v VarType) quotesValue()                     {}
+func (v VarType) rValue()                          {}
+func (v VarType) readingFlowValue()                {}
+func (v VarType) resizeValue()                     {}
+func (v VarType) rightValue()                      {}
+func (v VarType) rotateValue()                     {}
+func (v VarType) rowGapValue()                     {}
+func (v VarType) rubyAlignValue()                  {}
+func (v VarType) rubyMergeValue()                  {}
+func (v VarType) rubyPositionValue()               {}
+func (v VarType) rxValue()                         {}
+func (v VarType) ryValue()                         {}
+func (v VarType) scaleValue()                      {}
+func (v VarType) scrollBehaviorValue()             {}
+func (v VarType) scrollInitialTargetValue()        {}
+func (v VarType) scrollMarginValue()               {}
+func (v VarType) scrollMarginBlockValue()          {}
+func (v VarType) scrollMarginBlockEndValue()       {}
+func (v VarType) scrollMarginBlockStartValue()     {}
+func (v VarType) scrollMarginBottomValue()         {}
+func (v VarType) scrollMarginInlineValue()         {}
+func (v VarType) scrollMarginInlineEndValue()      {}
+func (v VarType) scrollMarginInlineStartValue()    {}
+func (v VarType) scrollMarginLeftValue()           {}
+func (v VarType) scrollMarginRightValue()          {}
+func (v VarType) scrollMarginTopValue()            {}
+func (v VarType) scrollMarkerGroupValue()          {}
+func (v VarType) scrollPaddingValue()              {}
+func (v VarType) scrollPaddingBlockValue()         {}
+func (v VarType) scrollPaddingBlockEndValue()      {}
+func (v VarType) scrollPaddingBlockStartValue()    {}
+func (v VarType) scrollPaddingBottomValue()        {}
+func (v VarType) scrollPaddingInlineValue()        {}
+func (v VarType) scrollPaddingInlineEndValue()     {}
+func (v VarType) scrollPaddingInlineStartValue()   {}
+func (v VarType) scrollPaddingLeftValue()          {}
+func (v VarType) scrollPaddingRightValue()         {}
+func (v VarType) scrollPaddingTopValue()           {}
+func (v VarType) scrollSnapAlignValue()            {}
+func (v VarType) scrollSnapStopValue()             {}
+func (v VarType) scrollSnapTypeValue()             {}
+func (v VarType) scrollTimelineValue()             {}
+func (v VarType) scrollTimelineAxisValue()         {}
+func (v VarType) scrollTimelineNameValue()         {}
+func (v VarType) scrollbarColorValue()             {}
+func (v VarType) scrollbarGutterValue()            {}
+func (v VarType) scrollbarWidthValue()             {}
+func (v VarType) shapeImageThresholdValue()        {}
+func (v VarType) shapeMarginValue()                {}
+func (v VarType) shapeOutsideValue()               {}
+func (v VarType) shapeRenderingValue()             {}
+func (v VarType) speakAsValue()                    {}
+func (v VarType) stopColorValue()                  {}
+func (v VarType) stopOpacityValue()                {}
+func (v VarType) strokeValue()                     {}
+func (v VarType) strokeDasharrayValue()            {}
+func (v VarType) strokeDashoffsetValue()           {}
+func (v VarType) strokeLinecapValue()              {}
+func (v VarType) strokeLinejoinValue()             {}
+func (v VarType) strokeMiterlimitValue()           {}
+func (v VarType) strokeOpacityValue()              {}
+func (v VarType) strokeWidthValue()                {}
+func (v VarType) tabSizeValue()                    {}
+func (v VarType) tableLayoutValue()                {}
+func (v VarType) textAlignValue()                  {}
+func (v VarType) textAlignLastValue()              {}
+func (v VarType) textAnchorValue()                 {}
+func (v VarType) textAutospaceValue()              {}
+func (v VarType) textBoxValue()                    {}
+func (v VarType) textBoxEdgeValue()                {}
+func (v VarType) textBoxTrimValue()                {}
+func (v VarType) textCombineUprightValue()         {}
+func (v VarType) textDecorationValue()             {}
+func (v VarType) textDecorationColorValue()        {}
+func (v VarType) textDecorationLineValue()         {}
+func (v VarType) textDecorationSkipInkValue()      {}
+func (v VarType) textDecorationStyleValue()        {}
+func (v VarType) textDecorationThicknessValue()    {}
+func (v VarType) textEmphasisValue()               {}
+func (v VarType) textEmphasisColorValue()          {}
+func (v VarType) textEmphasisPositionValue()       {}
+func (v VarType) textEmphasisStyleValue()          {}
+func (v VarType) textIndentValue()                 {}
+func (v VarType) textJustifyValue()                {}
+func (v VarType) textOrientationValue()            {}
+func (v VarType) textOverflowValue()               {}
+func (v VarType) textRenderingValue()              {}
+func (v VarType) textShadowValue()                 {}
+func (v VarType) textSizeAdjustValue()             {}
+func (v VarType) textSpacingTrimValue()            {}
+func (v VarType) textTransformValue()              {}
+func (v VarType) textUnderlineOffsetValue()        {}
+func (v VarType) textUnderlinePositionValue()      {}
+func (v VarType) textWrapValue()                   {}
+func (v VarType) textWrapModeValue()               {}
+func (v VarType) textWrapStyleValue()              {}
+func (v VarType) timelineScopeValue()              {}
+func (v VarType) topValue()                        {}
+func (v VarType) touchActionValue()                {}
+func (v VarType) transformValue()                  {}
+func (v VarType) transformBoxValue()               {}
+func (v VarType) transformOriginValue()            {}
+func (v VarType) transformStyleValue()             {}
+func (v VarType) transitionValue()                 {}
+func (v VarType) transitionBehaviorValue()         {}
+func (v VarType) transitionDelayValue()            {}
+func (v VarType) transitionDurationValue()         {}
+func (v VarType) transitionPropertyValue()         {}
+func (v VarType) transitionTimingFunctionValue()   {}
+func (v VarType) translateValue()                  {}
+func (v VarType) unicodeBidiValue()                {}
+func (v VarType) userSelectValue()                 {}
+func (v VarType) vectorEffectValue()               {}
+func (v VarType) verticalAlignValue()              {}
+func (v VarType) viewTimelineValue()               {}
+func (v VarType) viewTimelineAxisValue()           {}
+func (v VarType) viewTimelineInsetValue()          {}
+func (v VarType) viewTimelineNameValue()           {}
+func (v VarType) viewTransitionClassValue()        {}
+func (v VarType) viewTransitionNameValue()         {}
+func (v VarType) visibilityValue()                 {}
+func (v VarType) whiteSpaceValue()                 {}
+func (v VarType) whiteSpaceCollapseValue()         {}
+func (v VarType) widowsValue()                     {}
+func (v VarType) willChangeValue()                 {}
+func (v VarType) wordBreakValue()                  {}
+func (v VarType) wordSpacingValue()                {}
+func (v VarType) wordWrapValue()                   {}
+func (v VarType) writingModeValue()                {}
+func (v VarType) xValue()                          {}
+func (v VarType) yValue()                          {}
+func (v VarType) zoomValue()                       {}
+
+func (a AutoType) alignSelfValue()                  {}
+func (a AutoType) animationTimelineValue()          {}
+func (a AutoType) appearanceValue()                 {}
+func (a AutoType) aspectRatioValue()                {}
+func (a AutoType) backgroundValue()                 {}
+func (a AutoType) backgroundSizeValue()             {}
+func (a AutoType) baselineSourceValue()             {}
+func (a AutoType) blockSizeValue()                  {}
+func (a AutoType) borderImageValue()                {}
+func (a AutoType) borderImageWidthValue()           {}
+func (a AutoType) bottomValue()                     {}
+func (a AutoType) breakAfterValue()                 {}
+func (a AutoType) breakBeforeValue()                {}
+func (a AutoType) breakInsideValue()                {}
+func (a AutoType) caretValue()                      {}
+func (a AutoType) caretColorValue()                 {}
+func (a AutoType) caretShapeValue()                 {}
+func (a AutoType) colorInterpolationValue()         {}
+func (a AutoType) colorInterpolationFiltersValue()  {}
+func (a AutoType) columnCountValue()                {}
+func (a AutoType) columnFillValue()                 {}
+func (a AutoType) columnWidthValue()                {}
+func (a AutoType) columnsValue()                    {}
+func (a AutoType) containIntrinsicBlockSizeValue()  {}
+func (a AutoType) containIntrinsicHeightValue()     {}
+func (a AutoType) containIntrinsicInlineSizeValue() {}
+func (a AutoType) containIntrinsicSizeValue()       {}
+func (a AutoType) containIntrinsicWidthValue()      {}
+func (a AutoType) contentVisibilityValue()          {}
+func (a AutoType) cursorValue()                     {}
+func (a AutoType) dominantBaselineValue()           {}
+func (a AutoType) flexValue()                       {}
+func (a AutoType) flexBasisValue()                  {}
+func (a AutoType) fontKerningValue()                {}
+func (a AutoType) fontOpticalSizingValue()          {}
+func (a AutoType) fontSynthesisPositionValue()      {}
+func (a AutoType) fontSynthesisSmallCapsValue()     {}
+func (a AutoType) fontSynthesisStyleValue()         {}
+func (a AutoType) fontSynthesisWeightValue()        {}
+func (a AutoType) forcedColorAdjustValue()          {}
+func (a AutoType) gridValue()                       {}
+func (a AutoType) gridAreaValue()                   {}
+func (a AutoType) gridAutoColumnsValue()            {}
+func (a AutoType) gridAutoRowsValue()               {}
+func (a AutoType) gridColumnValue()                 {}
+func (a AutoType) gridColumnEndValue()              {}
+func (a AutoType) gridColumnStartValue()            {}
+func (a AutoType) gridRowValue()                    {}
+func (a AutoType) gridRowEndValue()                 {}
+func (a AutoType) gridRowStartValue()               {}
+func (a AutoType) gridTemplateValue()               {}
+func (a AutoType) gridTemplateColumnsValue()        {}
+func (a AutoType) gridTemplateRowsValue()           {}
+func (a AutoType) hyphenateCharacterValue()         {}
+func (a AutoType) hyphenateLimitCharsValue()        {}
+func (a AutoType) hyphensValue()                    {}
+func (a AutoType) imageRenderingValue()             {}
+func (a AutoType) inlineSizeValue()                 {}
+func (a AutoType) insetValue()                      {}
+func (a AutoType) insetBlockValue()                 {}
+func (a AutoType) insetBlockEndValue()              {}
+func (a AutoType) insetBlockStartValue()            {}
+func (a AutoType) insetInlineValue()                {}
+func (a AutoType) insetInlineEndValue()             {}
+func (a AutoType) insetInlineStartValue()           {}
+func (a AutoType) isolationValue()                  {}
+func (a AutoType) justifySelfValue()                {}
+func (a AutoType) leftValue()                       {}
+func (a AutoType) lineBreakValue()                  {}
+func (a AutoType) marginBlockValue()                {}
+func (a AutoType) marginBlockEndValue()             {}
+func (a AutoType) marginBlockStartValue()           {}
+func (a AutoType) marginBottomValue()               {}
+func (a AutoType) marginInlineValue()               {}
+func (a AutoType) marginInlineEndValue()            {}
+func (a AutoType) marginInlineStartValue()          {}
+func (a AutoType) marginLeftValue()                 {}
+func (a AutoType) marginRightValue()                {}
+func (a AutoType) marginTopValue()                  {}
+func (a AutoType) maskValue()                       {}
+func (a AutoType) maskBorderValue()                 {}
+func (a AutoType) maskBorderWidthValue()            {}
+func (a AutoType) maskSizeValue()                   {}
+func (a AutoType) minBlockSizeValue()               {}
+func (a AutoType) minHeightValue()                  {}
+func (a AutoType) minInlineSizeValue()              {}
+func (a AutoType) minWidthValue()                   {}
+func (a AutoType) offsetValue()                     {}
+func (a AutoType) offsetAnchorValue()               {}
+func (a AutoType) offsetPositionValue()             {}
+func (a AutoType) offsetRotateValue()               {}
+func (a AutoType) outlineValue()                    {}
+func (a AutoType) outlineColorValue()               {}
+func (a AutoType) outlineStyleValue()               {}
+func (a AutoType) overflowValue()                   {}
+func (a AutoType) overflowAnchorValue()             {}
+func (a AutoType) overflowBlockValue()              {}
+func (a AutoType) overflowInlineValue()             {}
+func (a AutoType) overflowXValue()                  {}
+func (a AutoType) overflowYValue()                  {}
+func (a AutoType) overlayValue()                    {}
+func (a AutoType) overscrollBehaviorValue()         {}
+func (a AutoType) overscrollBehaviorBlockValue()    {}
+func (a AutoType) overscrollBehaviorInlineValue()   {}
+func (a AutoType) overscrollBehaviorXValue()        {}
+func (a AutoType) overscrollBehaviorYValue()        {}
+func (a AutoType) pageValue()                       {}
+func (a AutoType) pageBreakAfterValue()             {}
+func (a AutoType) pageBreakBeforeValue()            {}
+func (a AutoType) pageBreakInsideValue()            {}
+func (a AutoType) placeSelfValue()                  {}
+func (a AutoType) pointerEventsValue()              {}
+func (a AutoType) positionAnchorValue()             {}
+func (a AutoType) quotesValue()                     {}
+func (a AutoType) rightValue()                      {}
+func (a AutoType) rubyMergeValue()                  {}
+func (a AutoType) rxValue()                         {}
+func (a AutoType) ryValue()                         {}
+func (a AutoType) scrollBehaviorValue()             {}
+func (a AutoType) scrollPaddingValue()              {}
+func (a AutoType) scrollPaddingBlockValue()         {}
+func (a AutoType) scrollPaddingBlockEndValue()      {}
+func (a AutoType) scrollPaddingBlockStartValue()    {}
+func (a AutoType) scrollPaddingBottomValue()        {}
+func (a AutoType) scrollPaddingInlineValue()        {}
+func (a AutoType) scrollPaddingInlineEndValue()     {}
+func (a AutoType) scrollPaddingInlineStartValue()   {}
+func (a AutoType) scrollPaddingLeftValue()          {}
+func (a AutoType) scrollPaddingRightValue()         {}
+func (a AutoType) scrollPaddingTopValue()           {}
+func (a AutoType) scrollbarColorValue()             {}
+func (a AutoType) scrollbarGutterValue()            {}
+func (a AutoType) scrollbarWidthValue()             {}
+func (a AutoType) shapeRenderingValue()             {}
+func (a AutoType) tableLayoutValue()                {}
+func (a AutoType) textAlignLastValue()              {}
+func (a AutoType) textAutospaceValue()              {}
+func (a AutoType) textBoxValue()                    {}
+func (a AutoType) textBoxEdgeValue()                {}
+func (a AutoType) textDecorationValue()             {}
+func (a AutoType) textDecorationSkipInkValue()      {}
+func (a AutoType) textDecorationThicknessValue()    {}
+func (a AutoType) textEmphasisPositionValue()       {}
+func (a AutoType) textJustifyValue()                {}
+func (a AutoType) textRenderingValue()              {}
+func (a AutoType) textSizeAdjustValue()             {}
+func (a AutoType) textSpacingTrimValue()            {}
+func (a AutoType) textUnderlineOffsetValue()        {}
+func (a AutoType) textUnderlinePositionValue()      {}
+func (a AutoType) textWrapValue()                   {}
+func (a AutoType) textWrapStyleValue()              {}
+func (a AutoType) topValue()                        {}
+func (a AutoType) touchActionValue()                {}
+func (a AutoType) userSelectValue()                 {}
+func (a AutoType) viewTimelineInsetValue()          {}
+func (a AutoType) willChangeValue()                 {}
+
+func (s Size) animationRangeValue()             {}
+func (s Size) animationRangeEndValue()          {}
+func (s Size) animationRangeStartValue()        {}
+func (s Size) backgroundValue()                 {}
+func (s Size) backgroundPositionValue()         {}
+func (s Size) backgroundPositionXValue()        {}
+func (s Size) backgroundPositionYValue()        {}
+func (s Size) backgroundSizeValue()             {}
+func (s Size) baselineShiftValue()              {}
+func (s Size) blockSizeValue()                  {}
+func (s Size) borderBlockValue()                {}
+func (s Size) borderBlockEndValue()             {}
+func (s Size) borderBlockEndWidthValue()        {}
+func (s Size) borderBlockStartValue()           {}
+func (s Size) borderBlockStartWidthValue()      {}
+func (s Size) borderBlockWidthValue()           {}
+func (s Size) borderBottomValue()               {}
+func (s Size) borderEndEndRadiusValue()         {}
+func (s Size) borderEndStartRadiusValue()       {}
+func (s Size) borderImageValue()                {}
+func (s Size) borderImageOutsetValue()          {}
+func (s Size) borderImageSliceValue()           {}
+func (s Size) borderImageWidthValue()           {}
+func (s Size) borderInlineValue()               {}
+func (s Size) borderInlineEndValue()            {}
+func (s Size) borderInlineEndWidthValue()       {}
+func (s Size) borderInlineStartValue()          {}
+func (s Size) borderInlineStartWidthValue()     {}
+func (s Size) borderInlineWidthValue()          {}
+func (s Size) borderLeftValue()                 {}
+func (s Size) borderRightValue()                {}
+func (s Size) borderSpacingValue()              {}
+func (s Size) borderStartEndRadiusValue()       {}
+func (s Size) borderStartStartRadiusValue()     {}
+func (s Size) borderTopValue()                  {}
+func (s Size) bottomValue()                     {}
+func (s Size) boxShadowValue()                  {}
+func (s Size) columnGapValue()                  {}
+func (s Size) columnRuleValue()                 {}
+func (s Size) columnRuleWidthValue()            {}
+func (s Size) columnWidthValue()                {}
+func (s Size) columnsValue()                    {}
+func (s Size) containIntrinsicBlockSizeValue()  {}
+func (s Size) containIntrinsicHeightValue()     {}
+func (s Size) containIntrinsicInlineSizeValue() {}
+func (s Size) containIntrinsicSizeValue()       {}
+func (s Size) containIntrinsicWidthValue()      {}
+func (s Size) cxValue()                         {}
+func (s Size) cyValue()                         {}
+func (s Size) fillOpacityValue()                {}
+func (s Size) flexValue()                       {}
+func (s Size) flexBasisValue()                  {}
+func (s Size) floodOpacityValue()               {}
+func (s Size) fontValue()                       {}
+func (s Size) fontStretchValue()                {}
+func (s Size) gapValue()                        {}
+func (s Size) gridValue()                       {}
+func (s Size) gridAutoColumnsValue()            {}
+func (s Size) gridAutoRowsValue()               {}
+func (s Size) gridTemplateValue()               {}
+func (s Size) gridTemplateColumnsValue()        {}
+func (s Size) gridTemplateRowsValue()           {}
+func (s Size) inlineSizeValue()                 {}
+func (s Size) insetValue()                      {}
+func (s Size) insetBlockValue()                 {}
+func (s Size) insetBlockEndValue()              {}
+func (s Size) insetBlockStartValue()            {}
+func (s Size) insetInlineValue()                {}
+func (s Size) insetInlineEndValue()             {}
+func (s Size) insetInlineStartValue()           {}
+func (s Size) leftValue()                       {}
+func (s Size) marginBlockValue()                {}
+func (s Size) marginBlockEndValue()             {}
+func (s Size) marginBlockStartValue()           {}
+func (s Size) marginBottomValue()               {}
+func (s Size) marginInlineValue()               {}
+func (s Size) marginInlineEndValue()            {}
+func (s Size) marginInlineStartValue()          {}
+func (s Size) marginLeftValue()                 {}
+func (s Size) marginRightValue()                {}
+func (s Size) marginTopValue()                  {}
+func (s Size) maskValue()                       {}
+func (s Size) maskBorderValue()                 {}
+func (s Size) maskBorderOutsetValue()           {}
+func (s Size) maskBorderSliceValue()            {}
+func (s Size) maskBorderWidthValue()            {}
+func (s Size) maskPositionValue()               {}
+func (s Size) maskSizeValue()                   {}
+func (s Size) maxBlockSizeValue()               {}
+func (s Size) maxHeightValue()                  {}
+func (s Size) maxInlineSizeValue()              {}
+func (s Size) maxWidthValue()                   {}
+func (s Size) minBlockSizeValue()               {}
+func (s Size) minHeightValue()                  {}
+func (s Size) minInlineSizeValue()              {}
+func (s Size) minWidthValue()                   {}
+func (s Size) objectPositionValue()             {}
+func (s Size) offsetValue()                     {}
+func (s Size) offsetAnchorValue()               {}
+func (s Size) offsetDistanceValue()             {}
+func (s Size) offsetPositionValue()             {}
+func (s Size) opacityValue()                    {}
+func (s Size) outlineValue()                    {}
+func (s Size) outlineOffsetValue()              {}
+func (s Size) outlineWidthValue()               {}
+func (s Size) overflowClipMarginValue()         {}
+func (s Size) paddingBlockValue()               {}
+func (s Size) paddingBlockEndValue()            {}
+func (s Size) paddingBlockStartValue()          {}
+func (s Size) paddingInlineValue()              {}
+func (s Size) paddingInlineEndValue()           {}
+func (s Size) paddingInlineStartValue()         {}
+func (s Size) perspectiveValue()                {}
+func (s Size) perspectiveOriginValue()          {}
+func (s Size) rValue()                          {}
+func (s Size) rightValue()                      {}
+func (s Size) rowGapValue()                     {}
+func (s Size) rxValue()                         {}
+func (s Size) ryValue()                         {}
+func (s Size) scaleValue()                      {}
+func (s Size) scrollMarginValue()               {}
+func (s Size) scrollMarginBlockValue()          {}
+func (s Size) scrollMarginBlockEndValue()       {}
+func (s Size) scrollMarginBlockStartValue()     {}
+func (s Size) scrollMarginBottomValue()         {}
+func (s Size) scrollMarginInlineValue()         {}
+func (s Size) scrollMarginInlineEndValue()      {}
+func (s Size) scrollMarginInlineStartValue()    {}
+func (s Size) scrollMarginLeftValue()           {}
+func (s Size) scrollMarginRightValue()          {}
+func (s Size) scrollMarginTopValue()            {}
+func (s Size) scrollPaddingValue()              {}
+func (s Size) scrollPaddingBlockValue()         {}
+func (s Size) scrollPaddingBlockEndValue()      {}
+func (s Size) scrollPaddingBlockStartValue()    {}
+func (s Size) scrollPaddingBottomValue()        {}
+func (s Size) scrollPaddingInlineValue()        {}
+func (s Size) scrollPaddingInlineEndValue()     {}
+func (s Size) scrollPaddingInlineStartValue()   {}
+func (s Size) scrollPaddingLeftValue()          {}
+func (s Size) scrollPaddingRightValue()         {}
+func (s Size) scrollPaddingTopValue()           {}
+func (s Size) shapeImageThresholdValue()        {}
+func (s Size) shapeMarginValue()                {}
+func (s Size) stopOpacityValue()                {}
+func (s Size) strokeDasharrayValue()            {}
+func (s Size) strokeDashoffsetValue()           {}
+func (s Size) strokeOpacityValue()              {}
+func (s Size) strokeWidthValue()                {}
+func (s Size) tabSizeValue()                    {}
+func (s Size) textDecorationValue()             {}
+func (s Size) textDecorationThicknessValue()    {}
+func (s Size) textIndentValue()                 {}
+func (s Size) textShadowValue()                 {}
+func (s Size) textSizeAdjustValue()             {}
+func (s Size) textUnderlineOffsetValue()        {}
+func (s Size) topValue()                        {}
+func (s Size) transformOriginValue()            {}
+func (s Size) translateValue()                  {}
+func (s Size) verticalAlignValue()              {}
+func (s Size) viewTimelineInsetValue()          {}
+func (s Size) wordSpacingValue()                {}
+func (s Size) xValue()                          {}
+func (s Size) yValue()                          {}
+func (s Size) zoomValue()                       {}
+
+func (n Number) animationValue()               {}
+func (n Number) animationIterationCountValue() {}
+func (n Number) borderImageValue()             {}
+func (n Number) borderImageOutsetValue()       {}
+func (n Number) borderImageSliceValue()        {}
+func (n Number) borderImageWidthValue()        {}
+func (n Number) columnCountValue()             {}
+func (n Number) columnsValue()                 {}
+func (n Number) counterIncrementValue()        {}
+func (n Number) counterResetValue()            {}
+func (n Number) counterSetValue()              {}
+func (n Number) cursorValue()                  {}
+func (n Number) fillOpacityValue()             {}
+func (n Number) flexValue()                    {}
+func (n Number) flexGrowValue()                {}
+func (n Number) flexShrinkValue()              {}
+func (n Number) floodOpacityValue()            {}
+func (n Number) fontValue()                    {}
+func (n Number) fontFeatureSettingsValue()     {}
+func (n Number) fontSizeAdjustValue()          {}
+func (n Number) fontVariationSettingsValue()   {}
+func (n Number) fontWeightValue()              {}
+func (n Number) gridAreaValue()                {}
+func (n Number) gridColumnValue()              {}
+func (n Number) gridColumnEndValue()           {}
+func (n Number) gridColumnStartValue()         {}
+func (n Number) gridRowValue()                 {}
+func (n Number) gridRowEndValue()              {}
+func (n Number) gridRowStartValue()            {}
+func (n Number) hyphenateLimitCharsValue()     {}
+func (n Number) initialLetterValue()           {}
+func (n Number) lineClampValue()               {}
+func (n Number) maskBorderValue()              {}
+func (n Number) maskBorderOutsetValue()        {}
+func (n Number) maskBorderSliceValue()         {}
+func (n Number) maskBorderWidthValue()         {}
+func (n Number) mathDepthValue()               {}
+func (n Number) opacityValue()                 {}
+func (n Number) orderValue()                   {}
+func (n Number) orphansValue()                 {}
+func (n Number) rotateValue()                  {}
+func (n Number) scaleValue()                   {}
+func (n Number) shapeImageThresholdValue()     {}
+func (n Number) stopOpacityValue()             {}
+func (n Number) strokeDasharrayValue()         {}
+func (n Number) strokeDashoffsetValue()        {}
+func (n Number) strokeMiterlimitValue()        {}
+func (n Number) strokeOpacityValue()           {}
+func (n Number) strokeWidthValue()             {}
+func (n Number) tabSizeValue()                 {}
+func (n Number) textCombineUprightValue()      {}
+func (n Number) widowsValue()                  {}
+func (n Number) zoomValue()                    {}
+
+func (c Color) backgroundValue()             {}
+func (c Color) borderBlockValue()            {}
+func (c Color) borderBlockColorValue()       {}
+func (c Color) borderBlockEndValue()         {}
+func (c Color) borderBlockEndColorValue()    {}
+func (c Color) borderBlockStartValue()       {}
+func (c Color) borderBlockStartColorValue()  {}
+func (c Color) borderBottomValue()           {}
+func (c Color) borderBottomColorValue()      {}
+func (c Color) borderInlineValue()           {}
+func (c Color) borderInlineColorValue()      {}
+func (c Color) borderInlineEndValue()        {}
+func (c Color) borderInlineEndColorValue()   {}
+func (c Color) borderInlineStartValue()      {}
+func (c Color) borderInlineStartColorValue() {}
+func (c Color) borderLeftValue()             {}
+func (c Color) borderLeftColorValue()        {}
+func (c Color) borderRightValue()            {}
+func (c Color) borderRightColorValue()       {}
+func (c Color) borderTopValue()              {}
+func (c Color) borderTopColorValue()         {}
+func (c Color) boxShadowValue()              {}
+func (c Color) caretValue()                  {}
+func (c Color) caretColorValue()             {}
+func (c Color) columnRuleValue()             {}
+func (c Color) columnRuleColorValue()        {}
+func (c Color) fillValue()                   {}
+func (c Color) floodColorValue()             {}
+func (c Color) lightingColorValue()          {}
+func (c Color) outlineValue()                {}
+func (c Color) outlineColorValue()           {}
+func (c Color) scrollbarColorValue()         {}
+func (c Color) stopColorValue()              {}
+func (c Color) strokeValue()                 {}
+func (c Color) textDecorationValue()         {}
+func (c Color) textDecorationColorValue()    {}
+func (c Color) textEmphasisValue()           {}
+func (c Color) textEmphasisColorValue()      {}
+func (c Color) textShadowValue()             {}
+
+func (r RGBColor) backgroundValue()             {}
+func (r RGBColor) borderBlockValue()            {}
+func (r RGBColor) borderBlockColorValue()       {}
+func (r RGBColor) borderBlockEndValue()         {}
+func (r RGBColor) borderBlockEndColorValue()    {}
+func (r RGBColor) borderBlockStartValue()       {}
+func (r RGBColor) borderBlockStartColorValue()  {}
+func (r RGBColor) borderBottomValue()           {}
+func (r RGBColor) borderBottomColorValue()      {}
+func (r RGBColor) borderInlineValue()           {}
+func (r RGBColor) borderInlineColorValue()      {}
+func (r RGBColor) borderInlineEndValue()        {}
+func (r RGBColor) borderInlineEndColorValue()   {}
+func (r RGBColor) borderInlineStartValue()      {}
+func (r RGBColor) borderInlineStartColorValue() {}
+func (r RGBColor) borderLeftValue()             {}
+func (r RGBColor) borderLeftColorValue()        {}
+func (r RGBColor) borderRightValue()            {}
+func (r RGBColor) borderRightColorValue()       {}
+func (r RGBColor) borderTopValue()              {}
+func (r RGBColor) borderTopColorValue()         {}
+func (r RGBColor) boxShadowValue()              {}
+func (r RGBColor) caretValue()                  {}
+func (r RGBColor) caretColorValue()             {}
+func (r RGBColor) columnRuleValue()             {}
+func (r RGBColor) columnRuleColorValue()        {}
+func (r RGBColor) fillValue()                   {}
+func (r RGBColor) floodColorValue()             {}
+func (r RGBColor) lightingColorValue()          {}
+func (r RGBColor) outlineValue()                {}
+func (r RGBColor) outlineColorValue()           {}
+func (r RGBColor) scrollbarColorValue()         {}
+func (r RGBColor) stopColorValue()              {}
+func (r RGBColor) strokeValue()                 {}
+func (r RGBColor) textDecorationValue()         {}
+func (r RGBColor) textDecorationColorValue()    {}
+func (r RGBColor) textEmphasisValue()           {}
+func (r RGBColor) textEmphasisColorValue()      {}
+func (r RGBColor) textShadowValue()             {}
+
+func (c ColorFunction) backgroundValue()             {}
+func (c ColorFunction) borderBlockValue()            {}
+func (c ColorFunction) borderBlockColorValue()       {}
+func (c ColorFunction) borderBlockEndValue()         {}
+func (c ColorFunction) borderBlockEndColorValue()    {}
+func (c ColorFunction) borderBlockStartValue()       {}
+func (c ColorFunction) borderBlockStartColorValue()  {}
+func (c ColorFunction) borderBottomValue()           {}
+func (c ColorFunction) borderBottomColorValue()      {}
+func (c ColorFunction) borderInlineValue()           {}
+func (c ColorFunction) borderInlineColorValue()      {}
+func (c ColorFunction) borderInlineEndValue()        {}
+func (c ColorFunction) borderInlineEndColorValue()   {}
+func (c ColorFunction) borderInlineStartValue()      {}
+func (c ColorFunction) borderInlineStartColorValue() {}
+func (c ColorFunction) borderLeftValue()             {}
+func (c ColorFunction) borderLeftColorValue()        {}
+func (c ColorFunction) borderRightValue()            {}
+func (c ColorFunction) borderRightColorValue()       {}
+func (c ColorFunction) borderTopValue()              {}
+func (c ColorFunction) borderTopColorValue()         {}
+func (c ColorFunction) boxShadowValue()              {}
+func (c ColorFunction) caretValue()                  {}
+func (c ColorFunction) caretColorValue()             {}
+func (c ColorFunction) columnRuleValue()             {}
+func (c ColorFunction) columnRuleColorValue()        {}
+func (c ColorFunction) fillValue()                   {}
+func (c ColorFunction) floodColorValue()             {}
+func (c ColorFunction) lightingColorValue()          {}
+func (c ColorFunction) outlineValue()                {}
+func (c ColorFunction) outlineColorValue()           {}
+func (c ColorFunction) scrollbarColorValue()         {}
+func (c ColorFunction) stopColorValue()              {}
+func (c ColorFunction) strokeValue()                 {}
+func (c ColorFunction) textDecorationValue()         {}
+func (c ColorFunction) textDecorationColorValue()    {}
+func (c ColorFunction) textEmphasisValue()           {}
+func (c ColorFunction) textEmphasisColorValue()      {}
+func (c ColorFunction) textShadowValue()             {}
+
+func (u UrlType) backdropFilterValue()    {}
+func (u UrlType) backgroundValue()        {}
+func (u UrlType) borderImageValue()       {}
+func (u UrlType) borderImageSourceValue() {}
+func (u UrlType) clipPathValue()          {}
+func (u UrlType) cursorValue()            {}
+func (u UrlType) fillValue()              {}
+func (u UrlType) filterValue()            {}
+func (u UrlType) listStyleValue()         {}
+func (u UrlType) listStyleImageValue()    {}
+func (u UrlType) markerValue()            {}
+func (u UrlType) markerEndValue()         {}
+func (u UrlType) markerMidValue()         {}
+func (u UrlType) markerStartValue()       {}
+func (u UrlType) maskValue()              {}
+func (u UrlType) maskBorderValue()        {}
+func (u UrlType) maskBorderSourceValue()  {}
+func (u UrlType) maskImageValue()         {}
+func (u UrlType) offsetValue()            {}
+func (u UrlType) offsetPathValue()        {}
+func (u UrlType) shapeOutsideValue()      {}
+func (u UrlType) strokeValue()            {}
+
+func (s StringType) animationValue()             {}
+func (s StringType) animationNameValue()         {}
+func (s StringType) fontValue()                  {}
+func (s StringType) fontFeatureSettingsValue()   {}
+func (s StringType) fontLanguageOverrideValue()  {}
+func (s StringType) fontVariationSettingsValue() {}
+func (s StringType) gridValue()                  {}
+func (s StringType) gridTemplateValue()          {}
+func (s StringType) gridTemplateAreasValue()     {}
+func (s StringType) hyphenateCharacterValue()    {}
+func (s StringType) listStyleValue()             {}
+func (s StringType) listStyleTypeValue()         {}
+func (s StringType) quotesValue()                {}
+func (s StringType) textEmphasisValue()          {}
+func (s StringType) textEmphasisStyleValue()     {}
+func (s StringType) textOverflowValue()          {}
+
+func (t Time) animationValue()          {}
+func (t Time) animationDelayValue()     {}
+func (t Time) animationDurationValue()  {}
+func (t Time) transitionValue()         {}
+func (t Time) transitionDelayValue()    {}
+func (t Time) transitionDurationValue() {}
+
+func (a Angle) fontValue()             {}
+func (a Angle) fontStyleValue()        {}
+func (a Angle) imageOrientationValue() {}
+func (a Angle) offsetValue()           {}
+func (a Angle) offsetRotateValue()     {}
+func (a Angle) rotateValue()           {}
+
+func (i IdentType) anchorNameValue()           {}
+func (i IdentType) anchorScopeValue()          {}
+func (i IdentType) animationValue()            {}
+func (i IdentType) animationNameValue()        {}
+func (i IdentType) animationTimelineValue()    {}
+func (i IdentType) containerValue()            {}
+func (i IdentType) containerNameValue()        {}
+func (i IdentType) counterIncrementValue()     {}
+func (i IdentType) counterResetValue()         {}
+func (i IdentType) counterSetValue()           {}
+func (i IdentType) fontValue()                 {}
+func (i IdentType) fontPaletteValue()          {}
+func (i IdentType) gridValue()                 {}
+func (i IdentType) gridAreaValue()             {}
+func (i IdentType) gridColumnValue()           {}
+func (i IdentType) gridColumnEndValue()        {}
+func (i IdentType) gridColumnStartValue()      {}
+func (i IdentType) gridRowValue()              {}
+func (i IdentType) gridRowEndValue()           {}
+func (i IdentType) gridRowStartValue()         {}
+func (i IdentType) gridTemplateValue()         {}
+func (i IdentType) gridTemplateColumnsValue()  {}
+func (i IdentType) gridTemplateRowsValue()     {}
+func (i IdentType) listStyleValue()            {}
+func (i IdentType) listStyleTypeValue()        {}
+func (i IdentType) pageValue()                 {}
+func (i IdentType) positionAnchorValue()       {}
+func (i IdentType) positionTryValue()          {}
+func (i IdentType) positionTryFallbacksValue() {}
+func (i IdentType) scrollTimelineValue()       {}
+func (i IdentType) scrollTimelineNameValue()   {}
+func (i IdentType) timelineScopeValue()        {}
+func (i IdentType) transitionValue()           {}
+func (i IdentType) transitionPropertyValue()   {}
+func (i IdentType) viewTimelineValue()         {}
+func (i IdentType) viewTimelineNameValue()     {}
+func (i IdentType) viewTransitionClassValue()  {}
+func (i IdentType) viewTransitionNameValue()   {}
+func (i IdentType) willChangeValue()           {}
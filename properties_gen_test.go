@@ -0,0 +1,483 @@
+// Code generated by genprops from data/properties.json; DO NOT EDIT.
+
+package cssgo
+
+import "testing"
+
+// generatedPropertyTests has an example for every generated property constructor.
+var generatedPropertyTests = []test{
+	{name: "align-content", input: AlignContent(AlignContentNormal), want: "align-content: normal;"},
+	{name: "align-items", input: AlignItems(AlignItemsNormal), want: "align-items: normal;"},
+	{name: "align-self", input: AlignSelf(AlignSelfNormal), want: "align-self: normal;"},
+	{name: "alignment-baseline", input: AlignmentBaseline(AlignmentBaselineBaseline), want: "alignment-baseline: baseline;"},
+	{name: "all", input: All(Inherit), want: "all: inherit;"},
+	{name: "anchor-name", input: AnchorName(Ident("--a")), want: "anchor-name: --a;"},
+	{name: "anchor-scope", input: AnchorScope(Ident("--a")), want: "anchor-scope: --a;"},
+	{name: "animation", input: Animation(Ident("fade"), S(1), AnimationIterationCountInfinite), want: "animation: fade 1s infinite;"},
+	{name: "animation layers", input: AnimationLayers([]AnimationValue{Ident("fade"), S(1), AnimationIterationCountInfinite}, []AnimationValue{Ident("fade"), S(1), AnimationIterationCountInfinite}), want: "animation: fade 1s infinite, fade 1s infinite;"},
+	{name: "animation-composition", input: AnimationComposition(AnimationCompositionReplace, AnimationCompositionReplace), want: "animation-composition: replace, replace;"},
+	{name: "animation-delay", input: AnimationDelay(MS(200), MS(200)), want: "animation-delay: 200ms, 200ms;"},
+	{name: "animation-direction", input: AnimationDirection(AnimationDirectionNormal, AnimationDirectionNormal), want: "animation-direction: normal, normal;"},
+	{name: "animation-duration", input: AnimationDuration(MS(200), MS(200)), want: "animation-duration: 200ms, 200ms;"},
+	{name: "animation-fill-mode", input: AnimationFillMode(AnimationFillModeNone, AnimationFillModeNone), want: "animation-fill-mode: none, none;"},
+	{name: "animation-iteration-count", input: AnimationIterationCount(AnimationIterationCountInfinite, AnimationIterationCountInfinite), want: "animation-iteration-count: infinite, infinite;"},
+	{name: "animation-name", input: AnimationName(AnimationNameNone, AnimationNameNone), want: "animation-name: none, none;"},
+	{name: "animation-play-state", input: AnimationPlayState(AnimationPlayStateRunning, AnimationPlayStateRunning), want: "animation-play-state: running, running;"},
+	{name: "animation-range", input: AnimationRange(AnimationRangeStartEntry, PCT(10)), want: "animation-range: entry 10%;"},
+	{name: "animation-range layers", input: AnimationRangeLayers([]AnimationRangeValue{AnimationRangeStartEntry, PCT(10)}, []AnimationRangeValue{AnimationRangeStartEntry, PCT(10)}), want: "animation-range: entry 10%, entry 10%;"},
+	{name: "animation-range-end", input: AnimationRangeEnd(AnimationRangeEndExit, PCT(90)), want: "animation-range-end: exit 90%;"},
+	{name: "animation-range-end layers", input: AnimationRangeEndLayers([]AnimationRangeEndValue{AnimationRangeEndExit, PCT(90)}, []AnimationRangeEndValue{AnimationRangeEndExit, PCT(90)}), want: "animation-range-end: exit 90%, exit 90%;"},
+	{name: "animation-range-start", input: AnimationRangeStart(AnimationRangeStartEntry, PCT(10)), want: "animation-range-start: entry 10%;"},
+	{name: "animation-range-start layers", input: AnimationRangeStartLayers([]AnimationRangeStartValue{AnimationRangeStartEntry, PCT(10)}, []AnimationRangeStartValue{AnimationRangeStartEntry, PCT(10)}), want: "animation-range-start: entry 10%, entry 10%;"},
+	{name: "animation-timeline", input: AnimationTimeline(AnimationTimelineNone, AnimationTimelineNone), want: "animation-timeline: none, none;"},
+	{name: "animation-timing-function", input: AnimationTimingFunction(AnimationTimingFunctionLinear, AnimationTimingFunctionLinear), want: "animation-timing-function: linear, linear;"},
+	{name: "appearance", input: Appearance(AppearanceNone), want: "appearance: none;"},
+	{name: "aspect-ratio", input: AspectRatio(Auto), want: "aspect-ratio: auto;"},
+	{name: "backdrop-filter", input: BackdropFilter(BackdropFilterNone), want: "backdrop-filter: none;"},
+	{name: "backface-visibility", input: BackfaceVisibility(BackfaceVisibilityVisible), want: "backface-visibility: visible;"},
+	{name: "background", input: Background(BackgroundNone), want: "background: none;"},
+	{name: "background-attachment", input: BackgroundAttachment(BackgroundAttachmentScroll, BackgroundAttachmentScroll), want: "background-attachment: scroll, scroll;"},
+	{name: "background-blend-mode", input: BackgroundBlendMode(BackgroundBlendModeNormal, BackgroundBlendModeNormal), want: "background-blend-mode: normal, normal;"},
+	{name: "background-clip", input: BackgroundClip(BackgroundClipBorderBox, BackgroundClipBorderBox), want: "background-clip: border-box, border-box;"},
+	{name: "background-origin", input: BackgroundOrigin(BackgroundOriginBorderBox, BackgroundOriginBorderBox), want: "background-origin: border-box, border-box;"},
+	{name: "background-position", input: BackgroundPosition(BackgroundPositionRight, PX(8)), want: "background-position: right 8px;"},
+	{name: "background-position layers", input: BackgroundPositionLayers([]BackgroundPositionValue{BackgroundPositionRight, PX(8)}, []BackgroundPositionValue{BackgroundPositionRight, PX(8)}), want: "background-position: right 8px, right 8px;"},
+	{name: "background-position-x", input: BackgroundPositionX(BackgroundPositionXRight, PX(8)), want: "background-position-x: right 8px;"},
+	{name: "background-position-x layers", input: BackgroundPositionXLayers([]BackgroundPositionXValue{BackgroundPositionXRight, PX(8)}, []BackgroundPositionXValue{BackgroundPositionXRight, PX(8)}), want: "background-position-x: right 8px, right 8px;"},
+	{name: "background-position-y", input: BackgroundPositionY(BackgroundPositionYBottom, PX(8)), want: "background-position-y: bottom 8px;"},
+	{name: "background-position-y layers", input: BackgroundPositionYLayers([]BackgroundPositionYValue{BackgroundPositionYBottom, PX(8)}, []BackgroundPositionYValue{BackgroundPositionYBottom, PX(8)}), want: "background-position-y: bottom 8px, bottom 8px;"},
+	{name: "background-repeat", input: BackgroundRepeat(BackgroundRepeatRepeat, BackgroundRepeatNoRepeat), want: "background-repeat: repeat no-repeat;"},
+	{name: "background-repeat layers", input: BackgroundRepeatLayers([]BackgroundRepeatValue{BackgroundRepeatRepeat, BackgroundRepeatNoRepeat}, []BackgroundRepeatValue{BackgroundRepeatRepeat, BackgroundRepeatNoRepeat}), want: "background-repeat: repeat no-repeat, repeat no-repeat;"},
+	{name: "background-size", input: BackgroundSize(PCT(50), Auto), want: "background-size: 50% auto;"},
+	{name: "background-size layers", input: BackgroundSizeLayers([]BackgroundSizeValue{PCT(50), Auto}, []BackgroundSizeValue{PCT(50), Auto}), want: "background-size: 50% auto, 50% auto;"},
+	{name: "baseline-shift", input: BaselineShift(BaselineShiftSub), want: "baseline-shift: sub;"},
+	{name: "baseline-source", input: BaselineSource(BaselineSourceFirst), want: "baseline-source: first;"},
+	{name: "block-size", input: BlockSize(BlockSizeMinContent), want: "block-size: min-content;"},
+	{name: "border-block", input: BorderBlock(PX(1)), want: "border-block: 1px;"},
+	{name: "border-block-color", input: BorderBlockColor(Red), want: "border-block-color: red;"},
+	{name: "border-block-end", input: BorderBlockEnd(PX(1)), want: "border-block-end: 1px;"},
+	{name: "border-block-end-color", input: BorderBlockEndColor(Red), want: "border-block-end-color: red;"},
+	{name: "border-block-end-style", input: BorderBlockEndStyle(BorderBlockEndStyleNone), want: "border-block-end-style: none;"},
+	{name: "border-block-end-width", input: BorderBlockEndWidth(BorderBlockEndWidthThin), want: "border-block-end-width: thin;"},
+	{name: "border-block-start", input: BorderBlockStart(PX(1)), want: "border-block-start: 1px;"},
+	{name: "border-block-start-color", input: BorderBlockStartColor(Red), want: "border-block-start-color: red;"},
+	{name: "border-block-start-style", input: BorderBlockStartStyle(BorderBlockStartStyleNone), want: "border-block-start-style: none;"},
+	{name: "border-block-start-width", input: BorderBlockStartWidth(BorderBlockStartWidthThin), want: "border-block-start-width: thin;"},
+	{name: "border-block-style", input: BorderBlockStyle(Inherit), want: "border-block-style: inherit;"},
+	{name: "border-block-width", input: BorderBlockWidth(PX(1)), want: "border-block-width: 1px;"},
+	{name: "border-bottom", input: BorderBottom(BorderBottomThin), want: "border-bottom: thin;"},
+	{name: "border-bottom-color", input: BorderBottomColor(Red), want: "border-bottom-color: red;"},
+	{name: "border-bottom-style", input: BorderBottomStyle(BorderBottomStyleNone), want: "border-bottom-style: none;"},
+	{name: "border-collapse", input: BorderCollapse(BorderCollapseCollapse), want: "border-collapse: collapse;"},
+	{name: "border-end-end-radius", input: BorderEndEndRadius(PX(1)), want: "border-end-end-radius: 1px;"},
+	{name: "border-end-start-radius", input: BorderEndStartRadius(PX(1)), want: "border-end-start-radius: 1px;"},
+	{name: "border-image", input: BorderImage(Url("a.png")), want: "border-image: url('a.png');"},
+	{name: "border-image-outset", input: BorderImageOutset(PX(1)), want: "border-image-outset: 1px;"},
+	{name: "border-image-repeat", input: BorderImageRepeat(BorderImageRepeatStretch), want: "border-image-repeat: stretch;"},
+	{name: "border-image-slice", input: BorderImageSlice(Num(1)), want: "border-image-slice: 1;"},
+	{name: "border-image-source", input: BorderImageSource(BorderImageSourceNone), want: "border-image-source: none;"},
+	{name: "border-image-width", input: BorderImageWidth(PX(1)), want: "border-image-width: 1px;"},
+	{name: "border-inline", input: BorderInline(PX(1)), want: "border-inline: 1px;"},
+	{name: "border-inline-color", input: BorderInlineColor(Red), want: "border-inline-color: red;"},
+	{name: "border-inline-end", input: BorderInlineEnd(PX(1)), want: "border-inline-end: 1px;"},
+	{name: "border-inline-end-color", input: BorderInlineEndColor(Red), want: "border-inline-end-color: red;"},
+	{name: "border-inline-end-style", input: BorderInlineEndStyle(BorderInlineEndStyleNone), want: "border-inline-end-style: none;"},
+	{name: "border-inline-end-width", input: BorderInlineEndWidth(BorderInlineEndWidthThin), want: "border-inline-end-width: thin;"},
+	{name: "border-inline-start", input: BorderInlineStart(PX(1)), want: "border-inline-start: 1px;"},
+	{name: "border-inline-start-color", input: BorderInlineStartColor(Red), want: "border-inline-start-color: red;"},
+	{name: "border-inline-start-style", input: BorderInlineStartStyle(BorderInlineStartStyleNone), want: "border-inline-start-style: none;"},
+	{name: "border-inline-start-width", input: BorderInlineStartWidth(BorderInlineStartWidthThin), want: "border-inline-start-width: thin;"},
+	{name: "border-inline-style", input: BorderInlineStyle(Inherit), want: "border-inline-style: inherit;"},
+	{name: "border-inline-width", input: BorderInlineWidth(PX(1)), want: "border-inline-width: 1px;"},
+	{name: "border-left", input: BorderLeft(BorderLeftThin), want: "border-left: thin;"},
+	{name: "border-left-color", input: BorderLeftColor(Red), want: "border-left-color: red;"},
+	{name: "border-left-style", input: BorderLeftStyle(BorderLeftStyleNone), want: "border-left-style: none;"},
+	{name: "border-right", input: BorderRight(BorderRightThin), want: "border-right: thin;"},
+	{name: "border-right-color", input: BorderRightColor(Red), want: "border-right-color: red;"},
+	{name: "border-right-style", input: BorderRightStyle(BorderRightStyleNone), want: "border-right-style: none;"},
+	{name: "border-spacing", input: BorderSpacing(PX(1)), want: "border-spacing: 1px;"},
+	{name: "border-start-end-radius", input: BorderStartEndRadius(PX(1)), want: "border-start-end-radius: 1px;"},
+	{name: "border-start-start-radius", input: BorderStartStartRadius(PX(1)), want: "border-start-start-radius: 1px;"},
+	{name: "border-top", input: BorderTop(BorderTopThin), want: "border-top: thin;"},
+	{name: "border-top-color", input: BorderTopColor(Red), want: "border-top-color: red;"},
+	{name: "border-top-style", input: BorderTopStyle(BorderTopStyleNone), want: "border-top-style: none;"},
+	{name: "bottom", input: Bottom(PX(1)), want: "bottom: 1px;"},
+	{name: "box-decoration-break", input: BoxDecorationBreak(BoxDecorationBreakSlice), want: "box-decoration-break: slice;"},
+	{name: "box-shadow", input: BoxShadow(PX(1), PX(2), Red), want: "box-shadow: 1px 2px red;"},
+	{name: "box-shadow layers", input: BoxShadowLayers([]BoxShadowValue{PX(1), PX(2), Red}, []BoxShadowValue{PX(1), PX(2), Red}), want: "box-shadow: 1px 2px red, 1px 2px red;"},
+	{name: "box-sizing", input: BoxSizing(BoxSizingContentBox), want: "box-sizing: content-box;"},
+	{name: "break-after", input: BreakAfter(BreakAfterAvoid), want: "break-after: avoid;"},
+	{name: "break-before", input: BreakBefore(BreakBeforeAvoid), want: "break-before: avoid;"},
+	{name: "break-inside", input: BreakInside(BreakInsideAvoid), want: "break-inside: avoid;"},
+	{name: "caption-side", input: CaptionSide(CaptionSideTop), want: "caption-side: top;"},
+	{name: "caret", input: Caret(Auto), want: "caret: auto;"},
+	{name: "caret-color", input: CaretColor(Auto), want: "caret-color: auto;"},
+	{name: "caret-shape", input: CaretShape(CaretShapeBar), want: "caret-shape: bar;"},
+	{name: "clear", input: Clear(ClearNone), want: "clear: none;"},
+	{name: "clip-path", input: ClipPath(ClipPathContentBox), want: "clip-path: content-box;"},
+	{name: "clip-rule", input: ClipRule(ClipRuleNonzero), want: "clip-rule: nonzero;"},
+	{name: "color-interpolation", input: ColorInterpolation(ColorInterpolationSRGB), want: "color-interpolation: sRGB;"},
+	{name: "color-interpolation-filters", input: ColorInterpolationFilters(ColorInterpolationFiltersSRGB), want: "color-interpolation-filters: sRGB;"},
+	{name: "column-count", input: ColumnCount(Int(1)), want: "column-count: 1;"},
+	{name: "column-fill", input: ColumnFill(ColumnFillBalance), want: "column-fill: balance;"},
+	{name: "column-gap", input: ColumnGap(ColumnGapNormal), want: "column-gap: normal;"},
+	{name: "column-rule", input: ColumnRule(PX(1)), want: "column-rule: 1px;"},
+	{name: "column-rule-color", input: ColumnRuleColor(Red), want: "column-rule-color: red;"},
+	{name: "column-rule-style", input: ColumnRuleStyle(ColumnRuleStyleNone), want: "column-rule-style: none;"},
+	{name: "column-rule-width", input: ColumnRuleWidth(ColumnRuleWidthThin), want: "column-rule-width: thin;"},
+	{name: "column-span", input: ColumnSpan(ColumnSpanNone), want: "column-span: none;"},
+	{name: "column-width", input: ColumnWidth(PX(1)), want: "column-width: 1px;"},
+	{name: "columns", input: Columns(PX(1)), want: "columns: 1px;"},
+	{name: "contain", input: Contain(ContainNone), want: "contain: none;"},
+	{name: "contain-intrinsic-block-size", input: ContainIntrinsicBlockSize(ContainIntrinsicBlockSizeNone), want: "contain-intrinsic-block-size: none;"},
+	{name: "contain-intrinsic-height", input: ContainIntrinsicHeight(ContainIntrinsicHeightNone), want: "contain-intrinsic-height: none;"},
+	{name: "contain-intrinsic-inline-size", input: ContainIntrinsicInlineSize(ContainIntrinsicInlineSizeNone), want: "contain-intrinsic-inline-size: none;"},
+	{name: "contain-intrinsic-size", input: ContainIntrinsicSize(Auto, PX(100)), want: "contain-intrinsic-size: auto 100px;"},
+	{name: "contain-intrinsic-width", input: ContainIntrinsicWidth(ContainIntrinsicWidthNone), want: "contain-intrinsic-width: none;"},
+	{name: "container", input: Container(Ident("a")), want: "container: a;"},
+	{name: "container-name", input: ContainerName(ContainerNameNone), want: "container-name: none;"},
+	{name: "container-type", input: ContainerType(ContainerTypeNormal), want: "container-type: normal;"},
+	{name: "content-visibility", input: ContentVisibility(ContentVisibilityVisible), want: "content-visibility: visible;"},
+	{name: "counter-increment", input: CounterIncrement(CounterIncrementNone), want: "counter-increment: none;"},
+	{name: "counter-reset", input: CounterReset(CounterResetNone), want: "counter-reset: none;"},
+	{name: "counter-set", input: CounterSet(CounterSetNone), want: "counter-set: none;"},
+	{name: "cursor", input: Cursor(CursorDefault), want: "cursor: default;"},
+	{name: "cx", input: Cx(PX(1)), want: "cx: 1px;"},
+	{name: "cy", input: Cy(PX(1)), want: "cy: 1px;"},
+	{name: "d", input: D(DNone), want: "d: none;"},
+	{name: "direction", input: DirectionProp(DirectionLtr), want: "direction: ltr;"},
+	{name: "dominant-baseline", input: DominantBaseline(DominantBaselineTextBottom), want: "dominant-baseline: text-bottom;"},
+	{name: "dynamic-range-limit", input: DynamicRangeLimit(DynamicRangeLimitStandard), want: "dynamic-range-limit: standard;"},
+	{name: "empty-cells", input: EmptyCells(EmptyCellsShow), want: "empty-cells: show;"},
+	{name: "field-sizing", input: FieldSizing(FieldSizingContent), want: "field-sizing: content;"},
+	{name: "fill", input: Fill(FillNone), want: "fill: none;"},
+	{name: "fill-opacity", input: FillOpacity(Num(0.5)), want: "fill-opacity: 0.5;"},
+	{name: "fill-rule", input: FillRule(FillRuleNonzero), want: "fill-rule: nonzero;"},
+	{name: "filter", input: Filter(FilterNone), want: "filter: none;"},
+	{name: "flex", input: FlexProp(FlexNone), want: "flex: none;"},
+	{name: "flex-basis", input: FlexBasis(FlexBasisContent), want: "flex-basis: content;"},
+	{name: "flex-flow", input: FlexFlow(FlexFlowRow), want: "flex-flow: row;"},
+	{name: "flex-grow", input: FlexGrow(Num(0.5)), want: "flex-grow: 0.5;"},
+	{name: "flex-shrink", input: FlexShrink(Num(0.5)), want: "flex-shrink: 0.5;"},
+	{name: "flex-wrap", input: FlexWrap(FlexWrapNowrap), want: "flex-wrap: nowrap;"},
+	{name: "float", input: Float(FloatLeft), want: "float: left;"},
+	{name: "flood-color", input: FloodColor(Red), want: "flood-color: red;"},
+	{name: "flood-opacity", input: FloodOpacity(Num(0.5)), want: "flood-opacity: 0.5;"},
+	{name: "font", input: Font(FontCaption), want: "font: caption;"},
+	{name: "font-feature-settings", input: FontFeatureSettings(Str("liga"), Int(1)), want: "font-feature-settings: \"liga\" 1;"},
+	{name: "font-feature-settings layers", input: FontFeatureSettingsLayers([]FontFeatureSettingsValue{Str("liga"), Int(1)}, []FontFeatureSettingsValue{Str("liga"), Int(1)}), want: "font-feature-settings: \"liga\" 1, \"liga\" 1;"},
+	{name: "font-kerning", input: FontKerning(FontKerningNormal), want: "font-kerning: normal;"},
+	{name: "font-language-override", input: FontLanguageOverride(FontLanguageOverrideNormal), want: "font-language-override: normal;"},
+	{name: "font-optical-sizing", input: FontOpticalSizing(FontOpticalSizingNone), want: "font-optical-sizing: none;"},
+	{name: "font-palette", input: FontPalette(FontPaletteNormal), want: "font-palette: normal;"},
+	{name: "font-size-adjust", input: FontSizeAdjust(FontSizeAdjustNone), want: "font-size-adjust: none;"},
+	{name: "font-stretch", input: FontStretch(FontStretchNormal), want: "font-stretch: normal;"},
+	{name: "font-style", input: FontStyle(FontStyleNormal), want: "font-style: normal;"},
+	{name: "font-synthesis", input: FontSynthesis(FontSynthesisNone), want: "font-synthesis: none;"},
+	{name: "font-synthesis-position", input: FontSynthesisPosition(FontSynthesisPositionNone), want: "font-synthesis-position: none;"},
+	{name: "font-synthesis-small-caps", input: FontSynthesisSmallCaps(FontSynthesisSmallCapsNone), want: "font-synthesis-small-caps: none;"},
+	{name: "font-synthesis-style", input: FontSynthesisStyle(FontSynthesisStyleNone), want: "font-synthesis-style: none;"},
+	{name: "font-synthesis-weight", input: FontSynthesisWeight(FontSynthesisWeightNone), want: "font-synthesis-weight: none;"},
+	{name: "font-variant", input: FontVariant(Inherit), want: "font-variant: inherit;"},
+	{name: "font-variant-alternates", input: FontVariantAlternates(FontVariantAlternatesNormal), want: "font-variant-alternates: normal;"},
+	{name: "font-variant-caps", input: FontVariantCaps(FontVariantCapsNormal), want: "font-variant-caps: normal;"},
+	{name: "font-variant-east-asian", input: FontVariantEastAsian(FontVariantEastAsianNormal), want: "font-variant-east-asian: normal;"},
+	{name: "font-variant-emoji", input: FontVariantEmoji(FontVariantEmojiNormal), want: "font-variant-emoji: normal;"},
+	{name: "font-variant-ligatures", input: FontVariantLigatures(FontVariantLigaturesNormal), want: "font-variant-ligatures: normal;"},
+	{name: "font-variant-numeric", input: FontVariantNumeric(FontVariantNumericNormal), want: "font-variant-numeric: normal;"},
+	{name: "font-variant-position", input: FontVariantPosition(FontVariantPositionNormal), want: "font-variant-position: normal;"},
+	{name: "font-variation-settings", input: FontVariationSettings(Str("wght"), Num(400)), want: "font-variation-settings: \"wght\" 400;"},
+	{name: "font-variation-settings layers", input: FontVariationSettingsLayers([]FontVariationSettingsValue{Str("wght"), Num(400)}, []FontVariationSettingsValue{Str("wght"), Num(400)}), want: "font-variation-settings: \"wght\" 400, \"wght\" 400;"},
+	{name: "font-weight", input: FontWeight(FontWeightNormal), want: "font-weight: normal;"},
+	{name: "forced-color-adjust", input: ForcedColorAdjust(ForcedColorAdjustNone), want: "forced-color-adjust: none;"},
+	{name: "gap", input: Gap(PX(1)), want: "gap: 1px;"},
+	{name: "grid", input: GridProp(GridTemplateRowsNone), want: "grid: none;"},
+	{name: "grid-area", input: GridArea(Auto), want: "grid-area: auto;"},
+	{name: "grid-auto-columns", input: GridAutoColumns(GridAutoColumnsMinContent), want: "grid-auto-columns: min-content;"},
+	{name: "grid-auto-flow", input: GridAutoFlow(GridAutoFlowRow), want: "grid-auto-flow: row;"},
+	{name: "grid-auto-rows", input: GridAutoRows(GridAutoRowsMinContent), want: "grid-auto-rows: min-content;"},
+	{name: "grid-column", input: GridColumn(Auto), want: "grid-column: auto;"},
+	{name: "grid-column-end", input: GridColumnEnd(Auto), want: "grid-column-end: auto;"},
+	{name: "grid-column-start", input: GridColumnStart(Auto), want: "grid-column-start: auto;"},
+	{name: "grid-row", input: GridRow(Auto), want: "grid-row: auto;"},
+	{name: "grid-row-end", input: GridRowEnd(Auto), want: "grid-row-end: auto;"},
+	{name: "grid-row-start", input: GridRowStart(Auto), want: "grid-row-start: auto;"},
+	{name: "grid-template", input: GridTemplate(GridTemplateRowsNone), want: "grid-template: none;"},
+	{name: "grid-template-areas", input: GridTemplateAreas(GridTemplateAreasNone), want: "grid-template-areas: none;"},
+	{name: "grid-template-columns", input: GridTemplateColumns(GridTemplateColumnsNone), want: "grid-template-columns: none;"},
+	{name: "grid-template-rows", input: GridTemplateRows(GridTemplateRowsNone), want: "grid-template-rows: none;"},
+	{name: "hanging-punctuation", input: HangingPunctuation(HangingPunctuationNone), want: "hanging-punctuation: none;"},
+	{name: "hyphenate-character", input: HyphenateCharacter(Auto), want: "hyphenate-character: auto;"},
+	{name: "hyphenate-limit-chars", input: HyphenateLimitChars(Auto), want: "hyphenate-limit-chars: auto;"},
+	{name: "hyphens", input: Hyphens(HyphensNone), want: "hyphens: none;"},
+	{name: "image-orientation", input: ImageOrientation(ImageOrientationFromImage), want: "image-orientation: from-image;"},
+	{name: "image-rendering", input: ImageRendering(ImageRenderingCrispEdges), want: "image-rendering: crisp-edges;"},
+	{name: "initial-letter", input: InitialLetter(InitialLetterNormal), want: "initial-letter: normal;"},
+	{name: "inline-size", input: InlineSize(InlineSizeMinContent), want: "inline-size: min-content;"},
+	{name: "inset", input: InsetProp(PX(1)), want: "inset: 1px;"},
+	{name: "inset-block", input: InsetBlock(PX(1)), want: "inset-block: 1px;"},
+	{name: "inset-block-end", input: InsetBlockEnd(PX(1)), want: "inset-block-end: 1px;"},
+	{name: "inset-block-start", input: InsetBlockStart(PX(1)), want: "inset-block-start: 1px;"},
+	{name: "inset-inline", input: InsetInline(PX(1)), want: "inset-inline: 1px;"},
+	{name: "inset-inline-end", input: InsetInlineEnd(PX(1)), want: "inset-inline-end: 1px;"},
+	{name: "inset-inline-start", input: InsetInlineStart(PX(1)), want: "inset-inline-start: 1px;"},
+	{name: "interpolate-size", input: InterpolateSize(InterpolateSizeNumericOnly), want: "interpolate-size: numeric-only;"},
+	{name: "isolation", input: Isolation(IsolationIsolate), want: "isolation: isolate;"},
+	{name: "justify-content", input: JustifyContent(JustifyContentNormal), want: "justify-content: normal;"},
+	{name: "justify-items", input: JustifyItems(JustifyItemsNormal), want: "justify-items: normal;"},
+	{name: "justify-self", input: JustifySelf(JustifySelfNormal), want: "justify-self: normal;"},
+	{name: "left", input: Left(PX(1)), want: "left: 1px;"},
+	{name: "lighting-color", input: LightingColor(Red), want: "lighting-color: red;"},
+	{name: "line-break", input: LineBreak(LineBreakLoose), want: "line-break: loose;"},
+	{name: "line-clamp", input: LineClamp(LineClampNone), want: "line-clamp: none;"},
+	{name: "list-style", input: ListStyle(Ident("a")), want: "list-style: a;"},
+	{name: "list-style-image", input: ListStyleImage(ListStyleImageNone), want: "list-style-image: none;"},
+	{name: "list-style-position", input: ListStylePosition(ListStylePositionInside), want: "list-style-position: inside;"},
+	{name: "list-style-type", input: ListStyleType(ListStyleTypeNone), want: "list-style-type: none;"},
+	{name: "margin-block", input: MarginBlock(PX(1)), want: "margin-block: 1px;"},
+	{name: "margin-block-end", input: MarginBlockEnd(PX(1)), want: "margin-block-end: 1px;"},
+	{name: "margin-block-start", input: MarginBlockStart(PX(1)), want: "margin-block-start: 1px;"},
+	{name: "margin-bottom", input: MarginBottom(PX(1)), want: "margin-bottom: 1px;"},
+	{name: "margin-inline", input: MarginInline(PX(1)), want: "margin-inline: 1px;"},
+	{name: "margin-inline-end", input: MarginInlineEnd(PX(1)), want: "margin-inline-end: 1px;"},
+	{name: "margin-inline-start", input: MarginInlineStart(PX(1)), want: "margin-inline-start: 1px;"},
+	{name: "margin-left", input: MarginLeft(PX(1)), want: "margin-left: 1px;"},
+	{name: "margin-right", input: MarginRight(PX(1)), want: "margin-right: 1px;"},
+	{name: "margin-top", input: MarginTop(PX(1)), want: "margin-top: 1px;"},
+	{name: "margin-trim", input: MarginTrim(MarginTrimNone), want: "margin-trim: none;"},
+	{name: "marker", input: Marker(Url("a.png")), want: "marker: url('a.png');"},
+	{name: "marker-end", input: MarkerEnd(MarkerEndNone), want: "marker-end: none;"},
+	{name: "marker-mid", input: MarkerMid(MarkerMidNone), want: "marker-mid: none;"},
+	{name: "marker-start", input: MarkerStart(MarkerStartNone), want: "marker-start: none;"},
+	{name: "mask", input: Mask(Url("a.png"), MaskRepeatNoRepeat), want: "mask: url('a.png') no-repeat;"},
+	{name: "mask layers", input: MaskLayers([]MaskValue{Url("a.png"), MaskRepeatNoRepeat}, []MaskValue{Url("a.png"), MaskRepeatNoRepeat}), want: "mask: url('a.png') no-repeat, url('a.png') no-repeat;"},
+	{name: "mask-border", input: MaskBorder(Url("a.png")), want: "mask-border: url('a.png');"},
+	{name: "mask-border-mode", input: MaskBorderMode(MaskBorderModeLuminance), want: "mask-border-mode: luminance;"},
+	{name: "mask-border-outset", input: MaskBorderOutset(PX(1)), want: "mask-border-outset: 1px;"},
+	{name: "mask-border-repeat", input: MaskBorderRepeat(MaskBorderRepeatStretch), want: "mask-border-repeat: stretch;"},
+	{name: "mask-border-slice", input: MaskBorderSlice(Num(0.5)), want: "mask-border-slice: 0.5;"},
+	{name: "mask-border-source", input: MaskBorderSource(MaskBorderSourceNone), want: "mask-border-source: none;"},
+	{name: "mask-border-width", input: MaskBorderWidth(PX(1)), want: "mask-border-width: 1px;"},
+	{name: "mask-clip", input: MaskClip(MaskClipContentBox, MaskClipContentBox), want: "mask-clip: content-box, content-box;"},
+	{name: "mask-composite", input: MaskComposite(MaskCompositeAdd, MaskCompositeAdd), want: "mask-composite: add, add;"},
+	{name: "mask-image", input: MaskImage(MaskImageNone, MaskImageNone), want: "mask-image: none, none;"},
+	{name: "mask-mode", input: MaskMode(MaskModeAlpha, MaskModeAlpha), want: "mask-mode: alpha, alpha;"},
+	{name: "mask-origin", input: MaskOrigin(MaskOriginContentBox, MaskOriginContentBox), want: "mask-origin: content-box, content-box;"},
+	{name: "mask-position", input: MaskPosition(MaskPositionRight, PX(8)), want: "mask-position: right 8px;"},
+	{name: "mask-position layers", input: MaskPositionLayers([]MaskPositionValue{MaskPositionRight, PX(8)}, []MaskPositionValue{MaskPositionRight, PX(8)}), want: "mask-position: right 8px, right 8px;"},
+	{name: "mask-repeat", input: MaskRepeat(MaskRepeatRepeat, MaskRepeatNoRepeat), want: "mask-repeat: repeat no-repeat;"},
+	{name: "mask-repeat layers", input: MaskRepeatLayers([]MaskRepeatValue{MaskRepeatRepeat, MaskRepeatNoRepeat}, []MaskRepeatValue{MaskRepeatRepeat, MaskRepeatNoRepeat}), want: "mask-repeat: repeat no-repeat, repeat no-repeat;"},
+	{name: "mask-size", input: MaskSize(PCT(50), Auto), want: "mask-size: 50% auto;"},
+	{name: "mask-size layers", input: MaskSizeLayers([]MaskSizeValue{PCT(50), Auto}, []MaskSizeValue{PCT(50), Auto}), want: "mask-size: 50% auto, 50% auto;"},
+	{name: "mask-type", input: MaskType(MaskTypeLuminance), want: "mask-type: luminance;"},
+	{name: "math-depth", input: MathDepth(MathDepthAutoAdd), want: "math-depth: auto-add;"},
+	{name: "math-shift", input: MathShift(MathShiftNormal), want: "math-shift: normal;"},
+	{name: "math-style", input: MathStyle(MathStyleNormal), want: "math-style: normal;"},
+	{name: "max-block-size", input: MaxBlockSize(MaxBlockSizeNone), want: "max-block-size: none;"},
+	{name: "max-height", input: MaxHeight(MaxHeightNone), want: "max-height: none;"},
+	{name: "max-inline-size", input: MaxInlineSize(MaxInlineSizeNone), want: "max-inline-size: none;"},
+	{name: "max-width", input: MaxWidth(MaxWidthNone), want: "max-width: none;"},
+	{name: "min-block-size", input: MinBlockSize(MinBlockSizeMinContent), want: "min-block-size: min-content;"},
+	{name: "min-height", input: MinHeight(MinHeightMinContent), want: "min-height: min-content;"},
+	{name: "min-inline-size", input: MinInlineSize(MinInlineSizeMinContent), want: "min-inline-size: min-content;"},
+	{name: "min-width", input: MinWidth(MinWidthMinContent), want: "min-width: min-content;"},
+	{name: "mix-blend-mode", input: MixBlendMode(MixBlendModeNormal), want: "mix-blend-mode: normal;"},
+	{name: "object-fit", input: ObjectFit(ObjectFitFill), want: "object-fit: fill;"},
+	{name: "object-position", input: ObjectPosition(ObjectPositionLeft), want: "object-position: left;"},
+	{name: "object-view-box", input: ObjectViewBox(ObjectViewBoxNone), want: "object-view-box: none;"},
+	{name: "offset", input: Offset(Auto), want: "offset: auto;"},
+	{name: "offset-anchor", input: OffsetAnchor(OffsetAnchorLeft), want: "offset-anchor: left;"},
+	{name: "offset-distance", input: OffsetDistance(PX(1)), want: "offset-distance: 1px;"},
+	{name: "offset-path", input: OffsetPath(OffsetPathNone), want: "offset-path: none;"},
+	{name: "offset-position", input: OffsetPosition(OffsetPositionNormal), want: "offset-position: normal;"},
+	{name: "offset-rotate", input: OffsetRotate(OffsetRotateReverse), want: "offset-rotate: reverse;"},
+	{name: "opacity", input: Opacity(Num(0.5)), want: "opacity: 0.5;"},
+	{name: "order", input: Order(Int(1)), want: "order: 1;"},
+	{name: "orphans", input: Orphans(Int(1)), want: "orphans: 1;"},
+	{name: "outline", input: Outline(PX(1)), want: "outline: 1px;"},
+	{name: "outline-color", input: OutlineColor(Auto), want: "outline-color: auto;"},
+	{name: "outline-offset", input: OutlineOffset(PX(1)), want: "outline-offset: 1px;"},
+	{name: "outline-style", input: OutlineStyle(OutlineStyleNone), want: "outline-style: none;"},
+	{name: "outline-width", input: OutlineWidth(OutlineWidthThin), want: "outline-width: thin;"},
+	{name: "overflow", input: Overflow(OverflowVisible), want: "overflow: visible;"},
+	{name: "overflow-anchor", input: OverflowAnchor(OverflowAnchorNone), want: "overflow-anchor: none;"},
+	{name: "overflow-block", input: OverflowBlock(OverflowBlockVisible), want: "overflow-block: visible;"},
+	{name: "overflow-clip-margin", input: OverflowClipMargin(OverflowClipMarginContentBox), want: "overflow-clip-margin: content-box;"},
+	{name: "overflow-inline", input: OverflowInline(OverflowInlineVisible), want: "overflow-inline: visible;"},
+	{name: "overflow-wrap", input: OverflowWrap(OverflowWrapNormal), want: "overflow-wrap: normal;"},
+	{name: "overflow-x", input: OverflowX(OverflowXVisible), want: "overflow-x: visible;"},
+	{name: "overflow-y", input: OverflowY(OverflowYVisible), want: "overflow-y: visible;"},
+	{name: "overlay", input: Overlay(OverlayNone), want: "overlay: none;"},
+	{name: "overscroll-behavior", input: OverscrollBehavior(OverscrollBehaviorContain), want: "overscroll-behavior: contain;"},
+	{name: "overscroll-behavior-block", input: OverscrollBehaviorBlock(OverscrollBehaviorBlockContain), want: "overscroll-behavior-block: contain;"},
+	{name: "overscroll-behavior-inline", input: OverscrollBehaviorInline(OverscrollBehaviorInlineContain), want: "overscroll-behavior-inline: contain;"},
+	{name: "overscroll-behavior-x", input: OverscrollBehaviorX(OverscrollBehaviorXContain), want: "overscroll-behavior-x: contain;"},
+	{name: "overscroll-behavior-y", input: OverscrollBehaviorY(OverscrollBehaviorYContain), want: "overscroll-behavior-y: contain;"},
+	{name: "padding-block", input: PaddingBlock(PX(1)), want: "padding-block: 1px;"},
+	{name: "padding-block-end", input: PaddingBlockEnd(PX(1)), want: "padding-block-end: 1px;"},
+	{name: "padding-block-start", input: PaddingBlockStart(PX(1)), want: "padding-block-start: 1px;"},
+	{name: "padding-inline", input: PaddingInline(PX(1)), want: "padding-inline: 1px;"},
+	{name: "padding-inline-end", input: PaddingInlineEnd(PX(1)), want: "padding-inline-end: 1px;"},
+	{name: "padding-inline-start", input: PaddingInlineStart(PX(1)), want: "padding-inline-start: 1px;"},
+	{name: "page", input: Page(Auto), want: "page: auto;"},
+	{name: "page-break-after", input: PageBreakAfter(PageBreakAfterAlways), want: "page-break-after: always;"},
+	{name: "page-break-before", input: PageBreakBefore(PageBreakBeforeAlways), want: "page-break-before: always;"},
+	{name: "page-break-inside", input: PageBreakInside(PageBreakInsideAvoid), want: "page-break-inside: avoid;"},
+	{name: "paint-order", input: PaintOrder(PaintOrderNormal), want: "paint-order: normal;"},
+	{name: "perspective", input: Perspective(PerspectiveNone), want: "perspective: none;"},
+	{name: "perspective-origin", input: PerspectiveOrigin(PerspectiveOriginLeft), want: "perspective-origin: left;"},
+	{name: "place-content", input: PlaceContent(Inherit), want: "place-content: inherit;"},
+	{name: "place-items", input: PlaceItems(Inherit), want: "place-items: inherit;"},
+	{name: "place-self", input: PlaceSelf(Auto), want: "place-self: auto;"},
+	{name: "pointer-events", input: PointerEvents(PointerEventsNone), want: "pointer-events: none;"},
+	{name: "position", input: Position(PositionStatic), want: "position: static;"},
+	{name: "position-anchor", input: PositionAnchor(Auto), want: "position-anchor: auto;"},
+	{name: "position-area", input: PositionArea(PositionAreaNone), want: "position-area: none;"},
+	{name: "position-try", input: PositionTry(Ident("--a")), want: "position-try: --a;"},
+	{name: "position-try-fallbacks", input: PositionTryFallbacks(Ident("--a"), PositionTryFallbacksFlipBlock), want: "position-try-fallbacks: --a flip-block;"},
+	{name: "position-try-fallbacks layers", input: PositionTryFallbacksLayers([]PositionTryFallbacksValue{Ident("--a"), PositionTryFallbacksFlipBlock}, []PositionTryFallbacksValue{Ident("--a"), PositionTryFallbacksFlipBlock}), want: "position-try-fallbacks: --a flip-block, --a flip-block;"},
+	{name: "position-try-order", input: PositionTryOrder(PositionTryOrderNormal), want: "position-try-order: normal;"},
+	{name: "position-visibility", input: PositionVisibility(PositionVisibilityAlways), want: "position-visibility: always;"},
+	{name: "print-color-adjust", input: PrintColorAdjust(PrintColorAdjustEconomy), want: "print-color-adjust: economy;"},
+	{name: "quotes", input: Quotes(QuotesNone), want: "quotes: none;"},
+	{name: "r", input: R(PX(1)), want: "r: 1px;"},
+	{name: "reading-flow", input: ReadingFlow(ReadingFlowNormal), want: "reading-flow: normal;"},
+	{name: "resize", input: Resize(ResizeNone), want: "resize: none;"},
+	{name: "right", input: Right(PX(1)), want: "right: 1px;"},
+	{name: "rotate", input: Rotate(RotateNone), want: "rotate: none;"},
+	{name: "row-gap", input: RowGap(RowGapNormal), want: "row-gap: normal;"},
+	{name: "ruby-align", input: RubyAlign(RubyAlignStart), want: "ruby-align: start;"},
+	{name: "ruby-merge", input: RubyMerge(RubyMergeSeparate), want: "ruby-merge: separate;"},
+	{name: "ruby-position", input: RubyPosition(RubyPositionAlternate), want: "ruby-position: alternate;"},
+	{name: "rx", input: Rx(PX(1)), want: "rx: 1px;"},
+	{name: "ry", input: Ry(PX(1)), want: "ry: 1px;"},
+	{name: "scale", input: Scale(ScaleNone), want: "scale: none;"},
+	{name: "scroll-behavior", input: ScrollBehavior(ScrollBehaviorSmooth), want: "scroll-behavior: smooth;"},
+	{name: "scroll-initial-target", input: ScrollInitialTarget(ScrollInitialTargetNone), want: "scroll-initial-target: none;"},
+	{name: "scroll-margin", input: ScrollMargin(PX(1)), want: "scroll-margin: 1px;"},
+	{name: "scroll-margin-block", input: ScrollMarginBlock(PX(1)), want: "scroll-margin-block: 1px;"},
+	{name: "scroll-margin-block-end", input: ScrollMarginBlockEnd(PX(1)), want: "scroll-margin-block-end: 1px;"},
+	{name: "scroll-margin-block-start", input: ScrollMarginBlockStart(PX(1)), want: "scroll-margin-block-start: 1px;"},
+	{name: "scroll-margin-bottom", input: ScrollMarginBottom(PX(1)), want: "scroll-margin-bottom: 1px;"},
+	{name: "scroll-margin-inline", input: ScrollMarginInline(PX(1)), want: "scroll-margin-inline: 1px;"},
+	{name: "scroll-margin-inline-end", input: ScrollMarginInlineEnd(PX(1)), want: "scroll-margin-inline-end: 1px;"},
+	{name: "scroll-margin-inline-start", input: ScrollMarginInlineStart(PX(1)), want: "scroll-margin-inline-start: 1px;"},
+	{name: "scroll-margin-left", input: ScrollMarginLeft(PX(1)), want: "scroll-margin-left: 1px;"},
+	{name: "scroll-margin-right", input: ScrollMarginRight(PX(1)), want: "scroll-margin-right: 1px;"},
+	{name: "scroll-margin-top", input: ScrollMarginTop(PX(1)), want: "scroll-margin-top: 1px;"},
+	{name: "scroll-marker-group", input: ScrollMarkerGroup(ScrollMarkerGroupNone), want: "scroll-marker-group: none;"},
+	{name: "scroll-padding", input: ScrollPadding(Auto), want: "scroll-padding: auto;"},
+	{name: "scroll-padding-block", input: ScrollPaddingBlock(Auto), want: "scroll-padding-block: auto;"},
+	{name: "scroll-padding-block-end", input: ScrollPaddingBlockEnd(Auto), want: "scroll-padding-block-end: auto;"},
+	{name: "scroll-padding-block-start", input: ScrollPaddingBlockStart(Auto), want: "scroll-padding-block-start: auto;"},
+	{name: "scroll-padding-bottom", input: ScrollPaddingBottom(Auto), want: "scroll-padding-bottom: auto;"},
+	{name: "scroll-padding-inline", input: ScrollPaddingInline(Auto), want: "scroll-padding-inline: auto;"},
+	{name: "scroll-padding-inline-end", input: ScrollPaddingInlineEnd(Auto), want: "scroll-padding-inline-end: auto;"},
+	{name: "scroll-padding-inline-start", input: ScrollPaddingInlineStart(Auto), want: "scroll-padding-inline-start: auto;"},
+	{name: "scroll-padding-left", input: ScrollPaddingLeft(Auto), want: "scroll-padding-left: auto;"},
+	{name: "scroll-padding-right", input: ScrollPaddingRight(Auto), want: "scroll-padding-right: auto;"},
+	{name: "scroll-padding-top", input: ScrollPaddingTop(Auto), want: "scroll-padding-top: auto;"},
+	{name: "scroll-snap-align", input: ScrollSnapAlign(ScrollSnapAlignNone), want: "scroll-snap-align: none;"},
+	{name: "scroll-snap-stop", input: ScrollSnapStop(ScrollSnapStopNormal), want: "scroll-snap-stop: normal;"},
+	{name: "scroll-snap-type", input: ScrollSnapType(ScrollSnapTypeNone), want: "scroll-snap-type: none;"},
+	{name: "scroll-timeline", input: ScrollTimeline(Ident("--a"), ScrollTimelineAxisInline), want: "scroll-timeline: --a inline;"},
+	{name: "scroll-timeline layers", input: ScrollTimelineLayers([]ScrollTimelineValue{Ident("--a"), ScrollTimelineAxisInline}, []ScrollTimelineValue{Ident("--a"), ScrollTimelineAxisInline}), want: "scroll-timeline: --a inline, --a inline;"},
+	{name: "scroll-timeline-axis", input: ScrollTimelineAxis(ScrollTimelineAxisBlock, ScrollTimelineAxisBlock), want: "scroll-timeline-axis: block, block;"},
+	{name: "scroll-timeline-name", input: ScrollTimelineName(ScrollTimelineNameNone, ScrollTimelineNameNone), want: "scroll-timeline-name: none, none;"},
+	{name: "scrollbar-color", input: ScrollbarColor(Auto), want: "scrollbar-color: auto;"},
+	{name: "scrollbar-gutter", input: ScrollbarGutter(ScrollbarGutterStable), want: "scrollbar-gutter: stable;"},
+	{name: "scrollbar-width", input: ScrollbarWidth(ScrollbarWidthThin), want: "scrollbar-width: thin;"},
+	{name: "shape-image-threshold", input: ShapeImageThreshold(Num(0.5)), want: "shape-image-threshold: 0.5;"},
+	{name: "shape-margin", input: ShapeMargin(PX(1)), want: "shape-margin: 1px;"},
+	{name: "shape-outside", input: ShapeOutside(ShapeOutsideNone), want: "shape-outside: none;"},
+	{name: "shape-rendering", input: ShapeRendering(ShapeRenderingOptimizeSpeed), want: "shape-rendering: optimizeSpeed;"},
+	{name: "speak-as", input: SpeakAs(SpeakAsNormal), want: "speak-as: normal;"},
+	{name: "stop-color", input: StopColor(Red), want: "stop-color: red;"},
+	{name: "stop-opacity", input: StopOpacity(Num(0.5)), want: "stop-opacity: 0.5;"},
+	{name: "stroke", input: Stroke(StrokeNone), want: "stroke: none;"},
+	{name: "stroke-dasharray", input: StrokeDasharray(StrokeDasharrayNone), want: "stroke-dasharray: none;"},
+	{name: "stroke-dashoffset", input: StrokeDashoffset(PX(1)), want: "stroke-dashoffset: 1px;"},
+	{name: "stroke-linecap", input: StrokeLinecap(StrokeLinecapButt), want: "stroke-linecap: butt;"},
+	{name: "stroke-linejoin", input: StrokeLinejoin(StrokeLinejoinMiter), want: "stroke-linejoin: miter;"},
+	{name: "stroke-miterlimit", input: StrokeMiterlimit(Num(0.5)), want: "stroke-miterlimit: 0.5;"},
+	{name: "stroke-opacity", input: StrokeOpacity(Num(0.5)), want: "stroke-opacity: 0.5;"},
+	{name: "stroke-width", input: StrokeWidth(PX(1)), want: "stroke-width: 1px;"},
+	{name: "tab-size", input: TabSize(Int(1)), want: "tab-size: 1;"},
+	{name: "table-layout", input: TableLayout(TableLayoutFixed), want: "table-layout: fixed;"},
+	{name: "text-align", input: TextAlign(TextAlignStart), want: "text-align: start;"},
+	{name: "text-align-last", input: TextAlignLast(TextAlignLastStart), want: "text-align-last: start;"},
+	{name: "text-anchor", input: TextAnchor(TextAnchorStart), want: "text-anchor: start;"},
+	{name: "text-autospace", input: TextAutospace(TextAutospaceNormal), want: "text-autospace: normal;"},
+	{name: "text-box", input: TextBox(TextBoxNormal), want: "text-box: normal;"},
+	{name: "text-box-edge", input: TextBoxEdge(TextBoxEdgeText), want: "text-box-edge: text;"},
+	{name: "text-box-trim", input: TextBoxTrim(TextBoxTrimNone), want: "text-box-trim: none;"},
+	{name: "text-combine-upright", input: TextCombineUpright(TextCombineUprightNone), want: "text-combine-upright: none;"},
+	{name: "text-decoration", input: TextDecoration(Red), want: "text-decoration: red;"},
+	{name: "text-decoration-color", input: TextDecorationColor(Red), want: "text-decoration-color: red;"},
+	{name: "text-decoration-line", input: TextDecorationLine(TextDecorationLineNone), want: "text-decoration-line: none;"},
+	{name: "text-decoration-skip-ink", input: TextDecorationSkipInk(TextDecorationSkipInkAll), want: "text-decoration-skip-ink: all;"},
+	{name: "text-decoration-style", input: TextDecorationStyle(TextDecorationStyleSolid), want: "text-decoration-style: solid;"},
+	{name: "text-decoration-thickness", input: TextDecorationThickness(TextDecorationThicknessFromFont), want: "text-decoration-thickness: from-font;"},
+	{name: "text-emphasis", input: TextEmphasis(Str("a")), want: "text-emphasis: \"a\";"},
+	{name: "text-emphasis-color", input: TextEmphasisColor(Red), want: "text-emphasis-color: red;"},
+	{name: "text-emphasis-position", input: TextEmphasisPosition(TextEmphasisPositionOver), want: "text-emphasis-position: over;"},
+	{name: "text-emphasis-style", input: TextEmphasisStyle(TextEmphasisStyleNone), want: "text-emphasis-style: none;"},
+	{name: "text-indent", input: TextIndent(PX(1)), want: "text-indent: 1px;"},
+	{name: "text-justify", input: TextJustify(TextJustifyInterCharacter), want: "text-justify: inter-character;"},
+	{name: "text-orientation", input: TextOrientation(TextOrientationMixed), want: "text-orientation: mixed;"},
+	{name: "text-overflow", input: TextOverflow(TextOverflowClip), want: "text-overflow: clip;"},
+	{name: "text-rendering", input: TextRendering(TextRenderingOptimizeSpeed), want: "text-rendering: optimizeSpeed;"},
+	{name: "text-shadow", input: TextShadow(PX(1), PX(2), Red), want: "text-shadow: 1px 2px red;"},
+	{name: "text-shadow layers", input: TextShadowLayers([]TextShadowValue{PX(1), PX(2), Red}, []TextShadowValue{PX(1), PX(2), Red}), want: "text-shadow: 1px 2px red, 1px 2px red;"},
+	{name: "text-size-adjust", input: TextSizeAdjust(TextSizeAdjustNone), want: "text-size-adjust: none;"},
+	{name: "text-spacing-trim", input: TextSpacingTrim(TextSpacingTrimSpaceAll), want: "text-spacing-trim: space-all;"},
+	{name: "text-transform", input: TextTransform(TextTransformNone), want: "text-transform: none;"},
+	{name: "text-underline-offset", input: TextUnderlineOffset(Auto), want: "text-underline-offset: auto;"},
+	{name: "text-underline-position", input: TextUnderlinePosition(TextUnderlinePositionFromFont), want: "text-underline-position: from-font;"},
+	{name: "text-wrap", input: TextWrap(Auto), want: "text-wrap: auto;"},
+	{name: "text-wrap-mode", input: TextWrapMode(TextWrapModeWrap), want: "text-wrap-mode: wrap;"},
+	{name: "text-wrap-style", input: TextWrapStyle(TextWrapStyleBalance), want: "text-wrap-style: balance;"},
+	{name: "timeline-scope", input: TimelineScope(Ident("--a")), want: "timeline-scope: --a;"},
+	{name: "top", input: Top(PX(1)), want: "top: 1px;"},
+	{name: "touch-action", input: TouchAction(TouchActionNone), want: "touch-action: none;"},
+	{name: "transform", input: Transform(TransformNone), want: "transform: none;"},
+	{name: "transform-box", input: TransformBox(TransformBoxContentBox), want: "transform-box: content-box;"},
+	{name: "transform-origin", input: TransformOrigin(TransformOriginLeft), want: "transform-origin: left;"},
+	{name: "transform-style", input: TransformStyle(TransformStyleFlat), want: "transform-style: flat;"},
+	{name: "transition", input: Transition(Ident("opacity"), MS(200)), want: "transition: opacity 200ms;"},
+	{name: "transition layers", input: TransitionLayers([]TransitionValue{Ident("opacity"), MS(200)}, []TransitionValue{Ident("opacity"), MS(200)}), want: "transition: opacity 200ms, opacity 200ms;"},
+	{name: "transition-behavior", input: TransitionBehavior(TransitionBehaviorNormal, TransitionBehaviorNormal), want: "transition-behavior: normal, normal;"},
+	{name: "transition-delay", input: TransitionDelay(MS(200), MS(200)), want: "transition-delay: 200ms, 200ms;"},
+	{name: "transition-duration", input: TransitionDuration(MS(200), MS(200)), want: "transition-duration: 200ms, 200ms;"},
+	{name: "transition-property", input: TransitionProperty(TransitionPropertyNone, TransitionPropertyNone), want: "transition-property: none, none;"},
+	{name: "transition-timing-function", input: TransitionTimingFunction(TransitionTimingFunctionLinear, TransitionTimingFunctionLinear), want: "transition-timing-function: linear, linear;"},
+	{name: "translate", input: Translate(TranslateNone), want: "translate: none;"},
+	{name: "unicode-bidi", input: UnicodeBidi(UnicodeBidiNormal), want: "unicode-bidi: normal;"},
+	{name: "user-select", input: UserSelect(UserSelectText), want: "user-select: text;"},
+	{name: "vector-effect", input: VectorEffect(VectorEffectNone), want: "vector-effect: none;"},
+	{name: "vertical-align", input: VerticalAlign(VerticalAlignBaseline), want: "vertical-align: baseline;"},
+	{name: "view-timeline", input: ViewTimeline(Ident("--a"), ViewTimelineAxisInline), want: "view-timeline: --a inline;"},
+	{name: "view-timeline layers", input: ViewTimelineLayers([]ViewTimelineValue{Ident("--a"), ViewTimelineAxisInline}, []ViewTimelineValue{Ident("--a"), ViewTimelineAxisInline}), want: "view-timeline: --a inline, --a inline;"},
+	{name: "view-timeline-axis", input: ViewTimelineAxis(ViewTimelineAxisBlock, ViewTimelineAxisBlock), want: "view-timeline-axis: block, block;"},
+	{name: "view-timeline-inset", input: ViewTimelineInset(PX(10), Auto), want: "view-timeline-inset: 10px auto;"},
+	{name: "view-timeline-inset layers", input: ViewTimelineInsetLayers([]ViewTimelineInsetValue{PX(10), Auto}, []ViewTimelineInsetValue{PX(10), Auto}), want: "view-timeline-inset: 10px auto, 10px auto;"},
+	{name: "view-timeline-name", input: ViewTimelineName(ViewTimelineNameNone, ViewTimelineNameNone), want: "view-timeline-name: none, none;"},
+	{name: "view-transition-class", input: ViewTransitionClass(ViewTransitionClassNone), want: "view-transition-class: none;"},
+	{name: "view-transition-name", input: ViewTransitionName(ViewTransitionNameNone), want: "view-transition-name: none;"},
+	{name: "visibility", input: Visibility(VisibilityVisible), want: "visibility: visible;"},
+	{name: "white-space", input: WhiteSpace(WhiteSpaceNormal), want: "white-space: normal;"},
+	{name: "white-space-collapse", input: WhiteSpaceCollapse(WhiteSpaceCollapseCollapse), want: "white-space-collapse: collapse;"},
+	{name: "widows", input: Widows(Int(1)), want: "widows: 1;"},
+	{name: "will-change", input: WillChange(WillChangeScrollPosition, WillChangeScrollPosition), want: "will-change: scroll-position, scroll-position;"},
+	{name: "word-break", input: WordBreak(WordBreakNormal), want: "word-break: normal;"},
+	{name: "word-spacing", input: WordSpacing(WordSpacingNormal), want: "word-spacing: normal;"},
+	{name: "word-wrap", input: WordWrap(WordWrapNormal), want: "word-wrap: normal;"},
+	{name: "writing-mode", input: WritingMode(WritingModeHorizontalTb), want: "writing-mode: horizontal-tb;"},
+	{name: "x", input: X(PX(1)), want: "x: 1px;"},
+	{name: "y", input: Y(PX(1)), want: "y: 1px;"},
+	{name: "zoom", input: Zoom(ZoomNormal), want: "zoom: normal;"},
+}
+
+func TestGeneratedProperties(t *testing.T) {
+	RunTests(t, generatedPropertyTests...)
+}
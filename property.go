@@ -5,6 +5,8 @@ import (
 	"strings"
 )
 
+//go:generate go run ./internal/cmd/genprops
+
 // PropertyNode represents a CSS property node.
 // Any type implementing this interface can be rendered as a valid CSS property.
 type PropertyNode interface {
@@ -28,10 +30,36 @@ func (p Property) String() string {
 
 func (p Property) propertyNode() {}
 
+// Important marks every declaration of the property as `!important`, including each
+// declaration of grouped properties, so that it overrides normal declarations from other
+// stylesheets, such as those of third-party widgets.
+// Example: TextColor(Red).Important() -> "color: red !important;"
+// Example: GroupProps(TextColor(Red), Width(PX(10))).Important() -> "color: red !important;width: 10px !important;"
+func (p Property) Important() Property {
+	return Property(func(w io.Writer) error {
+		decls, err := declarations(w, p)
+		if err != nil {
+			return err
+		}
+
+		for _, decl := range decls {
+			decl.Important = true
+			if err := decl.RenderCSS(w); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 // Prop creates a CSS property with a name and one or more values.
 // Example: Prop("color", Red) -> "color: red;"
 func Prop(name string, values ...ValueNode) Property {
 	return Property(func(w io.Writer) error {
+		if err := check(w, func() error { return validateValues(name, values, " ") }); err != nil {
+			return err
+		}
+
 		if _, err := w.Write([]byte(name + ":")); err != nil {
 			return err
 		}
@@ -53,6 +81,36 @@ func Prop(name string, values ...ValueNode) Property {
 	})
 }
 
+// commaProp creates a CSS property whose values are a comma-separated list.
+// Example: commaProp("transition-property", Ident("color"), Ident("opacity")) -> "transition-property: color, opacity;"
+func commaProp(name string, values ...ValueNode) Property {
+	return Property(func(w io.Writer) error {
+		if err := check(w, func() error { return validateValues(name, values, ", ") }); err != nil {
+			return err
+		}
+
+		if _, err := w.Write([]byte(name + ":")); err != nil {
+			return err
+		}
+
+		for i, value := range values {
+			sep := " "
+			if i > 0 {
+				sep = ", "
+			}
+			if _, err := w.Write([]byte(sep)); err != nil {
+				return err
+			}
+			if err := value.RenderCSS(w); err != nil {
+				return err
+			}
+		}
+
+		_, err := w.Write([]byte(";"))
+		return err
+	})
+}
+
 // GroupProps groups multiple properties into a single property.
 // This is useful for rendering multiple properties together.
 func GroupProps(props ...PropertyNode) Property {
@@ -374,3 +432,21 @@ func FlexDirection(value FlexDirectionValue) Property {
 func BackgroundImage(value UrlValue) Property {
 	return Prop("background-image", value)
 }
+
+// ColorScheme creates a "color-scheme" property using one or more ColorSchemeValues.
+// Example: ColorScheme(LightScheme, DarkScheme) -> "color-scheme: light dark;"
+func ColorScheme(values ...ColorSchemeValue) Property {
+	return Prop("color-scheme", valueNodes(values)...)
+}
+
+// FontFamily creates a "font-family" property from a prioritized list of font families.
+// Example: FontFamily(FontName("Helvetica Neue"), SansSerif) -> `font-family: "Helvetica Neue", sans-serif;`
+func FontFamily(families ...FontFamilyValue) Property {
+	return commaProp("font-family", valueNodes(families)...)
+}
+
+// Content creates a "content" property, used with the ::before and ::after pseudo-elements.
+// Example: Content(Str("→ ")) -> `content: "→ ";`
+func Content(values ...ContentValue) Property {
+	return Prop("content", valueNodes(values)...)
+}
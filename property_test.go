@@ -17,6 +17,25 @@ func TestGroupProps(t *testing.T) {
 	)
 }
 
+func TestImportant(t *testing.T) {
+	RunTests(t,
+		test{"single property", TextColor(Red).Important(), "color: red !important;"},
+		test{"grouped properties",
+			GroupProps(TextColor(Red), Padding2(PX(8), PX(16))).Important(),
+			"color: red !important;padding: 8px 16px !important;",
+		},
+		test{"twice", TextColor(Red).Important().Important(), "color: red !important;"},
+		test{"important wins when merged",
+			MergeProps(TextColor(Red).Important(), TextColor(Blue), Width(PX(1)), Width(PX(2)).Important()),
+			"color: red !important;width: 2px !important;",
+		},
+		test{"important longhands are kept after the shorthand",
+			MergeProps(PaddingLeft(PX(4)).Important(), Padding1(PX(8))),
+			"padding-left: 4px !important;padding: 8px;",
+		},
+	)
+}
+
 func TestTextColor(t *testing.T) {
 	RunTests(t,
 		test{"named color",
@@ -794,3 +813,41 @@ func TestBackgroundImage(t *testing.T) {
 		},
 	)
 }
+
+func TestColorScheme(t *testing.T) {
+	RunTests(t,
+		test{"light dark", ColorScheme(LightScheme, DarkScheme), "color-scheme: light dark;"},
+		test{"only dark", ColorScheme(OnlyScheme, DarkScheme), "color-scheme: only dark;"},
+		test{"normal", ColorScheme(NormalScheme), "color-scheme: normal;"},
+		test{"inherit", ColorScheme(Inherit), "color-scheme: inherit;"},
+	)
+}
+
+func TestFontFamily(t *testing.T) {
+	RunTests(t,
+		test{"single generic family", FontFamily(Monospace), "font-family: monospace;"},
+		test{"font name with fallback", FontFamily(FontName("Helvetica Neue"), FontName("Arial"), SansSerif), `font-family: "Helvetica Neue", "Arial", sans-serif;`},
+		test{"inherit", FontFamily(Inherit), "font-family: inherit;"},
+	)
+}
+
+func TestLayeredProperties(t *testing.T) {
+	RunTests(t,
+		test{"transition", Transition(Ident("opacity"), MS(200)), "transition: opacity 200ms;"},
+		test{"transition layers",
+			TransitionLayers([]TransitionValue{Ident("opacity"), MS(200)}, []TransitionValue{Ident("transform"), S(1), TransitionTimingFunctionEaseIn}),
+			"transition: opacity 200ms, transform 1s ease-in;",
+		},
+		test{"transition property list", TransitionProperty(Ident("opacity"), Ident("transform")), "transition-property: opacity, transform;"},
+		test{"box-shadow", BoxShadow(PX(1), PX(2), Red), "box-shadow: 1px 2px red;"},
+		test{"box-shadow layers",
+			BoxShadowLayers([]BoxShadowValue{PX(1), PX(2), Red}, []BoxShadowValue{PX(0), PX(0), PX(4), Blue}),
+			"box-shadow: 1px 2px red, 0px 0px 4px blue;",
+		},
+		test{"animation", Animation(Ident("fade"), S(1)), "animation: fade 1s;"},
+		test{"animation layers",
+			AnimationLayers([]AnimationValue{Ident("fade"), S(1)}, []AnimationValue{Ident("spin"), MS(500), AnimationIterationCountInfinite}),
+			"animation: fade 1s, spin 500ms infinite;",
+		},
+	)
+}
@@ -0,0 +1,174 @@
+package cssgo
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// propertiesJSON is a vendored property dataset, which follows the layout of the mdn-data
+// package. The typed constructors in properties_gen.go are generated from the same file.
+//
+//go:embed data/properties.json
+var propertiesJSON []byte
+
+// PropertyInfo describes a standard CSS property.
+type PropertyInfo struct {
+	// Name is the name of the property, e.g. "margin-top".
+	Name string
+	// Syntax is the value definition syntax of the property, e.g. "<length-percentage> | auto".
+	// Named data types such as <line-style> are described by LookupSyntax.
+	Syntax string
+	// Initial is the initial value of the property. It is empty for shorthands,
+	// which take the initial value of each of their longhands.
+	Initial string
+	// Inherited reports whether the property is inherited by default.
+	Inherited bool
+	// Animatable reports whether the property can be animated, even discretely.
+	// A shorthand is animatable if any of its longhands is.
+	Animatable bool
+	// AnimationType is how the property is interpolated, e.g. "discrete", "color",
+	// "lpc" (length, percentage or calc()) or "notAnimatable". It is empty for shorthands.
+	AnimationType string
+	// Longhands lists every longhand that a shorthand sets, expanding nested shorthands,
+	// e.g. "border" sets "border-top-width" through "border-width". It is empty for longhands.
+	Longhands []string
+	// Shorthands lists the shorthands that set the property, including nested ones,
+	// e.g. "border-top-width" is set by "border", "border-top" and "border-width".
+	Shorthands []string
+}
+
+// IsShorthand reports whether the property is a shorthand for other properties.
+func (p PropertyInfo) IsShorthand() bool {
+	return len(p.Longhands) > 0
+}
+
+// propertyData is the schema of data/properties.json.
+type propertyData struct {
+	Properties map[string]struct {
+		Syntax    string `json:"syntax"`
+		Inherited bool   `json:"inherited"`
+		// Initial and AnimationType are strings for longhands, and lists of longhands for shorthands.
+		Initial       json.RawMessage `json:"initial"`
+		AnimationType json.RawMessage `json:"animationType"`
+	} `json:"properties"`
+	Syntaxes map[string]struct {
+		Syntax string `json:"syntax"`
+	} `json:"syntaxes"`
+}
+
+var (
+	properties    = map[string]PropertyInfo{}
+	propertyNames []string
+	syntaxes      = map[string]string{}
+)
+
+func init() {
+	var data propertyData
+	if err := json.Unmarshal(propertiesJSON, &data); err != nil {
+		panic(fmt.Sprintf("cssgo: invalid property dataset: %v", err))
+	}
+
+	direct := map[string][]string{}
+	for name, p := range data.Properties {
+		info := PropertyInfo{Name: name, Syntax: p.Syntax, Inherited: p.Inherited}
+		if err := json.Unmarshal(p.Initial, &info.Initial); err != nil {
+			var longhands []string
+			if err := json.Unmarshal(p.Initial, &longhands); err != nil {
+				panic(fmt.Sprintf("cssgo: invalid initial value of %s: %v", name, err))
+			}
+			direct[name] = longhands
+		}
+		_ = json.Unmarshal(p.AnimationType, &info.AnimationType)
+
+		properties[name] = info
+		propertyNames = append(propertyNames, name)
+	}
+	sort.Strings(propertyNames)
+
+	for name, s := range data.Syntaxes {
+		syntaxes[name] = s.Syntax
+	}
+
+	var expand func(name string) []string
+	expand = func(name string) []string {
+		var out []string
+		for _, l := range direct[name] {
+			if _, ok := direct[l]; ok {
+				out = append(out, expand(l)...)
+			} else {
+				out = append(out, l)
+			}
+		}
+		return out
+	}
+
+	// Resolve shorthands once every property is known, as longhands can be shorthands themselves.
+	for _, name := range propertyNames {
+		if _, ok := direct[name]; !ok {
+			continue
+		}
+
+		info := properties[name]
+		info.Longhands = expand(name)
+		for _, l := range info.Longhands {
+			info.Animatable = info.Animatable || properties[l].AnimationType != "notAnimatable"
+		}
+		properties[name] = info
+
+		for _, l := range nestedLonghands(direct, name) {
+			part := properties[l]
+			part.Shorthands = append(part.Shorthands, name)
+			properties[l] = part
+		}
+	}
+
+	for name, info := range properties {
+		if _, ok := direct[name]; !ok {
+			info.Animatable = info.AnimationType != "notAnimatable"
+		}
+		sort.Strings(info.Shorthands)
+		info.Shorthands = slices.Compact(info.Shorthands)
+		properties[name] = info
+	}
+}
+
+// nestedLonghands returns the properties that a shorthand sets, including nested shorthands.
+func nestedLonghands(direct map[string][]string, name string) []string {
+	var out []string
+	for _, l := range direct[name] {
+		out = append(out, l)
+		out = append(out, nestedLonghands(direct, l)...)
+	}
+	return out
+}
+
+// LookupProperty returns the description of a standard CSS property,
+// and whether the property is known. Custom properties are not known.
+// Example: LookupProperty("color") -> PropertyInfo{Name: "color", Initial: "canvastext", Inherited: true, ...}, true
+func LookupProperty(name string) (PropertyInfo, bool) {
+	info, ok := properties[name]
+	if !ok {
+		return PropertyInfo{}, false
+	}
+
+	// Copy the slices, so that callers cannot modify the registry.
+	info.Longhands = append([]string(nil), info.Longhands...)
+	info.Shorthands = append([]string(nil), info.Shorthands...)
+	return info, true
+}
+
+// PropertyNames returns the names of the known standard CSS properties in alphabetical order.
+func PropertyNames() []string {
+	return append([]string(nil), propertyNames...)
+}
+
+// LookupSyntax returns the definition of a named data type used in property syntaxes,
+// and whether it is known. Basic data types such as <length> or <color> are not defined.
+// Example: LookupSyntax("line-style") -> "none | hidden | dotted | dashed | solid | double | groove | ridge | inset | outset", true
+func LookupSyntax(name string) (string, bool) {
+	s, ok := syntaxes[name]
+	return s, ok
+}
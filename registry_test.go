@@ -0,0 +1,102 @@
+package cssgo
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestLookupProperty(t *testing.T) {
+	tests := []struct {
+		name string
+		want PropertyInfo
+	}{
+		{
+			name: "color",
+			want: PropertyInfo{
+				Name: "color", Syntax: "<color>", Initial: "canvastext", Inherited: true,
+				Animatable: true, AnimationType: "color",
+			},
+		},
+		{
+			name: "border-top-width",
+			want: PropertyInfo{
+				Name: "border-top-width", Syntax: "<line-width>", Initial: "medium",
+				Animatable: true, AnimationType: "length",
+				Shorthands: []string{"border", "border-top", "border-width"},
+			},
+		},
+		{
+			name: "flex",
+			want: PropertyInfo{
+				Name: "flex", Syntax: "none | [ <'flex-grow'> <'flex-shrink'>? || <'flex-basis'> ]",
+				Animatable: true, Longhands: []string{"flex-grow", "flex-shrink", "flex-basis"},
+			},
+		},
+		{
+			name: "scroll-behavior",
+			want: PropertyInfo{
+				Name: "scroll-behavior", Syntax: "auto | smooth", Initial: "auto", AnimationType: "notAnimatable",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		got, ok := LookupProperty(test.name)
+		if !ok {
+			t.Fatalf("TESTCASE %s: property not found", test.name)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Fatalf("TESTCASE %s: FAIL\ngot: %+v != want: %+v", test.name, got, test.want)
+		}
+	}
+
+	border, _ := LookupProperty("border")
+	if !border.IsShorthand() || len(border.Longhands) != 12 || border.Longhands[0] != "border-top-width" {
+		t.Fatalf("unexpected border longhands: %v", border.Longhands)
+	}
+
+	for _, name := range []string{"colro", "--brand", ""} {
+		if _, ok := LookupProperty(name); ok {
+			t.Fatalf("TESTCASE %s: expected unknown property", name)
+		}
+	}
+}
+
+func TestPropertyRegistryIsConsistent(t *testing.T) {
+	names := PropertyNames()
+	if !sort.StringsAreSorted(names) || len(names) < 300 {
+		t.Fatalf("unexpected property names: %d", len(names))
+	}
+
+	for _, name := range names {
+		info, _ := LookupProperty(name)
+		for _, l := range info.Longhands {
+			longhand, ok := LookupProperty(l)
+			if !ok || longhand.IsShorthand() {
+				t.Fatalf("%s: longhand %s is unknown or a shorthand", name, l)
+			}
+		}
+		for _, s := range info.Shorthands {
+			if shorthand, ok := LookupProperty(s); !ok || !shorthand.IsShorthand() {
+				t.Fatalf("%s: shorthand %s is unknown or not a shorthand", name, s)
+			}
+		}
+	}
+
+	// The registry cannot be modified through the returned values.
+	flex, _ := LookupProperty("flex")
+	flex.Longhands[0] = "x"
+	if again, _ := LookupProperty("flex"); again.Longhands[0] != "flex-grow" {
+		t.Fatalf("registry was modified: %v", again.Longhands)
+	}
+}
+
+func TestLookupSyntax(t *testing.T) {
+	if got, ok := LookupSyntax("line-width"); !ok || got != "<length [0,∞]> | thin | medium | thick" {
+		t.Fatalf("got: %q, %v", got, ok)
+	}
+	if _, ok := LookupSyntax("length"); ok {
+		t.Fatalf("basic data types are not defined")
+	}
+}
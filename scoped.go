@@ -0,0 +1,35 @@
+package cssgo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// ScopedPrefix is the prefix of class names generated by ScopedClass.
+const ScopedPrefix = "css-"
+
+// ScopedClass derives a stable class name from the rendered declarations and returns
+// it together with the rule that applies the declarations to that class.
+// Equal declarations always produce the same class name, so the rule can be
+// deduplicated when several components share a style.
+// Example: ScopedClass(TextColor(Red)) -> "css-1a2b3c4d", `.css-1a2b3c4d{color: red;}`
+//
+// Parameters:
+// - props (...PropertyNode): The declarations of the scoped style.
+//
+// Returns:
+// - string: The generated class name.
+// - RuleNodeFunc: A function that renders the rule for the class.
+func ScopedClass(props ...PropertyNode) (string, RuleNodeFunc) {
+	var b strings.Builder
+	for _, prop := range props {
+		// Errors are reported when the rule is rendered.
+		_ = prop.RenderCSS(&b)
+	}
+
+	sum := sha256.Sum256([]byte(b.String()))
+	name := ScopedPrefix + hex.EncodeToString(sum[:4])
+
+	return name, Class(name).Props(props...)
+}
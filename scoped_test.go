@@ -0,0 +1,33 @@
+package cssgo
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScopedClass(t *testing.T) {
+	name, rule := ScopedClass(TextColor(Red), Padding1(PX(8)))
+
+	if !strings.HasPrefix(name, ScopedPrefix) || len(name) != len(ScopedPrefix)+8 {
+		t.Fatalf("unexpected class name %q", name)
+	}
+
+	RunTests(t,
+		test{"scoped rule", rule, "." + name + "{color: red;padding: 8px;}"},
+	)
+
+	again, _ := ScopedClass(TextColor(Red), Padding1(PX(8)))
+	if again != name {
+		t.Fatalf("class names should be stable: %q != %q", again, name)
+	}
+
+	grouped, _ := ScopedClass(GroupProps(TextColor(Red), Padding1(PX(8))))
+	if grouped != name {
+		t.Fatalf("class names should only depend on the declarations: %q != %q", grouped, name)
+	}
+
+	other, _ := ScopedClass(TextColor(Blue), Padding1(PX(8)))
+	if other == name {
+		t.Fatalf("different declarations should produce different class names: %q", other)
+	}
+}
@@ -147,3 +147,81 @@ func ID(name string) Selector {
 func El(name string) Selector {
 	return selector("", name)
 }
+
+// Root creates the `:root` pseudo-class selector, which matches the document's root element.
+// Example: Root() -> `:root`
+//
+// Returns:
+// - Selector: A Selector instance representing the root selector.
+func Root() Selector {
+	return selector("", ":root")
+}
+
+// Attr creates a CSS attribute selector that matches elements whose attribute equals a value.
+// The value is quoted and escaped as a CSS string.
+// Example: Attr("data-theme", "dark") -> `[data-theme="dark"]`
+//
+// Parameters:
+// - name (string): The attribute name.
+// - value (string): The exact attribute value to match.
+//
+// Returns:
+// - Selector: A Selector instance representing the attribute selector.
+func Attr(name, value string) Selector {
+	return selector("", "["+name+"="+quote(value, '"')+"]")
+}
+
+// PseudoClass creates a pseudo-class selector.
+// On its own it matches any element in that state; nested with Nest it applies to the parent rule.
+// Example: PseudoClass("hover") -> `:hover`
+//
+// Parameters:
+// - name (string): The pseudo-class name, without the colon (e.g., `hover`, `nth-child(2n)`).
+//
+// Returns:
+// - Selector: A Selector instance representing the pseudo-class selector.
+func PseudoClass(name string) Selector {
+	return selector(":", name)
+}
+
+// PseudoElement creates a pseudo-element selector.
+// Example: PseudoElement("before") -> `::before`
+//
+// Parameters:
+// - name (string): The pseudo-element name, without the colons (e.g., `before`, `placeholder`).
+//
+// Returns:
+// - Selector: A Selector instance representing the pseudo-element selector.
+func PseudoElement(name string) Selector {
+	return selector("::", name)
+}
+
+// Hover creates the `:hover` pseudo-class selector.
+func Hover() Selector { return PseudoClass("hover") }
+
+// Focus creates the `:focus` pseudo-class selector.
+func Focus() Selector { return PseudoClass("focus") }
+
+// FocusVisible creates the `:focus-visible` pseudo-class selector.
+func FocusVisible() Selector { return PseudoClass("focus-visible") }
+
+// FocusWithin creates the `:focus-within` pseudo-class selector.
+func FocusWithin() Selector { return PseudoClass("focus-within") }
+
+// Active creates the `:active` pseudo-class selector.
+func Active() Selector { return PseudoClass("active") }
+
+// Disabled creates the `:disabled` pseudo-class selector.
+func Disabled() Selector { return PseudoClass("disabled") }
+
+// FirstChild creates the `:first-child` pseudo-class selector.
+func FirstChild() Selector { return PseudoClass("first-child") }
+
+// LastChild creates the `:last-child` pseudo-class selector.
+func LastChild() Selector { return PseudoClass("last-child") }
+
+// Before creates the `::before` pseudo-element selector.
+func Before() Selector { return PseudoElement("before") }
+
+// After creates the `::after` pseudo-element selector.
+func After() Selector { return PseudoElement("after") }
@@ -20,6 +20,9 @@ func (g GlobalType) borderStyleValue()   {}
 func (g GlobalType) borderWidthValue()   {}
 func (g GlobalType) flexDirectionValue() {}
 func (g GlobalType) urlValue()           {}
+func (g GlobalType) colorSchemeValue()   {}
+func (g GlobalType) fontFamilyValue()    {}
+func (g GlobalType) contentValue()       {}
 
 const (
 	Inherit GlobalType = "inherit"
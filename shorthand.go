@@ -0,0 +1,106 @@
+package cssgo
+
+import "strings"
+
+// boxShorthands maps the shorthands taking one to four values to their
+// longhands, in top, right, bottom, left order.
+var boxShorthands = map[string][4]string{
+	"padding":      {"padding-top", "padding-right", "padding-bottom", "padding-left"},
+	"margin":       {"margin-top", "margin-right", "margin-bottom", "margin-left"},
+	"border-width": {"border-top-width", "border-right-width", "border-bottom-width", "border-left-width"},
+	"border-style": {"border-top-style", "border-right-style", "border-bottom-style", "border-left-style"},
+	"border-color": {"border-top-color", "border-right-color", "border-bottom-color", "border-left-color"},
+}
+
+// boxShorthandOrder lists the box shorthands in a fixed order, for deterministic output.
+var boxShorthandOrder = []string{"margin", "padding", "border-width", "border-style", "border-color"}
+
+// longhands returns the longhands set by a property, which is the property itself unless it is a shorthand.
+func longhands(property string) []string {
+	if info, ok := properties[property]; ok && info.IsShorthand() {
+		return info.Longhands
+	}
+	return []string{property}
+}
+
+// overrides reports whether a declaration of property `later` sets every longhand
+// that a declaration of property `earlier` sets, e.g. `padding` overrides `padding-left`.
+func overrides(later, earlier string) bool {
+	if later == earlier {
+		return true
+	}
+
+	set := map[string]bool{}
+	for _, l := range longhands(later) {
+		set[l] = true
+	}
+	for _, l := range longhands(earlier) {
+		if !set[l] {
+			return false
+		}
+	}
+	return true
+}
+
+// intersects reports whether declarations of the two properties set at least one common longhand.
+func intersects(a, b string) bool {
+	for _, l := range longhands(a) {
+		for _, m := range longhands(b) {
+			if l == m {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// wins reports whether declaration `later`, written after `earlier` in the same block,
+// takes precedence over it: important declarations beat normal ones, otherwise the last one wins.
+func wins(later, earlier Declaration) bool {
+	return later.Important || !earlier.Important
+}
+
+// isFallback reports whether an overridden declaration could still be needed as a
+// fallback for browsers that do not support the declaration overriding it, as in
+// `color: red; color: oklch(0.6 0.2 30);` or `display: -webkit-box; display: flex;`.
+// Values using functions are assumed to be unsupported somewhere, and vendor-prefixed
+// declarations are assumed to target browsers that lack the standard one.
+func isFallback(overridden, winner Declaration) bool {
+	return strings.Contains(winner.Value, "(") || vendorPrefixed(winner) || vendorPrefixed(overridden)
+}
+
+// vendorPrefixed reports whether a declaration has a vendor-prefixed property or value keyword.
+func vendorPrefixed(d Declaration) bool {
+	if strings.HasPrefix(d.Property, "-") && !strings.HasPrefix(d.Property, "--") {
+		return true
+	}
+	for _, word := range strings.Fields(d.Value) {
+		if len(word) > 1 && word[0] == '-' && (word[1] < '0' || word[1] > '9') && word[1] != '.' {
+			return true
+		}
+	}
+	return false
+}
+
+// collapseBox returns the shortest shorthand value for top, right, bottom and left values.
+// Example: collapseBox([4]string{"1px", "2px", "1px", "2px"}) -> "1px 2px"
+func collapseBox(v [4]string) string {
+	switch {
+	case v[1] != v[3]:
+		return strings.Join(v[:], " ")
+	case v[0] != v[2]:
+		return strings.Join(v[:3], " ")
+	case v[0] != v[1]:
+		return v[0] + " " + v[1]
+	}
+	return v[0]
+}
+
+// isGlobalKeyword reports whether a value is a CSS-wide keyword, which cannot be combined into a shorthand.
+func isGlobalKeyword(value string) bool {
+	switch strings.ToLower(value) {
+	case "inherit", "initial", "unset", "revert", "revert-layer":
+		return true
+	}
+	return false
+}
@@ -116,3 +116,9 @@ func VMIN(value float64) Size {
 func VMAX(value float64) Size {
 	return size(value, "vmax")
 }
+
+// FR generates a flexible length in fractions (fr) of the free space in a grid container.
+// Example: FR(1) -> "1fr"
+func FR(value float64) Size {
+	return size(value, "fr")
+}
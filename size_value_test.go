@@ -120,3 +120,10 @@ func TestVMAX(t *testing.T) {
 		test{"10.555vmax", VMAX(10.555), "10.555vmax"},
 	)
 }
+
+func TestFR(t *testing.T) {
+	RunTests(t,
+		test{"1fr", FR(1), "1fr"},
+		test{"2.5fr", FR(2.5), "2.5fr"},
+	)
+}
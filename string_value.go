@@ -0,0 +1,107 @@
+package cssgo
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// quote serializes s as a CSS string delimited by the quote character q,
+// following the CSSOM rules for serializing a string: the delimiter and
+// backslashes are escaped, control characters are written as hex escapes,
+// and NUL is replaced by U+FFFD.
+// Example: quote(`say "hi"`, '"') -> `"say \"hi\""`
+func quote(s string, q rune) string {
+	var b strings.Builder
+	b.WriteRune(q)
+	for _, r := range s {
+		switch {
+		case r == 0:
+			b.WriteRune('\uFFFD')
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&b, "\\%x ", r)
+		case r == q || r == '\\':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteRune(q)
+	return b.String()
+}
+
+// ContentValue defines an interface for CSS-compatible content values.
+// This ensures that any type implementing this interface can be rendered as a valid CSS content value.
+type ContentValue interface {
+	ValueNode
+	contentValue()
+}
+
+// ContentType represents a CSS content keyword, such as "none" or "open-quote".
+// It is a concrete type that implements the ContentValue interface.
+type ContentType string
+
+// Predefined content keywords as per the CSS specification.
+const (
+	ContentNormal ContentType = "normal"
+	ContentNone   ContentType = "none"
+	OpenQuote     ContentType = "open-quote"
+	CloseQuote    ContentType = "close-quote"
+)
+
+func (c ContentType) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(c))
+	return err
+}
+
+func (c ContentType) valueNode()    {}
+func (c ContentType) contentValue() {}
+
+// StringType represents a CSS string value (e.g., `"→ "`).
+// It is a concrete type that implements the ContentValue interface.
+type StringType string
+
+func (s StringType) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(s))
+	return err
+}
+
+func (s StringType) valueNode()    {}
+func (s StringType) contentValue() {}
+
+// Str creates a quoted and escaped CSS string.
+// Example: Str(`say "hi"`) -> `"say \"hi\""`
+func Str(s string) StringType {
+	return StringType(quote(s, '"'))
+}
+
+// IdentType represents a CSS custom identifier, such as an animation, grid area or counter name.
+// It is used by generated properties accepting `<custom-ident>`, such as AnimationName or GridArea.
+type IdentType string
+
+func (i IdentType) RenderCSS(w io.Writer) error {
+	_, err := w.Write([]byte(i))
+	return err
+}
+
+func (i IdentType) valueNode() {}
+
+// Ident creates a CSS custom identifier, escaping characters that are not valid in an identifier.
+// Example: Ident("fade-in") -> "fade-in"
+func Ident(name string) IdentType {
+	var b strings.Builder
+	for i, r := range name {
+		leadingDigit := r >= '0' && r <= '9' && (i == 0 || i == 1 && name[0] == '-')
+		switch {
+		case leadingDigit || r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&b, "\\%x ", r)
+		case r == '-' || r == '_' || r >= 0x80 || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		}
+	}
+	return IdentType(b.String())
+}
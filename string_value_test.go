@@ -0,0 +1,95 @@
+package cssgo
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+)
+
+func TestStr(t *testing.T) {
+	RunTests(t,
+		test{"plain string", Str("hello"), `"hello"`},
+		test{"double quotes", Str(`say "hi"`), `"say \"hi\""`},
+		test{"single quotes are kept", Str(`it's`), `"it's"`},
+		test{"backslash", Str(`a\b`), `"a\\b"`},
+		test{"newline and tab", Str("a\nb\tc"), `"a\a b\9 c"`},
+		test{"nul", Str("a\x00b"), "\"a�b\""},
+		test{"unicode", Str("→ "), `"→ "`},
+	)
+}
+
+func TestUrlEscaping(t *testing.T) {
+	RunTests(t,
+		test{"plain path", Url("images/background.png"), "url('images/background.png')"},
+		test{"single quote", Url("it's.png"), `url('it\'s.png')`},
+		test{"closing paren", Url("a).png"), "url('a).png')"},
+		test{"newline", Url("a\n}.foo{color:red"), `url('a\a }.foo{color:red')`},
+		test{"backslash", Url(`a\'`), `url('a\\\'')`},
+	)
+}
+
+func TestURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		allowed []string
+		want    string
+		wantErr error
+	}{
+		{"https", "https://example.com/a.png?x=1", nil, "url('https://example.com/a.png?x=1')", nil},
+		{"relative", "../img/a b.png", nil, "url('../img/a%20b.png')", nil},
+		{"data", "data:image/png;base64,AAAA", nil, "url('data:image/png;base64,AAAA')", nil},
+		{"javascript", "javascript:alert(1)", nil, "", ErrUnsafeURL},
+		{"javascript upper case", "JavaScript:alert(1)", nil, "", ErrUnsafeURL},
+		{"vbscript", "vbscript:msgbox(1)", []string{"javascript"}, "", ErrUnsafeURL},
+		{"javascript allowed", "javascript:void(0)", []string{"JavaScript"}, "url('javascript:void(0)')", nil},
+	}
+
+	for _, test := range tests {
+		func(t2 *testing.T) {
+			u, err := url.Parse(test.input)
+			if err != nil {
+				t2.Fatal(err)
+			}
+
+			got, err := URL(u, test.allowed...)
+			if !errors.Is(err, test.wantErr) {
+				t2.Fatalf("TESTCASE %s: FAIL\ngot error: %v != want: %v", test.name, err, test.wantErr)
+			}
+			if string(got) != test.want {
+				t2.Fatalf("TESTCASE %s: FAIL\ngot: %s != want: %s", test.name, got, test.want)
+			}
+		}(t)
+	}
+
+	if _, err := URL(nil); !errors.Is(err, ErrUnsafeURL) {
+		t.Fatalf("URL(nil): got %v, want ErrUnsafeURL", err)
+	}
+}
+
+func TestContent(t *testing.T) {
+	RunTests(t,
+		test{"string", Content(Str("→ ")), `content: "→ ";`},
+		test{"string with quote", Content(Str(`"`)), `content: "\"";`},
+		test{"keyword", Content(ContentNone), "content: none;"},
+		test{"quotes", Content(OpenQuote), "content: open-quote;"},
+		test{"url", Content(Url("icon.svg")), "content: url('icon.svg');"},
+		test{"inherit", Content(Inherit), "content: inherit;"},
+	)
+}
+
+func TestEscapedNames(t *testing.T) {
+	RunTests(t,
+		test{"font name with quote", FontFamily(FontName(`My "Font"`)), `font-family: "My \"Font\"";`},
+		test{"attribute selector with quote", Attr("title", `a"]{}`), `[title="a\"]{}"]`},
+	)
+}
+
+func TestIdent(t *testing.T) {
+	RunTests(t,
+		test{"plain", Ident("fade-in"), "fade-in"},
+		test{"leading digit", Ident("1st"), `\31 st`},
+		test{"leading digit after dash", Ident("-2x"), `-\32 x`},
+		test{"special characters", Ident("a.b c"), `a\.b\ c`},
+	)
+}
@@ -0,0 +1,80 @@
+package cssgo
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Stylesheet is a collection of rules that is rendered into a standalone .css file.
+// It implements the Node interface.
+type Stylesheet []RuleNode
+
+// RenderCSS writes every rule of the stylesheet.
+func (s Stylesheet) RenderCSS(w io.Writer) error {
+	for _, rule := range s {
+		if err := rule.RenderCSS(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s Stylesheet) String() string {
+	var b strings.Builder
+	_ = s.RenderCSS(&b)
+	return b.String()
+}
+
+var (
+	stylesheetsMu sync.Mutex
+	stylesheets   = map[string]Stylesheet{}
+
+	// stylesheetName restricts names to what can safely be used as a file name.
+	stylesheetName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+)
+
+// Register makes a stylesheet available under a logical name, so that tools such as
+// cmd/cssgo can extract it into a static file. It is meant to be called from init
+// functions or package level variable declarations, and panics if the name is invalid
+// or already registered, like http.Handle.
+// Example: var _ = Register("app", Stylesheet{Class("foo").Props(TextColor(Red))})
+func Register(name string, sheet Stylesheet) Stylesheet {
+	if !stylesheetName.MatchString(name) {
+		panic(fmt.Sprintf("cssgo: invalid stylesheet name %q", name))
+	}
+
+	stylesheetsMu.Lock()
+	defer stylesheetsMu.Unlock()
+
+	if _, ok := stylesheets[name]; ok {
+		panic(fmt.Sprintf("cssgo: stylesheet %q registered twice", name))
+	}
+	stylesheets[name] = sheet
+	return sheet
+}
+
+// RegisteredStylesheets returns the names of the registered stylesheets, sorted.
+func RegisteredStylesheets() []string {
+	stylesheetsMu.Lock()
+	defer stylesheetsMu.Unlock()
+
+	names := make([]string, 0, len(stylesheets))
+	for name := range stylesheets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LookupStylesheet returns the stylesheet registered under name.
+func LookupStylesheet(name string) (Stylesheet, bool) {
+	stylesheetsMu.Lock()
+	defer stylesheetsMu.Unlock()
+
+	sheet, ok := stylesheets[name]
+	return sheet, ok
+}
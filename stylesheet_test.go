@@ -0,0 +1,35 @@
+package cssgo
+
+import "testing"
+
+func TestRegister(t *testing.T) {
+	sheet := Register("register-test", Stylesheet{
+		Class("foo").Props(TextColor(Red)),
+		Class("bar").Props(Width(PX(10))),
+	})
+
+	RunTests(t, test{"stylesheet", sheet, ".foo{color: red;}.bar{width: 10px;}"})
+
+	if got, ok := LookupStylesheet("register-test"); !ok || got.String() != sheet.String() {
+		t.Fatalf("registered stylesheet not found")
+	}
+
+	found := false
+	for _, name := range RegisteredStylesheets() {
+		found = found || name == "register-test"
+	}
+	if !found {
+		t.Fatalf("registered stylesheet not listed: %v", RegisteredStylesheets())
+	}
+
+	for _, name := range []string{"register-test", "../escape", ""} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("expected a panic registering %q", name)
+				}
+			}()
+			Register(name, Stylesheet{})
+		}()
+	}
+}
@@ -0,0 +1,66 @@
+package cssgo
+
+import (
+	"io"
+	"sort"
+)
+
+// ThemeAttr is the attribute used by LightDarkTheme to force a color scheme on an element.
+// Example: <html data-theme="dark">
+const ThemeAttr = "data-theme"
+
+// Theme maps custom property names to colors.
+// Names may be given with or without the leading "--" and are referenced with Var.
+// Example: Theme{"bg": White, "fg": Black}
+type Theme map[string]ColorValue
+
+// props renders the theme as custom property declarations, sorted by name
+// so that the output is deterministic.
+func (t Theme) props() []PropertyNode {
+	names := make([]string, 0, len(t))
+	for name := range t {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	props := make([]PropertyNode, len(names))
+	for i, name := range names {
+		props[i] = CustomProp(name, t[name])
+	}
+	return props
+}
+
+// LightDarkTheme creates the rules needed to switch between a light and a dark theme.
+// The light theme is the default, the dark theme is applied through a
+// `prefers-color-scheme` media query, and either can be forced with the ThemeAttr attribute.
+// Example:
+//
+//	LightDarkTheme(Theme{"bg": White}, Theme{"bg": Black}) ->
+//	:root{color-scheme: light dark;--bg: white;}
+//	@media (prefers-color-scheme: dark){:root{--bg: black;}}
+//	[data-theme="light"]{color-scheme: light;--bg: white;}
+//	[data-theme="dark"]{color-scheme: dark;--bg: black;}
+//
+// Parameters:
+// - light (Theme): The custom properties used for the light color scheme.
+// - dark (Theme): The custom properties used for the dark color scheme.
+//
+// Returns:
+// - RuleNodeFunc: A function that renders all theme rules.
+func LightDarkTheme(light, dark Theme) RuleNodeFunc {
+	rules := []RuleNode{
+		Root().Props(append([]PropertyNode{ColorScheme(LightScheme, DarkScheme)}, light.props()...)...),
+		Media("(prefers-color-scheme: dark)", Root().Props(dark.props()...)),
+		Attr(ThemeAttr, string(LightScheme)).Props(append([]PropertyNode{ColorScheme(LightScheme)}, light.props()...)...),
+		Attr(ThemeAttr, string(DarkScheme)).Props(append([]PropertyNode{ColorScheme(DarkScheme)}, dark.props()...)...),
+	}
+
+	return RuleNodeFunc(func(w io.Writer) error {
+		for _, rule := range rules {
+			if err := rule.RenderCSS(w); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
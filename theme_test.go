@@ -0,0 +1,38 @@
+package cssgo
+
+import "testing"
+
+func TestLightDarkTheme(t *testing.T) {
+	RunTests(t,
+		test{
+			"light and dark theme",
+			LightDarkTheme(
+				Theme{"fg": Black, "bg": White},
+				Theme{"--fg": White, "--bg": Hex(0x121212)},
+			),
+			":root{color-scheme: light dark;--bg: white;--fg: black;}" +
+				"@media (prefers-color-scheme: dark){:root{--bg: #121212;--fg: white;}}" +
+				"[data-theme=\"light\"]{color-scheme: light;--bg: white;--fg: black;}" +
+				"[data-theme=\"dark\"]{color-scheme: dark;--bg: #121212;--fg: white;}",
+		},
+	)
+}
+
+func TestCustomProp(t *testing.T) {
+	RunTests(t,
+		test{"custom prop without dashes", CustomProp("brand", Blue), "--brand: blue;"},
+		test{"custom prop with dashes", CustomProp("--gap", PX(4)), "--gap: 4px;"},
+		test{"var as color", TextColor(Var("fg")), "color: var(--fg);"},
+		test{"var as size", Width(Var("--w")), "width: var(--w);"},
+	)
+}
+
+func TestMedia(t *testing.T) {
+	RunTests(t,
+		test{
+			"media with class rule",
+			Media("(min-width: 600px)", Class("foo").Props(Width(PX(10)))),
+			"@media (min-width: 600px){.foo{width: 10px;}}",
+		},
+	)
+}
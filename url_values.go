@@ -1,8 +1,11 @@
 package cssgo
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"net/url"
+	"strings"
 )
 
 // UrlyValue defines an interface for types representing CSS-compatible URL values.
@@ -20,9 +23,45 @@ func (u UrlType) RenderCSS(w io.Writer) error {
 	return err
 }
 
-func (u UrlType) valueNode() {}
-func (u UrlType) urlValue()  {}
+func (u UrlType) valueNode()    {}
+func (u UrlType) urlValue()     {}
+func (u UrlType) contentValue() {}
 
+// Url creates a CSS URL value. The path is quoted and escaped as a CSS string.
+// Example: Url("images/background.png") -> "url('images/background.png')"
 func Url(path string) UrlType {
-	return UrlType(fmt.Sprintf("url('%s')", path))
+	return UrlType("url(" + quote(path, '\'') + ")")
+}
+
+// ErrUnsafeURL is returned by URL for URLs with a scheme that can execute script.
+var ErrUnsafeURL = errors.New("cssgo: unsafe url")
+
+// unsafeSchemes lists the URL schemes that are rejected unless explicitly allowed.
+var unsafeSchemes = []string{"javascript", "vbscript"}
+
+// URL creates a CSS URL value from a parsed URL.
+// URLs using the javascript: or vbscript: schemes are rejected with ErrUnsafeURL,
+// unless their scheme is listed in allowedSchemes.
+// Example: URL(&url.URL{Scheme: "https", Host: "example.com", Path: "/a.png"}) -> "url('https://example.com/a.png')"
+func URL(u *url.URL, allowedSchemes ...string) (UrlType, error) {
+	if u == nil {
+		return "", fmt.Errorf("%w: nil url", ErrUnsafeURL)
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	for _, unsafe := range unsafeSchemes {
+		if scheme != unsafe {
+			continue
+		}
+
+		allowed := false
+		for _, s := range allowedSchemes {
+			allowed = allowed || strings.EqualFold(s, scheme)
+		}
+		if !allowed {
+			return "", fmt.Errorf("%w: %s: scheme is not allowed", ErrUnsafeURL, scheme)
+		}
+	}
+
+	return Url(u.String()), nil
 }
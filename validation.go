@@ -0,0 +1,436 @@
+package cssgo
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ValidationMode controls how invalid values are handled while rendering.
+// It is set per render with RenderOptions.Validation, so that code rendering CSS
+// for different purposes can use different modes at the same time.
+// Passes that transform nodes, such as Optimize or Prefix, render values as they
+// were constructed; use Validate on the nodes to check them first.
+type ValidationMode int32
+
+const (
+	// ValidationOff renders values exactly as they were constructed. This is the default.
+	ValidationOff ValidationMode = iota
+	// ValidationStrict makes rendering return an error for invalid values. It is intended for tests.
+	ValidationStrict
+	// ValidationLenient clamps out of range color components into their valid range while rendering.
+	// It is intended for production, where rendering something sensible beats failing.
+	ValidationLenient
+)
+
+// renderWriter carries the validation mode of RenderWith and Validate to the values being rendered.
+type renderWriter struct {
+	io.Writer
+	mode ValidationMode
+	// errs collects validation errors instead of returning them, so that Validate
+	// can report every invalid value instead of only the first one.
+	errs *[]error
+}
+
+// validationMode returns the validation mode of a writer passed to RenderCSS.
+func validationMode(w io.Writer) ValidationMode {
+	if rw, ok := w.(*renderWriter); ok {
+		return rw.mode
+	}
+	return ValidationOff
+}
+
+// bufferWriter returns a writer into buf that keeps the validation mode of w,
+// for nodes that render their children into a buffer before writing them to w.
+func bufferWriter(w io.Writer, buf io.Writer) io.Writer {
+	if rw, ok := w.(*renderWriter); ok {
+		return &renderWriter{Writer: buf, mode: rw.mode, errs: rw.errs}
+	}
+	return buf
+}
+
+// Validate renders the nodes in strict mode and returns every validation error found, joined with errors.Join.
+// Example: Validate(TextColor(RGB(300, 0, 0))) -> error
+func Validate(nodes ...Node) error {
+	var errs []error
+	w := &renderWriter{Writer: io.Discard, mode: ValidationStrict, errs: &errs}
+	for _, node := range nodes {
+		if err := node.RenderCSS(w); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// check runs validate when the writer renders in strict mode.
+// Errors are collected when rendering through Validate, and returned otherwise.
+func check(w io.Writer, validate func() error) error {
+	rw, ok := w.(*renderWriter)
+	if !ok || rw.mode != ValidationStrict {
+		return nil
+	}
+
+	err := validate()
+	if err == nil || rw.errs == nil {
+		return err
+	}
+
+	*rw.errs = append(*rw.errs, err)
+	return nil
+}
+
+// ErrUnknownProperty is reported for properties that are neither standard CSS properties
+// nor custom properties, and whose name is close to a standard one, such as Prop("colro", Red).
+var ErrUnknownProperty = errors.New("cssgo: unknown property")
+
+// ErrInvalidValue is reported for property values that do not match the syntax of the property.
+var ErrInvalidValue = errors.New("cssgo: invalid value")
+
+// validateValues renders the values of a property separated by sep and checks them with validateProperty.
+// Values that fail to render or are invalid themselves are accepted, as rendering them reports their own error.
+func validateValues(name string, values []ValueNode, sep string) error {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		var b strings.Builder
+		if err := value.RenderCSS(&renderWriter{Writer: &b, mode: ValidationStrict}); err != nil {
+			return nil
+		}
+		parts[i] = b.String()
+	}
+	return validateProperty(name, strings.Join(parts, sep))
+}
+
+// validateProperty checks that a property is a standard CSS property and that its value
+// matches the value definition syntax of the property in the vendored dataset.
+// Custom properties, vendor-prefixed properties and values, and values using var(), env()
+// or attr() cannot be checked and are accepted. So are properties missing from the dataset,
+// such as those newer than the vendored release, unless their name is a likely typo.
+// Example: validateProperty("position", "absolut") -> invalid value "absolut" for property "position" ... (did you mean "absolute"?)
+func validateProperty(name, value string) error {
+	name = strings.ToLower(name)
+	if strings.HasPrefix(name, "-") {
+		return nil
+	}
+
+	info, ok := properties[name]
+	if !ok {
+		if s := suggest(name, propertyNames); s != "" {
+			return fmt.Errorf("%w %q, did you mean %q?", ErrUnknownProperty, name, s)
+		}
+		return nil
+	}
+
+	tokens, ok := tokenizeValue(value)
+	if ok && (len(tokens) == 1 && tokens[0].kind == identToken && isGlobalKeyword(tokens[0].text) || uncheckable(tokens)) {
+		return nil
+	}
+
+	g, err := cachedGrammar(name, true)
+	if err != nil {
+		return err
+	}
+	if ok {
+		if ok, err = matches(g, tokens); err != nil || ok {
+			return err
+		}
+	}
+
+	err = fmt.Errorf("%w %q for property %q, expected %s", ErrInvalidValue, value, name, info.Syntax)
+	keywords := grammarKeywords(g, map[*grammar]bool{}, map[string]bool{})
+	names := make([]string, 0, len(keywords))
+	for keyword := range keywords {
+		names = append(names, keyword)
+	}
+	sort.Strings(names)
+	for _, t := range tokens {
+		if t.kind != identToken || keywords[t.text] {
+			continue
+		}
+		if s := suggest(t.text, names); s != "" {
+			return fmt.Errorf("%w (did you mean %q?)", err, s)
+		}
+	}
+	return err
+}
+
+// uncheckable reports whether tokens contain values whose syntax cannot be known before
+// the page is rendered, such as var(), or vendor-prefixed values such as -webkit-sticky.
+func uncheckable(tokens []valueToken) bool {
+	for _, t := range tokens {
+		switch {
+		case t.kind == functionToken && (t.text == "var" || t.text == "env" || t.text == "attr"):
+			return true
+		case (t.kind == identToken || t.kind == functionToken) && strings.HasPrefix(t.text, "-") && !strings.HasPrefix(t.text, "--"):
+			return true
+		case t.kind == functionToken && uncheckable(t.args):
+			return true
+		}
+	}
+	return false
+}
+
+// grammarKeywords collects the keywords that a grammar accepts outside of functions.
+func grammarKeywords(g *grammar, seen map[*grammar]bool, keywords map[string]bool) map[string]bool {
+	if seen[g] {
+		return keywords
+	}
+	seen[g] = true
+
+	switch g.kind {
+	case keywordGrammar:
+		keywords[strings.ToLower(g.name)] = true
+	case typeGrammar:
+		if ref, err := cachedGrammar(g.name, g.prop); err == nil && ref != nil {
+			grammarKeywords(ref, seen, keywords)
+		}
+	case functionGrammar:
+	default:
+		for _, child := range g.children {
+			grammarKeywords(child, seen, keywords)
+		}
+	}
+	return keywords
+}
+
+// suggest returns the candidate closest to word, if it is close enough to be a likely typo.
+// Example: suggest("colro", []string{"color", "cursor"}) -> "color"
+func suggest(word string, candidates []string) string {
+	best, bestDistance := "", max(1, min(3, len(word)/3))+1
+	for _, c := range candidates {
+		if d := editDistance(word, c); d < bestDistance {
+			best, bestDistance = c, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the number of single character insertions, deletions, substitutions
+// and transpositions of adjacent characters that turn a into b.
+func editDistance(a, b string) int {
+	prev2, prev, cur := make([]int, len(b)+1), make([]int, len(b)+1), make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
+
+// validateColor checks that a Color is valid CSS and that its components are within range.
+// Colors referencing custom properties cannot be checked and are accepted.
+func validateColor(c Color) error {
+	s := strings.ToLower(strings.TrimSpace(string(c)))
+	if strings.Contains(s, "var(") {
+		return nil
+	}
+
+	if _, err := ParseColor(s); err != nil {
+		return err
+	}
+
+	open := strings.IndexByte(s, '(')
+	if open <= 0 || !colorFuncs[s[:open]] {
+		return nil
+	}
+
+	fn, err := parseColorFunc(string(c), s)
+	if err != nil {
+		return err
+	}
+
+	return fn.checkRange(string(c))
+}
+
+// channelRange is the valid range of a color component and the value 100% maps to.
+type channelRange struct {
+	name     string
+	min, max float64
+	percent  float64
+}
+
+// colorRanges lists the valid ranges of the non-hue components of each color function.
+// A zero-value range marks a hue or a component that may take any value.
+var colorRanges = map[string][3]channelRange{
+	"rgb":   {{"red", 0, 255, 255}, {"green", 0, 255, 255}, {"blue", 0, 255, 255}},
+	"hsl":   {{}, {"saturation", 0, 100, 100}, {"lightness", 0, 100, 100}},
+	"hwb":   {{}, {"whiteness", 0, 100, 100}, {"blackness", 0, 100, 100}},
+	"lab":   {{"lightness", 0, 100, 100}, {}, {}},
+	"lch":   {{"lightness", 0, 100, 100}, {"chroma", 0, math.Inf(1), 150}, {}},
+	"oklab": {{"lightness", 0, 1, 1}, {}, {}},
+	"oklch": {{"lightness", 0, 1, 1}, {"chroma", 0, math.Inf(1), 0.4}, {}},
+}
+
+// checkRange reports the first component of the function that is out of range.
+func (fn colorFunc) checkRange(input string) error {
+	// The legacy rgba() and hsla() aliases share the ranges of rgb() and hsl().
+	name := strings.TrimSuffix(fn.name, "a")
+
+	if ranges, ok := colorRanges[name]; ok {
+		for i, r := range ranges {
+			if r.name == "" || fn.args[i].none {
+				continue
+			}
+			v, err := fn.args[i].number(input, r.percent)
+			if err != nil {
+				return err
+			}
+			if v < r.min || v > r.max {
+				return invalidColor(input, fmt.Sprintf("%s %g is out of range", r.name, v))
+			}
+		}
+	}
+
+	if fn.hasAlpha && !fn.alpha.none {
+		a, err := fn.alpha.number(input, 1)
+		if err != nil {
+			return err
+		}
+		if a < 0 || a > 1 {
+			return invalidColor(input, fmt.Sprintf("alpha %g is out of range 0–1", a))
+		}
+	}
+
+	return nil
+}
+
+// maxCachedColors bounds the colors whose validation and clamping results are cached,
+// so that colors built from user input cannot grow the cache without limit.
+const maxCachedColors = 4096
+
+// colorCache caches a result per color, so that rendering the same color again does not parse it again.
+type colorCache[T any] struct {
+	mu      sync.Mutex
+	results map[Color]T
+}
+
+func (c *colorCache[T]) get(color Color, compute func(Color) T) T {
+	c.mu.Lock()
+	result, ok := c.results[color]
+	c.mu.Unlock()
+	if ok {
+		return result
+	}
+
+	result = compute(color)
+
+	c.mu.Lock()
+	if c.results == nil {
+		c.results = map[Color]T{}
+	}
+	if len(c.results) < maxCachedColors {
+		c.results[color] = result
+	}
+	c.mu.Unlock()
+	return result
+}
+
+var (
+	validatedColors colorCache[error]
+	clampedColors   colorCache[Color]
+)
+
+// clampColor clamps the out of range components of a color function, and hex colors
+// built from out of range integers, into their valid range. Other colors are returned as is.
+// Example: clampColor("rgb(300, -5, 0)") -> "rgb(255, 0, 0)"
+func clampColor(c Color) Color {
+	s := strings.ToLower(strings.TrimSpace(string(c)))
+	if digits, ok := strings.CutPrefix(s, "#"); ok {
+		if _, err := parseHexColor(s, digits); err == nil {
+			return c
+		}
+		v, err := strconv.ParseInt(digits, 16, 64)
+		if err != nil {
+			return c
+		}
+		return Color(fmt.Sprintf("#%06x", max(0, min(0xffffff, v))))
+	}
+
+	open := strings.IndexByte(s, '(')
+	if open <= 0 || !colorFuncs[s[:open]] {
+		return c
+	}
+	fn, err := parseColorFunc(string(c), s)
+	if err != nil {
+		return c
+	}
+
+	changed := false
+	clampArg := func(a *colorArg, r channelRange) {
+		if a.none || a.unit != "" && a.unit != "%" {
+			return
+		}
+		scale := 1.0
+		if a.unit == "%" {
+			scale = r.percent / 100
+		}
+		if v := clamp(a.value*scale, r.min, r.max); v != a.value*scale {
+			a.value, changed = v/scale, true
+		}
+	}
+
+	// The legacy rgba() and hsla() aliases share the ranges of rgb() and hsl().
+	if ranges, ok := colorRanges[strings.TrimSuffix(fn.name, "a")]; ok && len(fn.args) == 3 {
+		for i, r := range ranges {
+			if r.name != "" {
+				clampArg(&fn.args[i], r)
+			}
+		}
+	}
+	if fn.hasAlpha {
+		clampArg(&fn.alpha, channelRange{name: "alpha", min: 0, max: 1, percent: 1})
+	}
+
+	if !changed {
+		return c
+	}
+	return Color(fn.String())
+}
+
+// String renders the function back into CSS, in its legacy or modern syntax.
+func (fn colorFunc) String() string {
+	parts := make([]string, len(fn.args))
+	for i, a := range fn.args {
+		parts[i] = a.String()
+	}
+	if fn.space != "" {
+		parts = append([]string{string(fn.space)}, parts...)
+	}
+
+	if fn.legacy {
+		if fn.hasAlpha {
+			parts = append(parts, fn.alpha.String())
+		}
+		return fn.name + "(" + strings.Join(parts, ", ") + ")"
+	}
+
+	body := strings.Join(parts, " ")
+	if fn.hasAlpha {
+		body += " / " + fn.alpha.String()
+	}
+	return fn.name + "(" + body + ")"
+}
+
+func (a colorArg) String() string {
+	if a.none {
+		return "none"
+	}
+	return strconv.FormatFloat(a.value, 'f', -1, 64) + a.unit
+}
@@ -0,0 +1,149 @@
+package cssgo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	invalid := []Node{
+		RGB(300, -5, 0),
+		RGBA(0, 0, 0, 7.0),
+		HSL(0, -20, 50),
+		HSLA(0, 20, 50, -1),
+		Hex(0x1000000),
+		OKLCH(1.5, 0.1, 120),
+		LCH(50, -1, 0),
+		HWBA(0, 120, 0, 0.5),
+		Color("#12345"),
+		TextColor(Color("blurple")),
+	}
+
+	for _, node := range invalid {
+		if err := Validate(node); !errors.Is(err, ErrInvalidColor) {
+			t.Fatalf("Validate(%v): got %v, want ErrInvalidColor", node, err)
+		}
+	}
+
+	valid := []Node{
+		RGB(255, 0, 0),
+		RGBA(0, 0, 0, 0.5),
+		HSL(-30, 100, 50),
+		Hex(0xffffff),
+		OKLCHA(0.7, 0.1, 250, NoneChannel()),
+		LightDark(White, Black),
+		ColorMix(OKLCHSpace, Var("brand"), 40, White, 60),
+		Var("brand"),
+		Class("foo").Props(TextColor(Red), BackgroundColor(Inherit), Width(PX(10))),
+	}
+
+	for _, node := range valid {
+		if err := Validate(node); err != nil {
+			t.Fatalf("Validate(%v): unexpected error %v", node, err)
+		}
+	}
+}
+
+func TestValidateCollectsAllErrors(t *testing.T) {
+	err := Validate(Class("foo").Props(
+		TextColor(RGB(300, 0, 0)),
+		BackgroundColor(Hex(0x1000000)),
+		BorderColor1(HSL(0, -20, 50)),
+	))
+
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if got := strings.Count(err.Error(), "invalid color"); got != 3 {
+		t.Fatalf("expected 3 errors, got %d: %v", got, err)
+	}
+}
+
+func TestValidationStrict(t *testing.T) {
+	strict := RenderOptions{Validation: ValidationStrict}
+
+	var b strings.Builder
+	if err := RenderWith(&b, strict, TextColor(RGB(300, 0, 0))); !errors.Is(err, ErrInvalidColor) {
+		t.Fatalf("got %v, want ErrInvalidColor", err)
+	}
+
+	b.Reset()
+	if err := RenderWith(&b, strict, TextColor(RGB(30, 0, 0))); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// Nodes that render their properties through a buffer keep the mode.
+	b.Reset()
+	if err := RenderWith(&b, strict, TextColor(RGB(300, 0, 0)).Important()); !errors.Is(err, ErrInvalidColor) {
+		t.Fatalf("important: got %v, want ErrInvalidColor", err)
+	}
+	b.Reset()
+	if err := RenderWith(&b, strict, MergeProps(TextColor(RGB(300, 0, 0)))); !errors.Is(err, ErrInvalidColor) {
+		t.Fatalf("merged: got %v, want ErrInvalidColor", err)
+	}
+
+	// The mode only applies to the render it is given to.
+	b.Reset()
+	if err := TextColor(RGB(300, 0, 0)).RenderCSS(&b); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+// packageColor is constructed before any validation mode can be chosen.
+var packageColor = RGB(300, -5, 0)
+
+func TestValidationLenient(t *testing.T) {
+	tests := []test{
+		{"rgb clamps channels", RGB(300, -5, 0), "rgb(255, 0, 0)"},
+		{"package level color", packageColor, "rgb(255, 0, 0)"},
+		{"rgba clamps alpha", RGBA(0, 0, 0, 7.0), "rgba(0, 0, 0, 1)"},
+		{"hsl clamps saturation", HSL(0, -20, 50), "hsl(0, 0%, 50%)"},
+		{"hsla clamps lightness", HSLA(400, 20, 150, 0.5), "hsla(400, 20%, 100%, 0.5)"},
+		{"hex clamps", Hex(0x1000000), "#ffffff"},
+		{"negative hex clamps", Hex(-5), "#000000"},
+		{"oklch clamps lightness and chroma", OKLCH(1.5, -0.1, 120), "oklch(1 0 120)"},
+		{"none is kept", OKLCHA(0.5, 0.1, NoneChannel(), NoneChannel()), "oklch(0.5 0.1 none / none)"},
+		{"hwb clamps", HWBA(0, 120, -3, 2), "hwb(0 100% 0% / 1)"},
+		{"percentages clamp", Color("rgb(120% 50% -10% / 150%)"), "rgb(100% 50% 0% / 100%)"},
+		{"valid colors are kept", Color("rgb(10 20 30)"), "rgb(10 20 30)"},
+		{"inside properties", TextColor(LightDark(RGB(300, 0, 0), White)), "color: light-dark(rgb(255, 0, 0), white);"},
+	}
+
+	for _, test := range tests {
+		var b strings.Builder
+		if err := RenderWith(&b, RenderOptions{Validation: ValidationLenient}, test.input); err != nil {
+			t.Fatalf("TESTCASE %s: FAIL\nunexpected error %v", test.name, err)
+		}
+		if b.String() != test.want {
+			t.Fatalf("TESTCASE %s: FAIL\ngot: %s != want: %s", test.name, b.String(), test.want)
+		}
+	}
+}
+
+func TestValidationModesInParallel(t *testing.T) {
+	for _, mode := range []ValidationMode{ValidationOff, ValidationStrict, ValidationLenient} {
+		mode := mode
+		t.Run(fmt.Sprint(mode), func(t *testing.T) {
+			t.Parallel()
+			for i := 0; i < 100; i++ {
+				var b strings.Builder
+				err := RenderWith(&b, RenderOptions{Validation: mode}, TextColor(RGB(300, 0, 0)))
+				if (err != nil) != (mode == ValidationStrict) {
+					t.Fatalf("mode %d: unexpected error %v", mode, err)
+				}
+				if mode == ValidationLenient && b.String() != "color: rgb(255, 0, 0);" {
+					t.Fatalf("mode %d: got %s", mode, b.String())
+				}
+			}
+		})
+	}
+}
+
+func TestValidationOff(t *testing.T) {
+	RunTests(t,
+		test{"rgb is rendered verbatim", RGB(300, -5, 0), "rgb(300, -5, 0)"},
+		test{"hex is rendered verbatim", Hex(0x1000000), "#1000000"},
+	)
+}
@@ -25,3 +25,40 @@ func (g goType) valueNode() {}
 func toCSS(val any) goType {
 	return goType(fmt.Sprintf("%v", val))
 }
+
+// valueNodes converts a slice of typed values into ValueNodes, so that they can be passed to Prop.
+func valueNodes[V ValueNode](values []V) []ValueNode {
+	nodes := make([]ValueNode, len(values))
+	for i, v := range values {
+		nodes[i] = v
+	}
+	return nodes
+}
+
+// layer is a list of values separated by spaces, such as one layer of a transition.
+type layer []ValueNode
+
+func (l layer) RenderCSS(w io.Writer) error {
+	for i, value := range l {
+		if i > 0 {
+			if _, err := w.Write([]byte(" ")); err != nil {
+				return err
+			}
+		}
+		if err := value.RenderCSS(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l layer) valueNode() {}
+
+// layerNodes converts layers of typed values into ValueNodes, so that they can be passed to commaProp.
+func layerNodes[V ValueNode](layers [][]V) []ValueNode {
+	nodes := make([]ValueNode, len(layers))
+	for i, values := range layers {
+		nodes[i] = layer(valueNodes(values))
+	}
+	return nodes
+}